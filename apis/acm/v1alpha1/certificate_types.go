@@ -75,6 +75,11 @@ type CertificateExternalStatus struct {
 	// "_a79865eb4cd1a6ab990a45779b4e0b96.yourdomain.com", only
 	// "_a79865eb4cd1a6ab990a45779b4e0b96" must be used.
 	ResourceRecord *ResourceRecord `json:"resourceRecord,omitempty"`
+
+	// DomainValidationRecords are the DNS records that are used to validate
+	// the ownership of the domains of the certificate. Domains that share the
+	// same record are listed once.
+	DomainValidationRecords []DomainValidationRecord `json:"domainValidationRecords,omitempty"`
 }
 
 // An CertificateStatus represents the observed state of an Certificate manager.
@@ -96,11 +101,31 @@ type ResourceRecord struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// DomainValidationRecord is a DNS record that ACM uses to validate the
+// ownership of a domain.
+type DomainValidationRecord struct {
+	// DomainName is the domain that is validated with the record.
+	DomainName string `json:"domainName"`
+
+	// Name of the CNAME record.
+	Name string `json:"name"`
+
+	// Type of the record. Currently this can be CNAME.
+	Type string `json:"type"`
+
+	// Value of the CNAME record.
+	Value string `json:"value"`
+
+	// ValidationStatus is the validation status of the domain.
+	ValidationStatus types.DomainStatus `json:"validationStatus,omitempty"`
+}
+
 // CertificateParameters defines the desired state of an AWS Certificate.
 type CertificateParameters struct {
 
 	// Region is the region you'd like your Certificate to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The Amazon Resource Name (ARN) of the private certificate authority (CA)that will be used to issue the certificate.
 	// +optional
@@ -142,6 +167,29 @@ type CertificateParameters struct {
 	// +kubebuilder:validation:Enum=DNS;EMAIL
 	ValidationMethod *types.ValidationMethod `json:"validationMethod,omitempty"`
 
+	// HostedZoneID is the ID of the Route53 hosted zone that the DNS
+	// validation records are created in. The records are created by the
+	// controller when the validation method is DNS.
+	// +optional
+	HostedZoneID *string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references a Route53 HostedZone to retrieve its ID.
+	// +optional
+	HostedZoneIDRef *xpv1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to a Route53 HostedZone to
+	// retrieve its ID.
+	// +optional
+	HostedZoneIDSelector *xpv1.Selector `json:"hostedZoneIdSelector,omitempty"`
+
+	// DeleteValidationRecords deletes the DNS validation records from the
+	// hosted zone when the certificate is deleted. ACM uses the same record
+	// for a domain in all certificates of an account, so it should only be
+	// enabled if no other certificate is issued for the same domains.
+	// Otherwise the renewal of those certificates fails.
+	// +optional
+	DeleteValidationRecords *bool `json:"deleteValidationRecords,omitempty"`
+
 	// Flag to renew the certificate
 	// +optional
 	RenewCertificate *bool `json:"renewCertificate,omitempty"`
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImportedCertificateParameters defines the desired state of a certificate
+// that is imported to ACM.
+type ImportedCertificateParameters struct {
+	// Region is the region you'd like your Certificate to be imported to.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// CertificateSecretRef references the kubernetes.io/tls Secret that
+	// contains the certificate and its private key. The first certificate in
+	// the tls.crt key is imported as the certificate and the rest as its
+	// chain. If tls.crt has a single certificate, the ca.crt key is used as
+	// the chain if it exists. The certificate is imported again whenever the
+	// certificate in the Secret changes.
+	CertificateSecretRef xpv1.SecretReference `json:"certificateSecretRef"`
+
+	// One or more resource tags to associate with the certificate.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ImportedCertificateSpec defines the desired state of an ImportedCertificate.
+type ImportedCertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImportedCertificateParameters `json:"forProvider"`
+}
+
+// ImportedCertificateObservation keeps the state of the imported certificate.
+type ImportedCertificateObservation struct {
+	// CertificateARN is the ARN of the imported certificate.
+	CertificateARN string `json:"certificateARN,omitempty"`
+
+	// DomainName is the fully qualified domain name of the certificate.
+	DomainName string `json:"domainName,omitempty"`
+
+	// SubjectAlternativeNames of the certificate.
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames,omitempty"`
+
+	// Serial is the serial number of the certificate.
+	Serial string `json:"serial,omitempty"`
+
+	// Status of the certificate.
+	Status types.CertificateStatus `json:"status,omitempty"`
+
+	// NotBefore is the time before which the certificate is not valid.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the time after which the certificate is not valid.
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// ImportedAt is the time at which the certificate was last imported.
+	ImportedAt *metav1.Time `json:"importedAt,omitempty"`
+
+	// InUseBy is the list of ARNs of the AWS resources that use the
+	// certificate.
+	InUseBy []string `json:"inUseBy,omitempty"`
+}
+
+// An ImportedCertificateStatus represents the observed state of an
+// ImportedCertificate.
+type ImportedCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImportedCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImportedCertificate is a managed resource that represents a certificate
+// imported to AWS Certificate Manager from a Kubernetes TLS Secret.
+// +kubebuilder:printcolumn:name="DOMAINNAME",type="string",JSONPath=".status.atProvider.domainName"
+// +kubebuilder:printcolumn:name="NOTAFTER",type="string",JSONPath=".status.atProvider.notAfter"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ImportedCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImportedCertificateSpec   `json:"spec"`
+	Status ImportedCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImportedCertificateList contains a list of ImportedCertificate
+type ImportedCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImportedCertificate `json:"items"`
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 )
 
 // ResolveReferences of this Certificate
@@ -45,5 +46,19 @@ func (mg *Certificate) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.CertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CertificateAuthorityARNRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.hostedZoneId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.HostedZoneID),
+		Reference:    mg.Spec.ForProvider.HostedZoneIDRef,
+		Selector:     mg.Spec.ForProvider.HostedZoneIDSelector,
+		To:           reference.To{Managed: &route53v1alpha1.HostedZone{}, List: &route53v1alpha1.HostedZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.hostedZoneId")
+	}
+	mg.Spec.ForProvider.HostedZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HostedZoneIDRef = rsp.ResolvedReference
+
 	return nil
 }
@@ -51,6 +51,15 @@ var (
 	CertificateGroupVersionKind = SchemeGroupVersion.WithKind(CertificateKind)
 )
 
+// ImportedCertificate type metadata.
+var (
+	ImportedCertificateKind             = reflect.TypeOf(ImportedCertificate{}).Name()
+	ImportedCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: ImportedCertificateKind}.String()
+	ImportedCertificateKindAPIVersion   = ImportedCertificateKind + "." + SchemeGroupVersion.String()
+	ImportedCertificateGroupVersionKind = SchemeGroupVersion.WithKind(ImportedCertificateKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
+	SchemeBuilder.Register(&ImportedCertificate{}, &ImportedCertificateList{})
 }
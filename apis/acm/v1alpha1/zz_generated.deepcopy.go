@@ -61,6 +61,11 @@ func (in *CertificateExternalStatus) DeepCopyInto(out *CertificateExternalStatus
 		*out = new(ResourceRecord)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DomainValidationRecords != nil {
+		in, out := &in.DomainValidationRecords, &out.DomainValidationRecords
+		*out = make([]DomainValidationRecord, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalStatus.
@@ -160,6 +165,26 @@ func (in *CertificateParameters) DeepCopyInto(out *CertificateParameters) {
 		*out = new(types.ValidationMethod)
 		**out = **in
 	}
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostedZoneIDRef != nil {
+		in, out := &in.HostedZoneIDRef, &out.HostedZoneIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.HostedZoneIDSelector != nil {
+		in, out := &in.HostedZoneIDSelector, &out.HostedZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeleteValidationRecords != nil {
+		in, out := &in.DeleteValidationRecords, &out.DeleteValidationRecords
+		*out = new(bool)
+		**out = **in
+	}
 	if in.RenewCertificate != nil {
 		in, out := &in.RenewCertificate, &out.RenewCertificate
 		*out = new(bool)
@@ -226,6 +251,172 @@ func (in *DomainValidationOption) DeepCopy() *DomainValidationOption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainValidationRecord) DeepCopyInto(out *DomainValidationRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainValidationRecord.
+func (in *DomainValidationRecord) DeepCopy() *DomainValidationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainValidationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedCertificate) DeepCopyInto(out *ImportedCertificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedCertificate.
+func (in *ImportedCertificate) DeepCopy() *ImportedCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImportedCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedCertificateList) DeepCopyInto(out *ImportedCertificateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImportedCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedCertificateList.
+func (in *ImportedCertificateList) DeepCopy() *ImportedCertificateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedCertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImportedCertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedCertificateObservation) DeepCopyInto(out *ImportedCertificateObservation) {
+	*out = *in
+	if in.SubjectAlternativeNames != nil {
+		in, out := &in.SubjectAlternativeNames, &out.SubjectAlternativeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.ImportedAt != nil {
+		in, out := &in.ImportedAt, &out.ImportedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.InUseBy != nil {
+		in, out := &in.InUseBy, &out.InUseBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedCertificateObservation.
+func (in *ImportedCertificateObservation) DeepCopy() *ImportedCertificateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedCertificateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedCertificateParameters) DeepCopyInto(out *ImportedCertificateParameters) {
+	*out = *in
+	out.CertificateSecretRef = in.CertificateSecretRef
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedCertificateParameters.
+func (in *ImportedCertificateParameters) DeepCopy() *ImportedCertificateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedCertificateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedCertificateSpec) DeepCopyInto(out *ImportedCertificateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedCertificateSpec.
+func (in *ImportedCertificateSpec) DeepCopy() *ImportedCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedCertificateStatus) DeepCopyInto(out *ImportedCertificateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedCertificateStatus.
+func (in *ImportedCertificateStatus) DeepCopy() *ImportedCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRecord) DeepCopyInto(out *ResourceRecord) {
 	*out = *in
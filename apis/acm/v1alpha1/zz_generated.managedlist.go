@@ -28,3 +28,12 @@ func (l *CertificateList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this ImportedCertificateList.
+func (l *ImportedCertificateList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
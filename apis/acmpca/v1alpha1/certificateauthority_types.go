@@ -22,10 +22,22 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// ResourceCredentialsSecretCertificateKey is the key of the PEM encoded
+	// certificate of the CertificateAuthority in the connection secret.
+	ResourceCredentialsSecretCertificateKey = "certificate"
+
+	// ResourceCredentialsSecretCertificateChainKey is the key of the PEM
+	// encoded certificate chain of the CertificateAuthority in the connection
+	// secret.
+	ResourceCredentialsSecretCertificateChainKey = "certificateChain"
+)
+
 // CertificateAuthorityParameters defines the desired state of an AWS CertificateAuthority.
 type CertificateAuthorityParameters struct {
 	// Region is the region you'd like your CertificateAuthority to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// Type of the certificate authority
 	// +kubebuilder:validation:Enum=ROOT;SUBORDINATE
@@ -51,6 +63,59 @@ type CertificateAuthorityParameters struct {
 
 	// One or more resource tags to associate with the certificateAuthority.
 	Tags []Tag `json:"tags"`
+
+	// Activation configures the issuance and the import of the CA certificate
+	// once the certificateAuthority is waiting for it. ROOT CAs issue their
+	// own certificate while SUBORDINATE CAs get it issued by the parent
+	// certificateAuthority. The certificateAuthority is left in
+	// PENDING_CERTIFICATE status if this is not given.
+	// +optional
+	Activation *Activation `json:"activation,omitempty"`
+}
+
+// Activation is the configuration of the CA certificate that activates a
+// certificateAuthority.
+type Activation struct {
+	// Validity of the CA certificate.
+	Validity Validity `json:"validity"`
+
+	// Algorithm that the issuing CA uses to sign the CA certificate. Defaults
+	// to the signing algorithm of the issuing CA.
+	// +optional
+	// +kubebuilder:validation:Enum=SHA512WITHECDSA;SHA256WITHECDSA;SHA384WITHECDSA;SHA512WITHRSA;SHA256WITHRSA;SHA384WITHRSA
+	SigningAlgorithm *types.SigningAlgorithm `json:"signingAlgorithm,omitempty"`
+
+	// ARN of the template used to issue the CA certificate. Defaults to
+	// RootCACertificate/V1 for ROOT CAs and to
+	// SubordinateCACertificate_PathLen0/V1 for SUBORDINATE CAs.
+	// +optional
+	TemplateARN *string `json:"templateArn,omitempty"`
+
+	// ARN of the certificateAuthority that issues the CA certificate of a
+	// SUBORDINATE certificateAuthority.
+	// +optional
+	ParentCertificateAuthorityARN *string `json:"parentCertificateAuthorityArn,omitempty"`
+
+	// ParentCertificateAuthorityARNRef references a CertificateAuthority to
+	// retrieve its ARN.
+	// +optional
+	ParentCertificateAuthorityARNRef *xpv1.Reference `json:"parentCertificateAuthorityArnRef,omitempty"`
+
+	// ParentCertificateAuthorityARNSelector selects a reference to a
+	// CertificateAuthority to retrieve its ARN.
+	// +optional
+	ParentCertificateAuthorityARNSelector *xpv1.Selector `json:"parentCertificateAuthorityArnSelector,omitempty"`
+}
+
+// Validity is the period of time during which a certificate is valid.
+type Validity struct {
+	// Type of the validity period.
+	// +kubebuilder:validation:Enum=DAYS;MONTHS;YEARS
+	Type types.ValidityPeriodType `json:"type"`
+
+	// Value of the validity period in units of the type.
+	// +kubebuilder:validation:Minimum=1
+	Value int64 `json:"value"`
 }
 
 // Tag represents user-provided metadata that can be associated
@@ -175,6 +240,9 @@ type CertificateAuthorityExternalStatus struct {
 
 	// Status is the current status of the CertificateAuthority.
 	Status string `json:"status,omitempty"`
+
+	// ARN of the certificate issued to activate the CertificateAuthority.
+	ActivationCertificateARN string `json:"activationCertificateARN,omitempty"`
 }
 
 // CertificateAuthoritySpec defines the desired state of CertificateAuthority
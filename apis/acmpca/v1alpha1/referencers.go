@@ -45,3 +45,27 @@ func (mg *CertificateAuthorityPermission) ResolveReferences(ctx context.Context,
 
 	return nil
 }
+
+// ResolveReferences of this CertificateAuthority
+func (mg *CertificateAuthority) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.Activation == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.activation.parentCertificateAuthorityArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARN),
+		Reference:    mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARNRef,
+		Selector:     mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARNSelector,
+		To:           reference.To{Managed: &CertificateAuthority{}, List: &CertificateAuthorityList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.activation.parentCertificateAuthorityArn")
+	}
+	mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARNRef = rsp.ResolvedReference
+
+	return nil
+}
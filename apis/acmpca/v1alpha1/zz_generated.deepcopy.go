@@ -21,10 +21,52 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Activation) DeepCopyInto(out *Activation) {
+	*out = *in
+	out.Validity = in.Validity
+	if in.SigningAlgorithm != nil {
+		in, out := &in.SigningAlgorithm, &out.SigningAlgorithm
+		*out = new(types.SigningAlgorithm)
+		**out = **in
+	}
+	if in.TemplateARN != nil {
+		in, out := &in.TemplateARN, &out.TemplateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentCertificateAuthorityARN != nil {
+		in, out := &in.ParentCertificateAuthorityARN, &out.ParentCertificateAuthorityARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentCertificateAuthorityARNRef != nil {
+		in, out := &in.ParentCertificateAuthorityARNRef, &out.ParentCertificateAuthorityARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ParentCertificateAuthorityARNSelector != nil {
+		in, out := &in.ParentCertificateAuthorityARNSelector, &out.ParentCertificateAuthorityARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Activation.
+func (in *Activation) DeepCopy() *Activation {
+	if in == nil {
+		return nil
+	}
+	out := new(Activation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateAuthority) DeepCopyInto(out *CertificateAuthority) {
 	*out = *in
@@ -139,6 +181,11 @@ func (in *CertificateAuthorityParameters) DeepCopyInto(out *CertificateAuthority
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.Activation != nil {
+		in, out := &in.Activation, &out.Activation
+		*out = new(Activation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityParameters.
@@ -416,3 +463,18 @@ func (in *Tag) DeepCopy() *Tag {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Validity) DeepCopyInto(out *Validity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Validity.
+func (in *Validity) DeepCopy() *Validity {
+	if in == nil {
+		return nil
+	}
+	out := new(Validity)
+	in.DeepCopyInto(out)
+	return out
+}
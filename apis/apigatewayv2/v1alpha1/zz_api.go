@@ -27,8 +27,8 @@ import (
 // APIParameters defines the desired state of API
 type APIParameters struct {
 	// Region is which region the API will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	APIKeySelectionExpression *string `json:"apiKeySelectionExpression,omitempty"`
 
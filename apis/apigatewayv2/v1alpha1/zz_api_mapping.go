@@ -27,8 +27,8 @@ import (
 // APIMappingParameters defines the desired state of APIMapping
 type APIMappingParameters struct {
 	// Region is which region the APIMapping will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	APIMappingKey              *string `json:"apiMappingKey,omitempty"`
 	CustomAPIMappingParameters `json:",inline"`
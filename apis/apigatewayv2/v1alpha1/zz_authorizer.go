@@ -27,8 +27,8 @@ import (
 // AuthorizerParameters defines the desired state of Authorizer
 type AuthorizerParameters struct {
 	// Region is which region the Authorizer will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	AuthorizerCredentialsARN *string `json:"authorizerCredentialsARN,omitempty"`
 
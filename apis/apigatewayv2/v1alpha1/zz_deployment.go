@@ -27,8 +27,8 @@ import (
 // DeploymentParameters defines the desired state of Deployment
 type DeploymentParameters struct {
 	// Region is which region the Deployment will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	Description *string `json:"description,omitempty"`
 
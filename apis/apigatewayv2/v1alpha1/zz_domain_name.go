@@ -27,8 +27,8 @@ import (
 // DomainNameParameters defines the desired state of DomainName
 type DomainNameParameters struct {
 	// Region is which region the DomainName will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	DomainNameConfigurations []*DomainNameConfiguration `json:"domainNameConfigurations,omitempty"`
 
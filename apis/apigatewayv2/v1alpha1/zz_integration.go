@@ -27,8 +27,8 @@ import (
 // IntegrationParameters defines the desired state of Integration
 type IntegrationParameters struct {
 	// Region is which region the Integration will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ConnectionID *string `json:"connectionID,omitempty"`
 
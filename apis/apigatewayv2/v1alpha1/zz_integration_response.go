@@ -27,8 +27,8 @@ import (
 // IntegrationResponseParameters defines the desired state of IntegrationResponse
 type IntegrationResponseParameters struct {
 	// Region is which region the IntegrationResponse will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ContentHandlingStrategy *string `json:"contentHandlingStrategy,omitempty"`
 
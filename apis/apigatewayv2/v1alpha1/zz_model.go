@@ -27,8 +27,8 @@ import (
 // ModelParameters defines the desired state of Model
 type ModelParameters struct {
 	// Region is which region the Model will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ContentType *string `json:"contentType,omitempty"`
 
@@ -27,8 +27,8 @@ import (
 // RouteParameters defines the desired state of Route
 type RouteParameters struct {
 	// Region is which region the Route will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	APIKeyRequired *bool `json:"apiKeyRequired,omitempty"`
 
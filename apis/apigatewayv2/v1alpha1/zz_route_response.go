@@ -27,8 +27,8 @@ import (
 // RouteResponseParameters defines the desired state of RouteResponse
 type RouteResponseParameters struct {
 	// Region is which region the RouteResponse will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ModelSelectionExpression *string `json:"modelSelectionExpression,omitempty"`
 
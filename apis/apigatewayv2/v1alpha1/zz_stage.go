@@ -27,8 +27,8 @@ import (
 // StageParameters defines the desired state of Stage
 type StageParameters struct {
 	// Region is which region the Stage will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	AccessLogSettings *AccessLogSettings `json:"accessLogSettings,omitempty"`
 
@@ -27,8 +27,8 @@ import (
 // VPCLinkParameters defines the desired state of VPCLink
 type VPCLinkParameters struct {
 	// Region is which region the VPCLink will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// +kubebuilder:validation:Required
 	Name *string `json:"name"`
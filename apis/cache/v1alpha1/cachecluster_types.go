@@ -169,7 +169,8 @@ type CacheClusterObservation struct {
 // https://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateReplicationGroup.html#API_CreateReplicationGroup_RequestParameters
 type CacheClusterParameters struct {
 	// Region is the region you'd like your CacheSubnetGroup to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// If true, this parameter causes the modifications in this request and any
 	// pending modifications to be applied, asynchronously and as soon as possible,
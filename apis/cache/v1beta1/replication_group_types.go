@@ -135,6 +135,10 @@ type ReplicationGroupPendingModifiedValues struct {
 	// failover for this Redis replication group.
 	AutomaticFailoverStatus string `json:"automaticFailoverStatus,omitempty"`
 
+	// AuthTokenStatus is the status of the auth token update, either SETTING
+	// or ROTATING.
+	AuthTokenStatus string `json:"authTokenStatus,omitempty"`
+
 	// PrimaryClusterID that is applied immediately or during the next
 	// maintenance window.
 	PrimaryClusterID string `json:"primaryClusterId,omitempty"`
@@ -195,6 +199,18 @@ type ReplicationGroupObservation struct {
 	// Status is the current state of this replication group - creating,
 	// available, modifying, deleting, create-failed, snapshotting.
 	Status string `json:"status,omitempty"`
+
+	// AuthTokenUpdateStrategy is the strategy of the last auth token update
+	// made to rotate the auth token to the one in AuthTokenSecretRef. It is
+	// ROTATE while both the old and the new tokens are accepted and SET once
+	// the old token is being removed. It is cleared when the rotation is
+	// completed.
+	AuthTokenUpdateStrategy string `json:"authTokenUpdateStrategy,omitempty"`
+
+	// AuthTokenUpdateHash is the SHA-256 hash of the auth token that the last
+	// auth token update was made with. The rotation is restarted if the token
+	// in AuthTokenSecretRef changes before it is completed.
+	AuthTokenUpdateHash string `json:"authTokenUpdateHash,omitempty"`
 }
 
 // A Tag is used to tag the ElastiCache resources in AWS.
@@ -275,11 +291,23 @@ type ReplicationGroupParameters struct {
 	// While ReplicationGroupSpec mirrors the fields of the upstream replication
 	// group object as closely as possible, we expose a boolean here rather than
 	// requiring the operator pass in a string authentication token. Crossplane
-	// will generate a token automatically and expose it via a Secret.
+	// will generate a token automatically and expose it via a Secret unless
+	// AuthTokenSecretRef is given.
 	// +immutable
 	// +optional
 	AuthEnabled *bool `json:"authEnabled,omitempty"`
 
+	// AuthTokenSecretRef references the secret key that contains the auth
+	// token of the replication group when AuthEnabled is true. When the token
+	// in the secret changes, the replication group is modified to accept both
+	// the old and the new tokens first and then only the new one. The
+	// connection secret is updated after the rotation is completed.
+	// Changes of the token are detected by comparing it with the one in the
+	// connection secret, so the token is rotated only if
+	// WriteConnectionSecretToReference is given.
+	// +optional
+	AuthTokenSecretRef *xpv1.SecretKeySelector `json:"authTokenSecretRef,omitempty"`
+
 	// AutomaticFailoverEnabled specifies whether a read-only replica is
 	// automatically promoted to read/write primary if the existing primary
 	// fails. If true, Multi-AZ is enabled for this replication group. If false,
@@ -27,8 +27,8 @@ import (
 // CachePolicyParameters defines the desired state of CachePolicy
 type CachePolicyParameters struct {
 	// Region is which region the CachePolicy will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A cache policy configuration.
 	// +kubebuilder:validation:Required
 	CachePolicyConfig           *CachePolicyConfig `json:"cachePolicyConfig"`
@@ -27,8 +27,8 @@ import (
 // DistributionParameters defines the desired state of Distribution
 type DistributionParameters struct {
 	// Region is which region the Distribution will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The distribution's configuration information.
 	// +kubebuilder:validation:Required
 	DistributionConfig           *DistributionConfig `json:"distributionConfig"`
@@ -27,8 +27,8 @@ import (
 // LogGroupParameters defines the desired state of LogGroup
 type LogGroupParameters struct {
 	// Region is which region the LogGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The name of the log group.
 	// +kubebuilder:validation:Required
 	LogGroupName *string `json:"logGroupName"`
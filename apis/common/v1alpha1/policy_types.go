@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PolicyDocument is a structured AWS IAM policy document. It can be used in
+// place of the JSON policy string of the resources that accept a resource or
+// an identity based policy.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_grammar.html
+type PolicyDocument struct {
+	// Version is the version of the policy language.
+	// +kubebuilder:validation:Enum="2012-10-17";"2008-10-17"
+	// +kubebuilder:default:="2012-10-17"
+	Version string `json:"version"`
+
+	// ID is the optional identifier of the policy.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Statements is the list of statements of the policy.
+	Statements []PolicyStatement `json:"statements"`
+}
+
+// PolicyStatement is a single statement of a PolicyDocument.
+type PolicyStatement struct {
+	// SID is the optional identifier of the statement. It must be unique in
+	// the policy if it is given.
+	// +optional
+	SID *string `json:"sid,omitempty"`
+
+	// Effect specifies whether the statement results in an allow or an
+	// explicit deny.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Principal specifies the principals that are allowed or denied access
+	// to the resources.
+	// +optional
+	Principal *PolicyPrincipal `json:"principal,omitempty"`
+
+	// NotPrincipal specifies the principals that are excluded from the
+	// statement.
+	// +optional
+	NotPrincipal *PolicyPrincipal `json:"notPrincipal,omitempty"`
+
+	// Action is the list of actions that are allowed or denied.
+	// +optional
+	Action []string `json:"action,omitempty"`
+
+	// NotAction is the list of actions that are excluded from the statement.
+	// +optional
+	NotAction []string `json:"notAction,omitempty"`
+
+	// Resource is the list of ARNs of the resources that the statement
+	// covers.
+	// +optional
+	Resource []string `json:"resource,omitempty"`
+
+	// ResourceRefs reference the managed resources whose ARNs are added to
+	// the resources of the statement.
+	// +optional
+	ResourceRefs []PolicyResourceReference `json:"resourceRefs,omitempty"`
+
+	// NotResource is the list of ARNs of the resources that are excluded
+	// from the statement.
+	// +optional
+	NotResource []string `json:"notResource,omitempty"`
+
+	// Condition is the list of conditions for the statement to be in effect.
+	// +optional
+	Condition []PolicyCondition `json:"condition,omitempty"`
+}
+
+// PolicyPrincipal specifies the principals of a PolicyStatement.
+type PolicyPrincipal struct {
+	// AllowAnon makes the statement apply to everyone, i.e. "*".
+	// +optional
+	AllowAnon bool `json:"allowAnon,omitempty"`
+
+	// AWSPrincipals is the list of AWS accounts, IAM users and IAM roles.
+	// +optional
+	AWSPrincipals []AWSPrincipal `json:"awsPrincipals,omitempty"`
+
+	// Federated is the list of web identity or SAML providers.
+	// +optional
+	Federated []string `json:"federated,omitempty"`
+
+	// Service is the list of AWS services, e.g. ec2.amazonaws.com.
+	// +optional
+	Service []string `json:"service,omitempty"`
+}
+
+// AWSPrincipal is an AWS account, an IAM user or an IAM role. Only one of
+// them should be given.
+type AWSPrincipal struct {
+	// AWSAccountID is the ID of an AWS account.
+	// +optional
+	AWSAccountID *string `json:"awsAccountId,omitempty"`
+
+	// IAMUserARN is the ARN of an IAM user.
+	// +optional
+	IAMUserARN *string `json:"iamUserArn,omitempty"`
+
+	// IAMUserARNRef references an IAMUser to retrieve its ARN.
+	// +optional
+	IAMUserARNRef *xpv1.Reference `json:"iamUserArnRef,omitempty"`
+
+	// IAMUserARNSelector selects an IAMUser to retrieve its ARN.
+	// +optional
+	IAMUserARNSelector *xpv1.Selector `json:"iamUserArnSelector,omitempty"`
+
+	// IAMRoleARN is the ARN of an IAM role.
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector selects an IAMRole to retrieve its ARN.
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleArnSelector,omitempty"`
+}
+
+// PolicyResourceReference references a managed resource whose ARN is used
+// as a resource of a PolicyStatement. Only one of the references should be
+// given.
+type PolicyResourceReference struct {
+	// ARN is the resolved ARN of the referenced resource.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// BucketRef references an S3 Bucket.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// KeyRef references a KMS Key.
+	// +optional
+	KeyRef *xpv1.Reference `json:"keyRef,omitempty"`
+
+	// RoleRef references an IAM Role.
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// Suffix is appended to the resolved ARN, e.g. "/*" to cover all the
+	// objects of a bucket.
+	// +optional
+	Suffix string `json:"suffix,omitempty"`
+}
+
+// PolicyCondition is the set of conditions that use the same operator.
+type PolicyCondition struct {
+	// OperatorKey is the condition operator, e.g. StringEquals or ArnLike.
+	OperatorKey string `json:"operatorKey"`
+
+	// Conditions is the list of condition keys and their values.
+	Conditions []PolicyConditionPair `json:"conditions"`
+}
+
+// PolicyConditionPair is a condition key and the values it is compared with.
+type PolicyConditionPair struct {
+	// Key is the condition key, e.g. aws:SourceArn.
+	Key string `json:"key"`
+
+	// Values is the list of values of the key. Boolean and numeric values
+	// are given as strings.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
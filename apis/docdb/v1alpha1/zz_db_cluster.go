@@ -27,8 +27,8 @@ import (
 // DBClusterParameters defines the desired state of DBCluster
 type DBClusterParameters struct {
 	// Region is which region the DBCluster will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A list of Amazon EC2 Availability Zones that instances in the cluster can
 	// be created in.
 	AvailabilityZones []*string `json:"availabilityZones,omitempty"`
@@ -27,8 +27,8 @@ import (
 // DBClusterParameterGroupParameters defines the desired state of DBClusterParameterGroup
 type DBClusterParameterGroupParameters struct {
 	// Region is which region the DBClusterParameterGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The cluster parameter group family name.
 	// +kubebuilder:validation:Required
 	DBParameterGroupFamily *string `json:"dbParameterGroupFamily"`
@@ -27,8 +27,8 @@ import (
 // DBInstanceParameters defines the desired state of DBInstance
 type DBInstanceParameters struct {
 	// Region is which region the DBInstance will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Indicates that minor engine upgrades are applied automatically to the instance
 	// during the maintenance window.
 	//
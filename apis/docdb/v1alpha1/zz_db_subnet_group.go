@@ -27,8 +27,8 @@ import (
 // DBSubnetGroupParameters defines the desired state of DBSubnetGroup
 type DBSubnetGroupParameters struct {
 	// Region is which region the DBSubnetGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The description for the subnet group.
 	// +kubebuilder:validation:Required
 	DBSubnetGroupDescription *string `json:"dbSubnetGroupDescription"`
@@ -32,7 +32,80 @@ type CustomBackupParameters struct {
 }
 
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// TimeToLive configures the expiry of the items in the table. Time to live
+	// settings of the table are not managed if it is not given.
+	// +optional
+	TimeToLive *TimeToLive `json:"timeToLive,omitempty"`
+
+	// PointInTimeRecoveryEnabled indicates whether continuous backups with
+	// point in time recovery should be enabled for the table. It is not
+	// managed if it is not given.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+
+	// ContributorInsightsEnabled indicates whether CloudWatch Contributor
+	// Insights should be enabled for the table. It is not managed if it is
+	// not given.
+	// +optional
+	ContributorInsightsEnabled *bool `json:"contributorInsightsEnabled,omitempty"`
+
+	// KinesisStreamingDestinations is the list of Kinesis data streams that
+	// the item-level changes of the table are streamed to. Streaming to the
+	// destinations that are not in this list is disabled. The destinations
+	// are not managed if it is not given.
+	// +optional
+	KinesisStreamingDestinations []KinesisStreamingDestination `json:"kinesisStreamingDestinations,omitempty"`
+}
+
+// TimeToLive configures the expiry of the items in a table.
+type TimeToLive struct {
+	// AttributeName is the name of the attribute that stores the expiry time
+	// of the items in epoch seconds.
+	AttributeName string `json:"attributeName"`
+
+	// Enabled indicates whether the items should expire.
+	Enabled bool `json:"enabled"`
+}
+
+// KinesisStreamingDestination is a Kinesis data stream that the item-level
+// changes of a table are streamed to.
+type KinesisStreamingDestination struct {
+	// StreamARN is the ARN of the Kinesis data stream.
+	StreamARN string `json:"streamARN"`
+}
+
+// CustomTableObservation includes the custom status fields of Table.
+type CustomTableObservation struct {
+	// TimeToLive is the observed time to live settings of the table.
+	TimeToLive *TimeToLiveDescription `json:"timeToLive,omitempty"`
+
+	// PointInTimeRecoveryStatus is the status of point in time recovery of
+	// the table. It is either ENABLED or DISABLED.
+	PointInTimeRecoveryStatus *string `json:"pointInTimeRecoveryStatus,omitempty"`
+
+	// ContributorInsightsStatus is the status of CloudWatch Contributor
+	// Insights of the table.
+	ContributorInsightsStatus *string `json:"contributorInsightsStatus,omitempty"`
+
+	// KinesisStreamingDestinations is the list of Kinesis data streams that
+	// are or were the destinations of the table.
+	KinesisStreamingDestinations []KinesisStreamingDestinationObservation `json:"kinesisStreamingDestinations,omitempty"`
+}
+
+// KinesisStreamingDestinationObservation is the observed state of a Kinesis
+// streaming destination of a table.
+type KinesisStreamingDestinationObservation struct {
+	// StreamARN is the ARN of the Kinesis data stream.
+	StreamARN *string `json:"streamARN,omitempty"`
+
+	// DestinationStatus is the status of the streaming to the destination.
+	DestinationStatus *string `json:"destinationStatus,omitempty"`
+
+	// DestinationStatusDescription is the human-readable description of the
+	// destination status.
+	DestinationStatusDescription *string `json:"destinationStatusDescription,omitempty"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
@@ -27,8 +27,8 @@ import (
 // BackupParameters defines the desired state of Backup
 type BackupParameters struct {
 	// Region is which region the Backup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Specified name for the backup.
 	// +kubebuilder:validation:Required
 	BackupName             *string `json:"backupName"`
@@ -703,9 +703,66 @@ func (in *CustomGlobalTableParameters) DeepCopy() *CustomGlobalTableParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTableObservation) DeepCopyInto(out *CustomTableObservation) {
+	*out = *in
+	if in.TimeToLive != nil {
+		in, out := &in.TimeToLive, &out.TimeToLive
+		*out = new(TimeToLiveDescription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PointInTimeRecoveryStatus != nil {
+		in, out := &in.PointInTimeRecoveryStatus, &out.PointInTimeRecoveryStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContributorInsightsStatus != nil {
+		in, out := &in.ContributorInsightsStatus, &out.ContributorInsightsStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.KinesisStreamingDestinations != nil {
+		in, out := &in.KinesisStreamingDestinations, &out.KinesisStreamingDestinations
+		*out = make([]KinesisStreamingDestinationObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTableObservation.
+func (in *CustomTableObservation) DeepCopy() *CustomTableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTableParameters) DeepCopyInto(out *CustomTableParameters) {
 	*out = *in
+	if in.TimeToLive != nil {
+		in, out := &in.TimeToLive, &out.TimeToLive
+		*out = new(TimeToLive)
+		**out = **in
+	}
+	if in.PointInTimeRecoveryEnabled != nil {
+		in, out := &in.PointInTimeRecoveryEnabled, &out.PointInTimeRecoveryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContributorInsightsEnabled != nil {
+		in, out := &in.ContributorInsightsEnabled, &out.ContributorInsightsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KinesisStreamingDestinations != nil {
+		in, out := &in.KinesisStreamingDestinations, &out.KinesisStreamingDestinations
+		*out = make([]KinesisStreamingDestination, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTableParameters.
@@ -1376,6 +1433,51 @@ func (in *KinesisDataStreamDestination) DeepCopy() *KinesisDataStreamDestination
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KinesisStreamingDestination) DeepCopyInto(out *KinesisStreamingDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisStreamingDestination.
+func (in *KinesisStreamingDestination) DeepCopy() *KinesisStreamingDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(KinesisStreamingDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KinesisStreamingDestinationObservation) DeepCopyInto(out *KinesisStreamingDestinationObservation) {
+	*out = *in
+	if in.StreamARN != nil {
+		in, out := &in.StreamARN, &out.StreamARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationStatus != nil {
+		in, out := &in.DestinationStatus, &out.DestinationStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationStatusDescription != nil {
+		in, out := &in.DestinationStatusDescription, &out.DestinationStatusDescription
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisStreamingDestinationObservation.
+func (in *KinesisStreamingDestinationObservation) DeepCopy() *KinesisStreamingDestinationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KinesisStreamingDestinationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalSecondaryIndex) DeepCopyInto(out *LocalSecondaryIndex) {
 	*out = *in
@@ -2612,6 +2714,7 @@ func (in *TableObservation) DeepCopyInto(out *TableObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	in.CustomTableObservation.DeepCopyInto(&out.CustomTableObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableObservation.
@@ -2702,7 +2805,7 @@ func (in *TableParameters) DeepCopyInto(out *TableParameters) {
 			}
 		}
 	}
-	out.CustomTableParameters = in.CustomTableParameters
+	in.CustomTableParameters.DeepCopyInto(&out.CustomTableParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
@@ -2774,6 +2877,21 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeToLive) DeepCopyInto(out *TimeToLive) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeToLive.
+func (in *TimeToLive) DeepCopy() *TimeToLive {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeToLive)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeToLiveDescription) DeepCopyInto(out *TimeToLiveDescription) {
 	*out = *in
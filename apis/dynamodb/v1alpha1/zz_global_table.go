@@ -27,8 +27,8 @@ import (
 // GlobalTableParameters defines the desired state of GlobalTable
 type GlobalTableParameters struct {
 	// Region is which region the GlobalTable will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The Regions where the global table needs to be created.
 	// +kubebuilder:validation:Required
 	ReplicationGroup            []*Replica `json:"replicationGroup"`
@@ -27,8 +27,8 @@ import (
 // TableParameters defines the desired state of Table
 type TableParameters struct {
 	// Region is which region the Table will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// An array of attributes that describe the key schema for the table and indexes.
 	// +kubebuilder:validation:Required
 	AttributeDefinitions []*AttributeDefinition `json:"attributeDefinitions"`
@@ -230,7 +230,8 @@ type TableObservation struct {
 	//
 	//    * ARCHIVED - The table has been archived. See the ArchivalReason for more
 	//    information.
-	TableStatus *string `json:"tableStatus,omitempty"`
+	TableStatus            *string `json:"tableStatus,omitempty"`
+	CustomTableObservation `json:",inline"`
 }
 
 // TableStatus defines the observed state of Table.
@@ -23,6 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
@@ -313,6 +315,15 @@ type IAMInstanceProfileSpecification struct {
 	// The name of the instance profile.
 	// +optional
 	Name *string `json:"name,omitempty"`
+
+	// NameRef is a reference to an IAMInstanceProfile used to set the Name.
+	// +optional
+	NameRef *xpv1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to an IAMInstanceProfile used to set
+	// the Name.
+	// +optional
+	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
 }
 
 // InstanceBlockDeviceMapping describes a block device mapping.
@@ -221,7 +221,8 @@ type InstanceParameters struct {
 	RAMDiskID *string `json:"ramDiskId,omitempty"`
 
 	// Region is the region you'd like your Instance to be created in.
-	Region *string `json:"region"`
+	// +optional
+	Region *string `json:"region,omitempty"`
 
 	// The IDs of the security groups. You can create a security group using CreateSecurityGroup
 	// (https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateSecurityGroup.html).
@@ -241,6 +242,16 @@ type InstanceParameters struct {
 	// +optional
 	SecurityGroupSelector *xpv1.Selector `json:"securityGroupSelector,omitempty"`
 
+	// State is the desired state of the instance. The instance is stopped or
+	// started whenever its state differs. Changes to the instance type, EBS
+	// optimization, kernel, RAM disk and user data of a running instance are
+	// applied by stopping the instance, modifying it and starting it again.
+	//
+	// Default: running
+	// +kubebuilder:validation:Enum=running;stopped
+	// +optional
+	State *string `json:"state,omitempty"`
+
 	// [EC2-VPC] The ID of the subnet to launch the instance into.
 	//
 	// If you specify a network interface, you must specify any subnets as part
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
 )
 
 // ResolveReferences of this Instance
@@ -79,5 +80,21 @@ func (mg *Instance) ResolveReferences(ctx context.Context, c client.Reader) erro
 	mg.Spec.ForProvider.SubnetID = aws.String(rsp.ResolvedValue)
 	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.iamInstanceProfile.name
+	if mg.Spec.ForProvider.IAMInstanceProfile != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IAMInstanceProfile.Name),
+			Reference:    mg.Spec.ForProvider.IAMInstanceProfile.NameRef,
+			Selector:     mg.Spec.ForProvider.IAMInstanceProfile.NameSelector,
+			To:           reference.To{Managed: &iamv1beta1.IAMInstanceProfile{}, List: &iamv1beta1.IAMInstanceProfileList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.iamInstanceProfile.name")
+		}
+		mg.Spec.ForProvider.IAMInstanceProfile.Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.IAMInstanceProfile.NameRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
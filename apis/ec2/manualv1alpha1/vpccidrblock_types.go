@@ -25,7 +25,8 @@ import (
 // VPCCIDRBlockParameters define the desired state of an VPC CIDR Block
 type VPCCIDRBlockParameters struct {
 	// Region is the region you'd like your VPC CIDR to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// Requests an Amazon-provided IPv6 CIDR block with a /56 prefix length for
 	// the VPC. You cannot specify the range of IPv6 addresses, or the size of the
@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -60,3 +62,15 @@ func (mg *VPCPeeringConnection) ResolveReferences(ctx context.Context, c client.
 
 	return nil
 }
+
+// LaunchTemplateLatestVersion returns the latest version number of a
+// LaunchTemplate.
+func LaunchTemplateLatestVersion() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*LaunchTemplate)
+		if !ok || cr.Status.AtProvider.LatestVersionNumber == nil {
+			return ""
+		}
+		return strconv.FormatInt(*cr.Status.AtProvider.LatestVersionNumber, 10)
+	}
+}
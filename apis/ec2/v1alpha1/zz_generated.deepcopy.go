@@ -1051,6 +1051,11 @@ func (in *CapacityReservationGroup) DeepCopy() *CapacityReservationGroup {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CapacityReservationTarget) DeepCopyInto(out *CapacityReservationTarget) {
 	*out = *in
+	if in.CapacityReservationID != nil {
+		in, out := &in.CapacityReservationID, &out.CapacityReservationID
+		*out = new(string)
+		**out = **in
+	}
 	if in.CapacityReservationResourceGroupARN != nil {
 		in, out := &in.CapacityReservationResourceGroupARN, &out.CapacityReservationResourceGroupARN
 		*out = new(string)
@@ -1970,6 +1975,21 @@ func (in *CreditSpecificationRequest) DeepCopy() *CreditSpecificationRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomLaunchTemplateParameters) DeepCopyInto(out *CustomLaunchTemplateParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLaunchTemplateParameters.
+func (in *CustomLaunchTemplateParameters) DeepCopy() *CustomLaunchTemplateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomLaunchTemplateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomVPCPeeringConnectionParameters) DeepCopyInto(out *CustomVPCPeeringConnectionParameters) {
 	*out = *in
@@ -5524,31 +5544,10 @@ func (in *LaunchSpecification) DeepCopy() *LaunchSpecification {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplate) DeepCopyInto(out *LaunchTemplate) {
 	*out = *in
-	if in.CreateTime != nil {
-		in, out := &in.CreateTime, &out.CreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.CreatedBy != nil {
-		in, out := &in.CreatedBy, &out.CreatedBy
-		*out = new(string)
-		**out = **in
-	}
-	if in.LaunchTemplateID != nil {
-		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplate.
@@ -5561,6 +5560,14 @@ func (in *LaunchTemplate) DeepCopy() *LaunchTemplate {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LaunchTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateBlockDeviceMapping) DeepCopyInto(out *LaunchTemplateBlockDeviceMapping) {
 	*out = *in
@@ -5599,6 +5606,11 @@ func (in *LaunchTemplateBlockDeviceMappingRequest) DeepCopyInto(out *LaunchTempl
 		*out = new(string)
 		**out = **in
 	}
+	if in.EBS != nil {
+		in, out := &in.EBS, &out.EBS
+		*out = new(LaunchTemplateEBSBlockDeviceRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NoDevice != nil {
 		in, out := &in.NoDevice, &out.NoDevice
 		*out = new(string)
@@ -5621,6 +5633,56 @@ func (in *LaunchTemplateBlockDeviceMappingRequest) DeepCopy() *LaunchTemplateBlo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateCPUOptionsRequest) DeepCopyInto(out *LaunchTemplateCPUOptionsRequest) {
+	*out = *in
+	if in.CoreCount != nil {
+		in, out := &in.CoreCount, &out.CoreCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ThreadsPerCore != nil {
+		in, out := &in.ThreadsPerCore, &out.ThreadsPerCore
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateCPUOptionsRequest.
+func (in *LaunchTemplateCPUOptionsRequest) DeepCopy() *LaunchTemplateCPUOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateCPUOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateCapacityReservationSpecificationRequest) DeepCopyInto(out *LaunchTemplateCapacityReservationSpecificationRequest) {
+	*out = *in
+	if in.CapacityReservationPreference != nil {
+		in, out := &in.CapacityReservationPreference, &out.CapacityReservationPreference
+		*out = new(string)
+		**out = **in
+	}
+	if in.CapacityReservationTarget != nil {
+		in, out := &in.CapacityReservationTarget, &out.CapacityReservationTarget
+		*out = new(CapacityReservationTarget)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateCapacityReservationSpecificationRequest.
+func (in *LaunchTemplateCapacityReservationSpecificationRequest) DeepCopy() *LaunchTemplateCapacityReservationSpecificationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateCapacityReservationSpecificationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateEBSBlockDevice) DeepCopyInto(out *LaunchTemplateEBSBlockDevice) {
 	*out = *in
@@ -5659,6 +5721,36 @@ func (in *LaunchTemplateEBSBlockDeviceRequest) DeepCopyInto(out *LaunchTemplateE
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateEBSBlockDeviceRequest.
@@ -5674,6 +5766,11 @@ func (in *LaunchTemplateEBSBlockDeviceRequest) DeepCopy() *LaunchTemplateEBSBloc
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateElasticInferenceAccelerator) DeepCopyInto(out *LaunchTemplateElasticInferenceAccelerator) {
 	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Type != nil {
 		in, out := &in.Type, &out.Type
 		*out = new(string)
@@ -5841,6 +5938,61 @@ func (in *LaunchTemplateIAMInstanceProfileSpecificationRequest) DeepCopy() *Laun
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateInstanceMarketOptionsRequest) DeepCopyInto(out *LaunchTemplateInstanceMarketOptionsRequest) {
+	*out = *in
+	if in.MarketType != nil {
+		in, out := &in.MarketType, &out.MarketType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SpotOptions != nil {
+		in, out := &in.SpotOptions, &out.SpotOptions
+		*out = new(LaunchTemplateSpotMarketOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateInstanceMarketOptionsRequest.
+func (in *LaunchTemplateInstanceMarketOptionsRequest) DeepCopy() *LaunchTemplateInstanceMarketOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateInstanceMarketOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateInstanceMetadataOptionsRequest) DeepCopyInto(out *LaunchTemplateInstanceMetadataOptionsRequest) {
+	*out = *in
+	if in.HTTPEndpoint != nil {
+		in, out := &in.HTTPEndpoint, &out.HTTPEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPPutResponseHopLimit != nil {
+		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HTTPTokens != nil {
+		in, out := &in.HTTPTokens, &out.HTTPTokens
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateInstanceMetadataOptionsRequest.
+func (in *LaunchTemplateInstanceMetadataOptionsRequest) DeepCopy() *LaunchTemplateInstanceMetadataOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateInstanceMetadataOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateInstanceNetworkInterfaceSpecification) DeepCopyInto(out *LaunchTemplateInstanceNetworkInterfaceSpecification) {
 	*out = *in
@@ -5909,16 +6061,79 @@ func (in *LaunchTemplateInstanceNetworkInterfaceSpecificationRequest) DeepCopyIn
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeviceIndex != nil {
+		in, out := &in.DeviceIndex, &out.DeviceIndex
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.InterfaceType != nil {
 		in, out := &in.InterfaceType, &out.InterfaceType
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6AddressCount != nil {
+		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]*InstanceIPv6AddressRequest, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InstanceIPv6AddressRequest)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.NetworkCardIndex != nil {
+		in, out := &in.NetworkCardIndex, &out.NetworkCardIndex
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
 	if in.PrivateIPAddress != nil {
 		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrivateIPAddresses != nil {
+		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
+		*out = make([]*PrivateIPAddressSpecification, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PrivateIPAddressSpecification)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SecondaryPrivateIPAddressCount != nil {
+		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateInstanceNetworkInterfaceSpecificationRequest.
@@ -5971,6 +6186,88 @@ func (in *LaunchTemplateLicenseConfigurationRequest) DeepCopy() *LaunchTemplateL
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateList) DeepCopyInto(out *LaunchTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LaunchTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateList.
+func (in *LaunchTemplateList) DeepCopy() *LaunchTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LaunchTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateObservation) DeepCopyInto(out *LaunchTemplateObservation) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedBy != nil {
+		in, out := &in.CreatedBy, &out.CreatedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultVersionNumber != nil {
+		in, out := &in.DefaultVersionNumber, &out.DefaultVersionNumber
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LatestVersionNumber != nil {
+		in, out := &in.LatestVersionNumber, &out.LatestVersionNumber
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateObservation.
+func (in *LaunchTemplateObservation) DeepCopy() *LaunchTemplateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateOverrides) DeepCopyInto(out *LaunchTemplateOverrides) {
 	*out = *in
@@ -6001,6 +6298,48 @@ func (in *LaunchTemplateOverrides) DeepCopy() *LaunchTemplateOverrides {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateParameters) DeepCopyInto(out *LaunchTemplateParameters) {
+	*out = *in
+	if in.LaunchTemplateData != nil {
+		in, out := &in.LaunchTemplateData, &out.LaunchTemplateData
+		*out = new(RequestLaunchTemplateData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LaunchTemplateName != nil {
+		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagSpecifications != nil {
+		in, out := &in.TagSpecifications, &out.TagSpecifications
+		*out = make([]*TagSpecification, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TagSpecification)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VersionDescription != nil {
+		in, out := &in.VersionDescription, &out.VersionDescription
+		*out = new(string)
+		**out = **in
+	}
+	out.CustomLaunchTemplateParameters = in.CustomLaunchTemplateParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateParameters.
+func (in *LaunchTemplateParameters) DeepCopy() *LaunchTemplateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplatePlacement) DeepCopyInto(out *LaunchTemplatePlacement) {
 	*out = *in
@@ -6059,16 +6398,36 @@ func (in *LaunchTemplatePlacementRequest) DeepCopyInto(out *LaunchTemplatePlacem
 		*out = new(string)
 		**out = **in
 	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
 	if in.HostResourceGroupARN != nil {
 		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.PartitionNumber != nil {
+		in, out := &in.PartitionNumber, &out.PartitionNumber
+		*out = new(int64)
+		**out = **in
+	}
 	if in.SpreadDomain != nil {
 		in, out := &in.SpreadDomain, &out.SpreadDomain
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tenancy != nil {
+		in, out := &in.Tenancy, &out.Tenancy
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplatePlacementRequest.
@@ -6081,6 +6440,23 @@ func (in *LaunchTemplatePlacementRequest) DeepCopy() *LaunchTemplatePlacementReq
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateSpec) DeepCopyInto(out *LaunchTemplateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpec.
+func (in *LaunchTemplateSpec) DeepCopy() *LaunchTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
 	*out = *in
@@ -6133,11 +6509,26 @@ func (in *LaunchTemplateSpotMarketOptions) DeepCopy() *LaunchTemplateSpotMarketO
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateSpotMarketOptionsRequest) DeepCopyInto(out *LaunchTemplateSpotMarketOptionsRequest) {
 	*out = *in
+	if in.BlockDurationMinutes != nil {
+		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InstanceInterruptionBehavior != nil {
+		in, out := &in.InstanceInterruptionBehavior, &out.InstanceInterruptionBehavior
+		*out = new(string)
+		**out = **in
+	}
 	if in.MaxPrice != nil {
 		in, out := &in.MaxPrice, &out.MaxPrice
 		*out = new(string)
 		**out = **in
 	}
+	if in.SpotInstanceType != nil {
+		in, out := &in.SpotInstanceType, &out.SpotInstanceType
+		*out = new(string)
+		**out = **in
+	}
 	if in.ValidUntil != nil {
 		in, out := &in.ValidUntil, &out.ValidUntil
 		*out = (*in).DeepCopy()
@@ -6154,6 +6545,23 @@ func (in *LaunchTemplateSpotMarketOptionsRequest) DeepCopy() *LaunchTemplateSpot
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateStatus) DeepCopyInto(out *LaunchTemplateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateStatus.
+func (in *LaunchTemplateStatus) DeepCopy() *LaunchTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateTagSpecification) DeepCopyInto(out *LaunchTemplateTagSpecification) {
 	*out = *in
@@ -6250,6 +6658,61 @@ func (in *LaunchTemplateVersion) DeepCopy() *LaunchTemplateVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplate_SDK) DeepCopyInto(out *LaunchTemplate_SDK) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedBy != nil {
+		in, out := &in.CreatedBy, &out.CreatedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultVersionNumber != nil {
+		in, out := &in.DefaultVersionNumber, &out.DefaultVersionNumber
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LatestVersionNumber != nil {
+		in, out := &in.LatestVersionNumber, &out.LatestVersionNumber
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplateName != nil {
+		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplate_SDK.
+func (in *LaunchTemplate_SDK) DeepCopy() *LaunchTemplate_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplate_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplatesMonitoring) DeepCopyInto(out *LaunchTemplatesMonitoring) {
 	*out = *in
@@ -8364,6 +8827,32 @@ func (in *RemovePrefixListEntry) DeepCopy() *RemovePrefixListEntry {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequestLaunchTemplateData) DeepCopyInto(out *RequestLaunchTemplateData) {
 	*out = *in
+	if in.BlockDeviceMappings != nil {
+		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
+		*out = make([]*LaunchTemplateBlockDeviceMappingRequest, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LaunchTemplateBlockDeviceMappingRequest)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CapacityReservationSpecification != nil {
+		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
+		*out = new(LaunchTemplateCapacityReservationSpecificationRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(LaunchTemplateCPUOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreditSpecification != nil {
+		in, out := &in.CreditSpecification, &out.CreditSpecification
+		*out = new(CreditSpecificationRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DisableAPITermination != nil {
 		in, out := &in.DisableAPITermination, &out.DisableAPITermination
 		*out = new(bool)
@@ -8374,6 +8863,148 @@ func (in *RequestLaunchTemplateData) DeepCopyInto(out *RequestLaunchTemplateData
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ElasticGPUSpecifications != nil {
+		in, out := &in.ElasticGPUSpecifications, &out.ElasticGPUSpecifications
+		*out = make([]*ElasticGPUSpecification, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ElasticGPUSpecification)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ElasticInferenceAccelerators != nil {
+		in, out := &in.ElasticInferenceAccelerators, &out.ElasticInferenceAccelerators
+		*out = make([]*LaunchTemplateElasticInferenceAccelerator, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LaunchTemplateElasticInferenceAccelerator)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(LaunchTemplateEnclaveOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(LaunchTemplateHibernationOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAMInstanceProfile != nil {
+		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
+		*out = new(LaunchTemplateIAMInstanceProfileSpecificationRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceInitiatedShutdownBehavior != nil {
+		in, out := &in.InstanceInitiatedShutdownBehavior, &out.InstanceInitiatedShutdownBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceMarketOptions != nil {
+		in, out := &in.InstanceMarketOptions, &out.InstanceMarketOptions
+		*out = new(LaunchTemplateInstanceMarketOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.KernelID != nil {
+		in, out := &in.KernelID, &out.KernelID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LicenseSpecifications != nil {
+		in, out := &in.LicenseSpecifications, &out.LicenseSpecifications
+		*out = make([]*LaunchTemplateLicenseConfigurationRequest, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LaunchTemplateLicenseConfigurationRequest)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MetadataOptions != nil {
+		in, out := &in.MetadataOptions, &out.MetadataOptions
+		*out = new(LaunchTemplateInstanceMetadataOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(LaunchTemplatesMonitoringRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]*LaunchTemplateInstanceNetworkInterfaceSpecificationRequest, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LaunchTemplateInstanceNetworkInterfaceSpecificationRequest)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(LaunchTemplatePlacementRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RamDiskID != nil {
+		in, out := &in.RamDiskID, &out.RamDiskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.TagSpecifications != nil {
+		in, out := &in.TagSpecifications, &out.TagSpecifications
+		*out = make([]*LaunchTemplateTagSpecificationRequest, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LaunchTemplateTagSpecificationRequest)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.UserData != nil {
 		in, out := &in.UserData, &out.UserData
 		*out = new(string)
@@ -298,6 +298,8 @@ type CapacityReservationGroup struct {
 }
 
 type CapacityReservationTarget struct {
+	CapacityReservationID *string `json:"capacityReservationID,omitempty"`
+
 	CapacityReservationResourceGroupARN *string `json:"capacityReservationResourceGroupARN,omitempty"`
 }
 
@@ -1421,16 +1423,6 @@ type LaunchSpecification struct {
 	UserData *string `json:"userData,omitempty"`
 }
 
-type LaunchTemplate struct {
-	CreateTime *metav1.Time `json:"createTime,omitempty"`
-
-	CreatedBy *string `json:"createdBy,omitempty"`
-
-	LaunchTemplateID *string `json:"launchTemplateID,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-}
-
 type LaunchTemplateBlockDeviceMapping struct {
 	DeviceName *string `json:"deviceName,omitempty"`
 
@@ -1442,11 +1434,25 @@ type LaunchTemplateBlockDeviceMapping struct {
 type LaunchTemplateBlockDeviceMappingRequest struct {
 	DeviceName *string `json:"deviceName,omitempty"`
 
+	EBS *LaunchTemplateEBSBlockDeviceRequest `json:"ebs,omitempty"`
+
 	NoDevice *string `json:"noDevice,omitempty"`
 
 	VirtualName *string `json:"virtualName,omitempty"`
 }
 
+type LaunchTemplateCPUOptionsRequest struct {
+	CoreCount *int64 `json:"coreCount,omitempty"`
+
+	ThreadsPerCore *int64 `json:"threadsPerCore,omitempty"`
+}
+
+type LaunchTemplateCapacityReservationSpecificationRequest struct {
+	CapacityReservationPreference *string `json:"capacityReservationPreference,omitempty"`
+
+	CapacityReservationTarget *CapacityReservationTarget `json:"capacityReservationTarget,omitempty"`
+}
+
 type LaunchTemplateEBSBlockDevice struct {
 	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
 
@@ -1457,9 +1463,23 @@ type LaunchTemplateEBSBlockDeviceRequest struct {
 	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
 
 	Encrypted *bool `json:"encrypted,omitempty"`
+
+	IOPS *int64 `json:"iops,omitempty"`
+
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+
+	SnapshotID *string `json:"snapshotID,omitempty"`
+
+	Throughput *int64 `json:"throughput,omitempty"`
+
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	VolumeType *string `json:"volumeType,omitempty"`
 }
 
 type LaunchTemplateElasticInferenceAccelerator struct {
+	Count *int64 `json:"count,omitempty"`
+
 	Type *string `json:"type_,omitempty"`
 }
 
@@ -1495,6 +1515,20 @@ type LaunchTemplateIAMInstanceProfileSpecificationRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+type LaunchTemplateInstanceMarketOptionsRequest struct {
+	MarketType *string `json:"marketType,omitempty"`
+
+	SpotOptions *LaunchTemplateSpotMarketOptionsRequest `json:"spotOptions,omitempty"`
+}
+
+type LaunchTemplateInstanceMetadataOptionsRequest struct {
+	HTTPEndpoint *string `json:"httpEndpoint,omitempty"`
+
+	HTTPPutResponseHopLimit *int64 `json:"httpPutResponseHopLimit,omitempty"`
+
+	HTTPTokens *string `json:"httpTokens,omitempty"`
+}
+
 type LaunchTemplateInstanceNetworkInterfaceSpecification struct {
 	AssociateCarrierIPAddress *bool `json:"associateCarrierIPAddress,omitempty"`
 
@@ -1518,9 +1552,27 @@ type LaunchTemplateInstanceNetworkInterfaceSpecificationRequest struct {
 
 	Description *string `json:"description,omitempty"`
 
+	DeviceIndex *int64 `json:"deviceIndex,omitempty"`
+
+	Groups []*string `json:"groups,omitempty"`
+
 	InterfaceType *string `json:"interfaceType,omitempty"`
 
+	IPv6AddressCount *int64 `json:"ipv6AddressCount,omitempty"`
+
+	IPv6Addresses []*InstanceIPv6AddressRequest `json:"ipv6Addresses,omitempty"`
+
+	NetworkCardIndex *int64 `json:"networkCardIndex,omitempty"`
+
+	NetworkInterfaceID *string `json:"networkInterfaceID,omitempty"`
+
 	PrivateIPAddress *string `json:"privateIPAddress,omitempty"`
+
+	PrivateIPAddresses []*PrivateIPAddressSpecification `json:"privateIPAddresses,omitempty"`
+
+	SecondaryPrivateIPAddressCount *int64 `json:"secondaryPrivateIPAddressCount,omitempty"`
+
+	SubnetID *string `json:"subnetID,omitempty"`
 }
 
 type LaunchTemplateLicenseConfiguration struct {
@@ -1558,9 +1610,17 @@ type LaunchTemplatePlacementRequest struct {
 
 	AvailabilityZone *string `json:"availabilityZone,omitempty"`
 
+	GroupName *string `json:"groupName,omitempty"`
+
+	HostID *string `json:"hostID,omitempty"`
+
 	HostResourceGroupARN *string `json:"hostResourceGroupARN,omitempty"`
 
+	PartitionNumber *int64 `json:"partitionNumber,omitempty"`
+
 	SpreadDomain *string `json:"spreadDomain,omitempty"`
+
+	Tenancy *string `json:"tenancy,omitempty"`
 }
 
 type LaunchTemplateSpecification struct {
@@ -1576,8 +1636,14 @@ type LaunchTemplateSpotMarketOptions struct {
 }
 
 type LaunchTemplateSpotMarketOptionsRequest struct {
+	BlockDurationMinutes *int64 `json:"blockDurationMinutes,omitempty"`
+
+	InstanceInterruptionBehavior *string `json:"instanceInterruptionBehavior,omitempty"`
+
 	MaxPrice *string `json:"maxPrice,omitempty"`
 
+	SpotInstanceType *string `json:"spotInstanceType,omitempty"`
+
 	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
 }
 
@@ -1603,6 +1669,22 @@ type LaunchTemplateVersion struct {
 	LaunchTemplateID *string `json:"launchTemplateID,omitempty"`
 }
 
+type LaunchTemplate_SDK struct {
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	DefaultVersionNumber *int64 `json:"defaultVersionNumber,omitempty"`
+
+	LatestVersionNumber *int64 `json:"latestVersionNumber,omitempty"`
+
+	LaunchTemplateID *string `json:"launchTemplateID,omitempty"`
+
+	LaunchTemplateName *string `json:"launchTemplateName,omitempty"`
+
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
 type LaunchTemplatesMonitoring struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
@@ -2132,10 +2214,58 @@ type RemovePrefixListEntry struct {
 }
 
 type RequestLaunchTemplateData struct {
+	BlockDeviceMappings []*LaunchTemplateBlockDeviceMappingRequest `json:"blockDeviceMappings,omitempty"`
+
+	CapacityReservationSpecification *LaunchTemplateCapacityReservationSpecificationRequest `json:"capacityReservationSpecification,omitempty"`
+
+	CPUOptions *LaunchTemplateCPUOptionsRequest `json:"cpuOptions,omitempty"`
+
+	CreditSpecification *CreditSpecificationRequest `json:"creditSpecification,omitempty"`
+
 	DisableAPITermination *bool `json:"disableAPITermination,omitempty"`
 
 	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
 
+	ElasticGPUSpecifications []*ElasticGPUSpecification `json:"elasticGPUSpecifications,omitempty"`
+
+	ElasticInferenceAccelerators []*LaunchTemplateElasticInferenceAccelerator `json:"elasticInferenceAccelerators,omitempty"`
+
+	EnclaveOptions *LaunchTemplateEnclaveOptionsRequest `json:"enclaveOptions,omitempty"`
+
+	HibernationOptions *LaunchTemplateHibernationOptionsRequest `json:"hibernationOptions,omitempty"`
+
+	IAMInstanceProfile *LaunchTemplateIAMInstanceProfileSpecificationRequest `json:"iamInstanceProfile,omitempty"`
+
+	ImageID *string `json:"imageID,omitempty"`
+
+	InstanceInitiatedShutdownBehavior *string `json:"instanceInitiatedShutdownBehavior,omitempty"`
+
+	InstanceMarketOptions *LaunchTemplateInstanceMarketOptionsRequest `json:"instanceMarketOptions,omitempty"`
+
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	KernelID *string `json:"kernelID,omitempty"`
+
+	KeyName *string `json:"keyName,omitempty"`
+
+	LicenseSpecifications []*LaunchTemplateLicenseConfigurationRequest `json:"licenseSpecifications,omitempty"`
+
+	MetadataOptions *LaunchTemplateInstanceMetadataOptionsRequest `json:"metadataOptions,omitempty"`
+
+	Monitoring *LaunchTemplatesMonitoringRequest `json:"monitoring,omitempty"`
+
+	NetworkInterfaces []*LaunchTemplateInstanceNetworkInterfaceSpecificationRequest `json:"networkInterfaces,omitempty"`
+
+	Placement *LaunchTemplatePlacementRequest `json:"placement,omitempty"`
+
+	RamDiskID *string `json:"ramDiskID,omitempty"`
+
+	SecurityGroupIDs []*string `json:"securityGroupIDs,omitempty"`
+
+	SecurityGroups []*string `json:"securityGroups,omitempty"`
+
+	TagSpecifications []*LaunchTemplateTagSpecificationRequest `json:"tagSpecifications,omitempty"`
+
 	UserData *string `json:"userData,omitempty"`
 }
 
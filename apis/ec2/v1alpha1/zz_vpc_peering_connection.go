@@ -27,8 +27,8 @@ import (
 // VPCPeeringConnectionParameters defines the desired state of VPCPeeringConnection
 type VPCPeeringConnectionParameters struct {
 	// Region is which region the VPCPeeringConnection will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The AWS account ID of the owner of the accepter VPC.
 	//
 	// Default: Your AWS account ID
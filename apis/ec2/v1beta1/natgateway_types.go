@@ -24,7 +24,8 @@ type NATGatewayParameters struct {
 
 	// Region is the region you'd like your NATGateway to be created in.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// AllocationID is the Elastic IP allocation ID
 	// +immutable
@@ -162,7 +162,8 @@ type AssociationState struct {
 // RouteTableParameters define the desired state of an AWS VPC Route Table.
 type RouteTableParameters struct {
 	// Region is the region you'd like your VPC to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The associations between the route table and one or more subnets.
 	Associations []Association `json:"associations"`
@@ -20,13 +20,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 )
 
 // RepositoryPolicyParameters define the desired state of an AWS Elastic Container Repository
 type RepositoryPolicyParameters struct {
 
 	// Region is the region you'd like your RepositoryPolicy to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// If the policy you are attempting to set on a repository policy would prevent
 	// you from setting another policy in the future, you must force the SetRepositoryPolicy
@@ -44,6 +47,12 @@ type RepositoryPolicyParameters struct {
 	// +optional
 	RawPolicy *string `json:"rawPolicy,omitempty"`
 
+	// PolicyDocument is the shared structured form of the repository policy
+	// whose references are resolved to ARNs. It can be used instead of
+	// policy or rawPolicy.
+	// +optional
+	PolicyDocument *commonv1alpha1.PolicyDocument `json:"policyDocument,omitempty"`
+
 	// The AWS account ID associated with the registry that contains the repository.
 	// If you do not specify a registry, the default registry is assumed.
 	// +optional
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -383,6 +384,11 @@ func (in *RepositoryPolicyParameters) DeepCopyInto(out *RepositoryPolicyParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyDocument != nil {
+		in, out := &in.PolicyDocument, &out.PolicyDocument
+		*out = new(commonv1alpha1.PolicyDocument)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RegistryID != nil {
 		in, out := &in.RegistryID, &out.RegistryID
 		*out = new(string)
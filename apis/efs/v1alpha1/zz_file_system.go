@@ -27,8 +27,8 @@ import (
 // FileSystemParameters defines the desired state of FileSystem
 type FileSystemParameters struct {
 	// Region is which region the FileSystem will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A Boolean value that, if true, creates an encrypted file system. When creating
 	// an encrypted file system, you have the option of specifying CreateFileSystemRequest$KmsKeyId
 	// for an existing AWS Key Management Service (AWS KMS) customer master key
@@ -27,8 +27,8 @@ import (
 // MountTargetParameters defines the desired state of MountTarget
 type MountTargetParameters struct {
 	// Region is which region the MountTarget will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Valid IPv4 address within the address range of the specified subnet.
 	IPAddress                   *string `json:"ipAddress,omitempty"`
 	CustomMountTargetParameters `json:",inline"`
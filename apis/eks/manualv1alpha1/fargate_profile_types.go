@@ -65,7 +65,8 @@ type FargateProfileParameters struct {
 
 	// Region is the region you'd like  the FargateProfile to be created in.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The name of the Amazon EKS cluster to apply the Fargate profile to.
 	//
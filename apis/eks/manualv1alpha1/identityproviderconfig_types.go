@@ -102,7 +102,8 @@ type OIDCIdentityProvider struct {
 type IdentityProviderConfigParameters struct {
 	// Region is the region you'd like the identity provider to be created in.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The name of the cluster to associate the identity provider with.
 	// +immutable
@@ -24,6 +24,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
@@ -91,5 +92,38 @@ func (mg *NodeGroup) ResolveReferences(ctx context.Context, c client.Reader) err
 		mg.Spec.ForProvider.RemoteAccess.SourceSecurityGroupRefs = mrsp.ResolvedReferences
 	}
 
+	// Resolve spec.forProvider.launchTemplate.id
+	if mg.Spec.ForProvider.LaunchTemplate != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LaunchTemplate.ID),
+			Reference:    mg.Spec.ForProvider.LaunchTemplate.IDRef,
+			Selector:     mg.Spec.ForProvider.LaunchTemplate.IDSelector,
+			To:           reference.To{Managed: &ec2v1alpha1.LaunchTemplate{}, List: &ec2v1alpha1.LaunchTemplateList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.launchTemplate.id")
+		}
+		mg.Spec.ForProvider.LaunchTemplate.ID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.LaunchTemplate.IDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.launchTemplate.version
+	if lt := mg.Spec.ForProvider.LaunchTemplate; lt != nil && (lt.VersionRef != nil || lt.VersionSelector != nil) {
+		// The current value is ignored so that the latest version of the
+		// launch template is picked up every time.
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			Reference: lt.VersionRef,
+			Selector:  lt.VersionSelector,
+			To:        reference.To{Managed: &ec2v1alpha1.LaunchTemplate{}, List: &ec2v1alpha1.LaunchTemplateList{}},
+			Extract:   ec2v1alpha1.LaunchTemplateLatestVersion(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.launchTemplate.version")
+		}
+		lt.Version = reference.ToPtrValue(rsp.ResolvedValue)
+		lt.VersionRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
@@ -40,7 +40,8 @@ const (
 // Service NodeGroup.
 type NodeGroupParameters struct {
 	// Region is the region you'd like  the NodeGroup to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The AMI type for your node group.
 	// GPU instance can use
@@ -75,8 +76,11 @@ type NodeGroupParameters struct {
 	// +optional
 	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
 
-	// CapacityType for your node group.
+	// CapacityType for your node group. SPOT node groups are backed by Spot
+	// Instances.
 	// +kubebuilder:validation:Enum=ON_DEMAND;SPOT
+	// +immutable
+	// +optional
 	CapacityType *string `json:"capacityType,omitempty"`
 
 	// The root device disk size (in GiB) for your node group instances. The default
@@ -101,7 +105,9 @@ type NodeGroupParameters struct {
 	// An object representing a node group's launch template specification. If
 	// specified, then do not specify instanceTypes, diskSize, or remoteAccess and make
 	// sure that the launch template meets the requirements in
-	// launchTemplateSpecification.
+	// launchTemplateSpecification. Changing the version of the launch template
+	// triggers a rolling update of the nodes.
+	// +optional
 	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
 
 	// The Amazon Resource Name (ARN) of the IAM role to associate with your node
@@ -171,8 +177,17 @@ type NodeGroupParameters struct {
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
 
-	// The Kubernetes taints to be applied to the nodes in the node group.
-	Taints []Taint `json:"taints,omitempty"`
+	// The Kubernetes taints to be applied to the nodes in the node group. The
+	// list is authoritative; taints of the node group that are not listed
+	// here are removed, and an empty list removes all of them. The taints of
+	// the node group are not managed if this field is omitted.
+	// +optional
+	Taints []Taint `json:"taints"`
+
+	// The node group update configuration that is used during version and
+	// launch template updates.
+	// +optional
+	UpdateConfig *NodeGroupUpdateConfig `json:"updateConfig,omitempty"`
 
 	// The Kubernetes version to use for your managed nodes. By default, the Kubernetes
 	// version of the cluster is used, and this is the only accepted specified value.
@@ -193,6 +208,23 @@ type Taint struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// NodeGroupUpdateConfig is the configuration of the rolling updates of a node
+// group. Only one of maxUnavailable and maxUnavailablePercentage can be given.
+type NodeGroupUpdateConfig struct {
+	// The maximum number of nodes unavailable at once during a version update.
+	// Nodes will be updated in parallel. The maximum number is 100.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+
+	// The maximum percentage of nodes unavailable during a version update. This
+	// percentage of nodes will be updated in parallel, up to 100 nodes at once.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxUnavailablePercentage *int32 `json:"maxUnavailablePercentage,omitempty"`
+}
+
 // LaunchTemplateSpecification is an object representing a node group launch
 // template specification. The launch
 // template cannot include SubnetId
@@ -215,14 +247,37 @@ type Taint struct {
 type LaunchTemplateSpecification struct {
 
 	// The ID of the launch template.
+	// +optional
 	ID *string `json:"id,omitempty"`
 
+	// IDRef is a reference to a LaunchTemplate used to set the ID.
+	// +optional
+	IDRef *xpv1.Reference `json:"idRef,omitempty"`
+
+	// IDSelector selects a reference to a LaunchTemplate used to set the ID.
+	// +optional
+	IDSelector *xpv1.Selector `json:"idSelector,omitempty"`
+
 	// The name of the launch template.
+	// +optional
 	Name *string `json:"name,omitempty"`
 
 	// The version of the launch template to use. If no version is specified, then the
 	// template's default version is used.
+	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// VersionRef is a reference to a LaunchTemplate whose latest version is
+	// used to set the Version. Unlike other references, it is resolved on
+	// every reconcile so that the node group follows new versions of the
+	// launch template.
+	// +optional
+	VersionRef *xpv1.Reference `json:"versionRef,omitempty"`
+
+	// VersionSelector selects a reference to a LaunchTemplate whose latest
+	// version is used to set the Version.
+	// +optional
+	VersionSelector *xpv1.Selector `json:"versionSelector,omitempty"`
 }
 
 // RemoteAccessConfig is the configuration for remotely accessing a node.
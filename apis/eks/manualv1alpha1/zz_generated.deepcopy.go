@@ -416,6 +416,16 @@ func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecifica
 		*out = new(string)
 		**out = **in
 	}
+	if in.IDRef != nil {
+		in, out := &in.IDRef, &out.IDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IDSelector != nil {
+		in, out := &in.IDSelector, &out.IDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -426,6 +436,16 @@ func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecifica
 		*out = new(string)
 		**out = **in
 	}
+	if in.VersionRef != nil {
+		in, out := &in.VersionRef, &out.VersionRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VersionSelector != nil {
+		in, out := &in.VersionSelector, &out.VersionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
@@ -644,6 +664,11 @@ func (in *NodeGroupParameters) DeepCopyInto(out *NodeGroupParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UpdateConfig != nil {
+		in, out := &in.UpdateConfig, &out.UpdateConfig
+		*out = new(NodeGroupUpdateConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Version != nil {
 		in, out := &in.Version, &out.Version
 		*out = new(string)
@@ -765,6 +790,31 @@ func (in *NodeGroupStatus) DeepCopy() *NodeGroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupUpdateConfig) DeepCopyInto(out *NodeGroupUpdateConfig) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnavailablePercentage != nil {
+		in, out := &in.MaxUnavailablePercentage, &out.MaxUnavailablePercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupUpdateConfig.
+func (in *NodeGroupUpdateConfig) DeepCopy() *NodeGroupUpdateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupUpdateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCIdentityProvider) DeepCopyInto(out *OIDCIdentityProvider) {
 	*out = *in
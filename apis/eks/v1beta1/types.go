@@ -34,6 +34,23 @@ const (
 	ClusterStatusUpdating ClusterStatusType = "UPDATING"
 )
 
+// ClusterUpdateStatus is the status of an update of an EKS cluster.
+type ClusterUpdateStatus string
+
+// Cluster update statuses.
+const (
+	ClusterUpdateStatusInProgress ClusterUpdateStatus = "InProgress"
+	ClusterUpdateStatusFailed     ClusterUpdateStatus = "Failed"
+	ClusterUpdateStatusCancelled  ClusterUpdateStatus = "Cancelled"
+	ClusterUpdateStatusSuccessful ClusterUpdateStatus = "Successful"
+)
+
+// AnnotationKeyAllowMultiMinorUpgrade is the annotation that allows a
+// Cluster to be upgraded to a version that is more than one minor version
+// newer when its value is "true". The cluster is upgraded one minor version
+// at a time since EKS doesn't allow skipping minor versions.
+const AnnotationKeyAllowMultiMinorUpgrade = "eks.aws.crossplane.io/allow-multi-minor-upgrade"
+
 // LogType is a type of logging.
 type LogType string
 
@@ -115,6 +132,53 @@ type ClusterParameters struct {
 	// Example: 1.15
 	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// Kubeconfig configures the kubeconfig that is published to the
+	// connection secret of the cluster. By default, the kubeconfig contains
+	// a token that is valid for 15 minutes and refreshed by the provider
+	// ahead of its expiry.
+	// +optional
+	Kubeconfig *KubeconfigConfig `json:"kubeconfig,omitempty"`
+}
+
+// KubeconfigConfig configures the kubeconfig of a cluster.
+type KubeconfigConfig struct {
+	// Exec makes the kubeconfig get a token by running a command whenever it
+	// needs one instead of containing a token. The command has to be
+	// installed and AWS credentials have to be available wherever the
+	// kubeconfig is used.
+	// +optional
+	Exec *KubeconfigExec `json:"exec,omitempty"`
+}
+
+// KubeconfigExecCommand is a command that can get a token for an EKS cluster.
+type KubeconfigExecCommand string
+
+// Commands that can get a token for an EKS cluster.
+const (
+	KubeconfigExecCommandAWS                 KubeconfigExecCommand = "aws"
+	KubeconfigExecCommandAWSIAMAuthenticator KubeconfigExecCommand = "aws-iam-authenticator"
+)
+
+// KubeconfigExec configures the command that the kubeconfig runs to get a
+// token.
+type KubeconfigExec struct {
+	// Command is the command to run, either aws to run aws eks get-token, or
+	// aws-iam-authenticator to run aws-iam-authenticator token.
+	// +kubebuilder:validation:Enum=aws;aws-iam-authenticator
+	// +kubebuilder:default=aws
+	// +optional
+	Command KubeconfigExecCommand `json:"command,omitempty"`
+
+	// RoleARN is the ARN of the IAM role that the command assumes to get the
+	// token.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// APIVersion of the ExecCredential that the command returns. Defaults to
+	// client.authentication.k8s.io/v1beta1.
+	// +optional
+	APIVersion *string `json:"apiVersion,omitempty"`
 }
 
 // EncryptionConfig is the encryption configuration for a cluster.
@@ -256,6 +320,27 @@ type ClusterObservation struct {
 
 	// The current status of the cluster.
 	Status ClusterStatusType `json:"status,omitempty"`
+
+	// LastUpdate is the last update that was requested for the cluster.
+	// +optional
+	LastUpdate *ClusterUpdate `json:"lastUpdate,omitempty"`
+}
+
+// ClusterUpdate is an update that was requested for a cluster.
+type ClusterUpdate struct {
+	// ID of the update.
+	ID string `json:"id"`
+
+	// Type of the update, such as VersionUpdate or EndpointAccessUpdate.
+	Type string `json:"type,omitempty"`
+
+	// Status of the update, one of InProgress, Failed, Cancelled and
+	// Successful.
+	Status ClusterUpdateStatus `json:"status,omitempty"`
+
+	// Generation of the Cluster that the update was requested for. A failed
+	// update is not retried until the spec of the Cluster is changed.
+	Generation int64 `json:"generation,omitempty"`
 }
 
 // Identity is the identity information for a cluster.
@@ -27,8 +27,8 @@ import (
 // ClassifierParameters defines the desired state of Classifier
 type ClassifierParameters struct {
 	// Region is which region the Classifier will be created.
-	// +kubebuilder:validation:Required
-	Region                     string `json:"region"`
+	// +optional
+	Region                     string `json:"region,omitempty"`
 	CustomClassifierParameters `json:",inline"`
 }
 
@@ -27,8 +27,8 @@ import (
 // ConnectionParameters defines the desired state of Connection
 type ConnectionParameters struct {
 	// Region is which region the Connection will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The ID of the Data Catalog in which to create the connection. If none is
 	// provided, the AWS account ID is used by default.
 	CatalogID                  *string `json:"catalogID,omitempty"`
@@ -27,8 +27,8 @@ import (
 // DatabaseParameters defines the desired state of Database
 type DatabaseParameters struct {
 	// Region is which region the Database will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The ID of the Data Catalog in which to create the database. If none is provided,
 	// the AWS account ID is used by default.
 	CatalogID                *string `json:"catalogID,omitempty"`
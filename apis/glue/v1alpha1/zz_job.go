@@ -27,8 +27,8 @@ import (
 // JobParameters defines the desired state of Job
 type JobParameters struct {
 	// Region is which region the Job will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// This parameter is deprecated. Use MaxCapacity instead.
 	//
 	// The number of AWS Glue data processing units (DPUs) to allocate to this Job.
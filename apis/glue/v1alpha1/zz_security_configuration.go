@@ -27,8 +27,8 @@ import (
 // SecurityConfigurationParameters defines the desired state of SecurityConfiguration
 type SecurityConfigurationParameters struct {
 	// Region is which region the SecurityConfiguration will be created.
-	// +kubebuilder:validation:Required
-	Region                                string `json:"region"`
+	// +optional
+	Region                                string `json:"region,omitempty"`
 	CustomSecurityConfigurationParameters `json:",inline"`
 }
 
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 )
 
 // IAMPolicyParameters define the desired state of an AWS IAM Policy.
@@ -33,11 +35,27 @@ type IAMPolicyParameters struct {
 	Path *string `json:"path,omitempty"`
 
 	// The JSON policy document that is the content for the policy.
-	Document string `json:"document"`
+	// Either document or policyDocument must be specified.
+	// +optional
+	Document string `json:"document,omitempty"`
+
+	// PolicyDocument is the structured form of the policy document. It
+	// takes precedence over document.
+	// +optional
+	PolicyDocument *commonv1alpha1.PolicyDocument `json:"policyDocument,omitempty"`
 
 	// The name of the policy.
 	Name string `json:"name"`
 
+	// RetainVersions is the maximum number of versions of the policy that are
+	// kept, including the default version. The oldest versions are deleted
+	// when a new version needs to be created and there is no room for it.
+	// Defaults to 5, which is the maximum number of versions IAM allows.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=5
+	// +optional
+	RetainVersions *int32 `json:"retainVersions,omitempty"`
+
 	// Tags. For more information about
 	// tagging, see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
 	// in the IAM User Guide.
@@ -73,6 +91,21 @@ type IAMPolicyObservation struct {
 
 	// The stable and unique string identifying the policy.
 	PolicyID string `json:"policyId,omitempty"`
+
+	// Versions of the policy, newest first.
+	Versions []IAMPolicyVersion `json:"versions,omitempty"`
+}
+
+// IAMPolicyVersion is a version of an IAM policy.
+type IAMPolicyVersion struct {
+	// The identifier of the version.
+	VersionID string `json:"versionId"`
+
+	// Specifies whether the version is the default version of the policy.
+	IsDefaultVersion bool `json:"isDefaultVersion,omitempty"`
+
+	// The date and time when the version was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
 }
 
 // An IAMPolicyStatus represents the observed state of an IAMPolicy.
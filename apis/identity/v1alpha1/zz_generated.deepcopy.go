@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -618,6 +619,13 @@ func (in *IAMPolicyList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMPolicyObservation) DeepCopyInto(out *IAMPolicyObservation) {
 	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]IAMPolicyVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMPolicyObservation.
@@ -643,6 +651,16 @@ func (in *IAMPolicyParameters) DeepCopyInto(out *IAMPolicyParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyDocument != nil {
+		in, out := &in.PolicyDocument, &out.PolicyDocument
+		*out = new(commonv1alpha1.PolicyDocument)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetainVersions != nil {
+		in, out := &in.RetainVersions, &out.RetainVersions
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -681,7 +699,7 @@ func (in *IAMPolicySpec) DeepCopy() *IAMPolicySpec {
 func (in *IAMPolicyStatus) DeepCopyInto(out *IAMPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMPolicyStatus.
@@ -694,6 +712,25 @@ func (in *IAMPolicyStatus) DeepCopy() *IAMPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMPolicyVersion) DeepCopyInto(out *IAMPolicyVersion) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMPolicyVersion.
+func (in *IAMPolicyVersion) DeepCopy() *IAMPolicyVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMPolicyVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMUser) DeepCopyInto(out *IAMUser) {
 	*out = *in
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IAMInstanceProfileParameters define the desired state of an AWS IAM
+// instance profile.
+type IAMInstanceProfileParameters struct {
+	// Path is the path to the instance profile.
+	// Default: /
+	// +immutable
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// RoleName is the name of the IAM role that is added to the instance
+	// profile. An instance profile can contain only one role.
+	// +optional
+	RoleName *string `json:"roleName,omitempty"`
+
+	// RoleNameRef references an IAMRole to retrieve its Name
+	// +optional
+	RoleNameRef *xpv1.Reference `json:"roleNameRef,omitempty"`
+
+	// RoleNameSelector selects a reference to an IAMRole to retrieve its Name
+	// +optional
+	RoleNameSelector *xpv1.Selector `json:"roleNameSelector,omitempty"`
+
+	// Tags. For more information about
+	// tagging, see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
+	// in the IAM User Guide.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An IAMInstanceProfileSpec defines the desired state of an
+// IAMInstanceProfile.
+type IAMInstanceProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IAMInstanceProfileParameters `json:"forProvider"`
+}
+
+// IAMInstanceProfileObservation keeps the state for the external resource
+type IAMInstanceProfileObservation struct {
+	// ARN is the Amazon Resource Name (ARN) specifying the instance profile.
+	ARN string `json:"arn,omitempty"`
+
+	// InstanceProfileID is the stable and unique string identifying the
+	// instance profile.
+	InstanceProfileID string `json:"instanceProfileId,omitempty"`
+}
+
+// An IAMInstanceProfileStatus represents the observed state of an
+// IAMInstanceProfile.
+type IAMInstanceProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IAMInstanceProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IAMInstanceProfile is a managed resource that represents an AWS IAM
+// instance profile, which passes an IAM role to EC2 instances.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLENAME",type="string",JSONPath=".spec.forProvider.roleName"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IAMInstanceProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IAMInstanceProfileSpec   `json:"spec"`
+	Status IAMInstanceProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IAMInstanceProfileList contains a list of IAMInstanceProfiles
+type IAMInstanceProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IAMInstanceProfile `json:"items"`
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -50,8 +52,17 @@ type IAMRoleParameters struct {
 
 	// AssumeRolePolicyDocument is the the trust relationship policy document
 	// that grants an entity permission to assume the role.
+	// Either assumeRolePolicyDocument or trustPolicyDocument must be
+	// specified.
 	// +immutable
-	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument"`
+	// +optional
+	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument,omitempty"`
+
+	// TrustPolicyDocument is the structured form of the trust relationship
+	// policy document, since assumeRolePolicyDocument already holds its JSON
+	// form. It takes precedence over assumeRolePolicyDocument.
+	// +optional
+	TrustPolicyDocument *commonv1alpha1.PolicyDocument `json:"trustPolicyDocument,omitempty"`
 
 	// Description is a description of the role.
 	// +optional
@@ -80,6 +91,27 @@ type IAMRoleParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// InlinePolicies are the policies embedded in the role. If this list is
+	// given, the inline policies of the role that are not in it are deleted.
+	// +optional
+	InlinePolicies []InlinePolicy `json:"inlinePolicies,omitempty"`
+}
+
+// InlinePolicy is a policy document that is embedded in an IAM role.
+type InlinePolicy struct {
+	// Name of the inline policy.
+	Name string `json:"name"`
+
+	// Document is the JSON policy document. Either document or
+	// policyDocument must be specified.
+	// +optional
+	Document *string `json:"document,omitempty"`
+
+	// PolicyDocument is the structured form of the policy document. It
+	// takes precedence over document.
+	// +optional
+	PolicyDocument *commonv1alpha1.PolicyDocument `json:"policyDocument,omitempty"`
 }
 
 // An IAMRoleSpec defines the desired state of an IAMRole.
@@ -74,3 +74,24 @@ func (mg *IAMRolePolicyAttachment) ResolveReferences(ctx context.Context, c clie
 
 	return nil
 }
+
+// ResolveReferences of this IAMInstanceProfile
+func (mg *IAMInstanceProfile) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.roleName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleName),
+		Reference:    mg.Spec.ForProvider.RoleNameRef,
+		Selector:     mg.Spec.ForProvider.RoleNameSelector,
+		To:           reference.To{Managed: &IAMRole{}, List: &IAMRoleList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleName")
+	}
+	mg.Spec.ForProvider.RoleName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -56,7 +56,16 @@ var (
 	IAMRolePolicyAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(IAMRolePolicyAttachmentKind)
 )
 
+// IAMInstanceProfile type metadata.
+var (
+	IAMInstanceProfileKind             = reflect.TypeOf(IAMInstanceProfile{}).Name()
+	IAMInstanceProfileGroupKind        = schema.GroupKind{Group: Group, Kind: IAMInstanceProfileKind}.String()
+	IAMInstanceProfileKindAPIVersion   = IAMInstanceProfileKind + "." + SchemeGroupVersion.String()
+	IAMInstanceProfileGroupVersionKind = SchemeGroupVersion.WithKind(IAMInstanceProfileKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&IAMRole{}, &IAMRoleList{})
 	SchemeBuilder.Register(&IAMRolePolicyAttachment{}, &IAMRolePolicyAttachmentList{})
+	SchemeBuilder.Register(&IAMInstanceProfile{}, &IAMInstanceProfileList{})
 }
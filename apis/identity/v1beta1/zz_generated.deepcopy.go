@@ -22,9 +22,158 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfile) DeepCopyInto(out *IAMInstanceProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfile.
+func (in *IAMInstanceProfile) DeepCopy() *IAMInstanceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMInstanceProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfileList) DeepCopyInto(out *IAMInstanceProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IAMInstanceProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileList.
+func (in *IAMInstanceProfileList) DeepCopy() *IAMInstanceProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMInstanceProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfileObservation) DeepCopyInto(out *IAMInstanceProfileObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileObservation.
+func (in *IAMInstanceProfileObservation) DeepCopy() *IAMInstanceProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfileParameters) DeepCopyInto(out *IAMInstanceProfileParameters) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleName != nil {
+		in, out := &in.RoleName, &out.RoleName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleNameRef != nil {
+		in, out := &in.RoleNameRef, &out.RoleNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RoleNameSelector != nil {
+		in, out := &in.RoleNameSelector, &out.RoleNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileParameters.
+func (in *IAMInstanceProfileParameters) DeepCopy() *IAMInstanceProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfileSpec) DeepCopyInto(out *IAMInstanceProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileSpec.
+func (in *IAMInstanceProfileSpec) DeepCopy() *IAMInstanceProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfileStatus) DeepCopyInto(out *IAMInstanceProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileStatus.
+func (in *IAMInstanceProfileStatus) DeepCopy() *IAMInstanceProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMRole) DeepCopyInto(out *IAMRole) {
 	*out = *in
@@ -102,6 +251,11 @@ func (in *IAMRoleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMRoleParameters) DeepCopyInto(out *IAMRoleParameters) {
 	*out = *in
+	if in.TrustPolicyDocument != nil {
+		in, out := &in.TrustPolicyDocument, &out.TrustPolicyDocument
+		*out = new(v1alpha1.PolicyDocument)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -127,6 +281,13 @@ func (in *IAMRoleParameters) DeepCopyInto(out *IAMRoleParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make([]InlinePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleParameters.
@@ -316,6 +477,31 @@ func (in *IAMRoleStatus) DeepCopy() *IAMRoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InlinePolicy) DeepCopyInto(out *InlinePolicy) {
+	*out = *in
+	if in.Document != nil {
+		in, out := &in.Document, &out.Document
+		*out = new(string)
+		**out = **in
+	}
+	if in.PolicyDocument != nil {
+		in, out := &in.PolicyDocument, &out.PolicyDocument
+		*out = new(v1alpha1.PolicyDocument)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InlinePolicy.
+func (in *InlinePolicy) DeepCopy() *InlinePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InlinePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
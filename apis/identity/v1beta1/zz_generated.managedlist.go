@@ -20,6 +20,15 @@ package v1beta1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this IAMInstanceProfileList.
+func (l *IAMInstanceProfileList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this IAMRoleList.
 func (l *IAMRoleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -16,8 +16,15 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
 // CustomClusterParameters contains the additional fields for ClusterParameters.
 type CustomClusterParameters struct {
+	// Represents the configuration that you want MSK to use for the cluster.
+	// +optional
+	ConfigurationInfo *CustomConfigurationInfo `json:"configurationInfo,omitempty"`
 
 	// The connection string to use to connect to the Apache ZooKeeper cluster.
 	// +optional
@@ -27,3 +34,43 @@ type CustomClusterParameters struct {
 	// +optional
 	ZookeeperConnectStringTLS *string `json:"zookeeperConnectStringTLS,omitempty"`
 }
+
+// CustomConfigurationInfo contains the additional fields for ConfigurationInfo.
+type CustomConfigurationInfo struct {
+	// ARN of the configuration to use.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// ARNRef is a reference to a Configuration used to set the ARN.
+	// +optional
+	ARNRef *xpv1.Reference `json:"arnRef,omitempty"`
+
+	// ARNSelector selects a reference to a Configuration used to set the ARN.
+	// +optional
+	ARNSelector *xpv1.Selector `json:"arnSelector,omitempty"`
+
+	// The revision of the configuration to use.
+	// +optional
+	Revision *int64 `json:"revision,omitempty"`
+
+	// RevisionRef is a reference to a Configuration whose latest revision is
+	// used to set the Revision. Unlike other references, it is resolved on
+	// every reconcile so that the cluster follows new revisions of the
+	// configuration.
+	// +optional
+	RevisionRef *xpv1.Reference `json:"revisionRef,omitempty"`
+
+	// RevisionSelector selects a reference to a Configuration whose latest
+	// revision is used to set the Revision.
+	// +optional
+	RevisionSelector *xpv1.Selector `json:"revisionSelector,omitempty"`
+}
+
+// CustomConfigurationParameters contains the additional fields for ConfigurationParameters.
+type CustomConfigurationParameters struct {
+
+	// The contents of the server.properties file. Supported properties are
+	// documented in the MSK Developer Guide.
+	// +kubebuilder:validation:Required
+	ServerProperties string `json:"serverProperties"`
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// ConfigurationLatestRevision returns the latest revision of a Configuration.
+func ConfigurationLatestRevision() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Configuration)
+		if !ok || cr.Status.AtProvider.LatestRevision == nil || cr.Status.AtProvider.LatestRevision.Revision == nil {
+			return ""
+		}
+		return strconv.FormatInt(*cr.Status.AtProvider.LatestRevision.Revision, 10)
+	}
+}
+
+// ResolveReferences of this Cluster
+func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	ci := mg.Spec.ForProvider.ConfigurationInfo
+	if ci == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.configurationInfo.arn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(ci.ARN),
+		Reference:    ci.ARNRef,
+		Selector:     ci.ARNSelector,
+		To:           reference.To{Managed: &Configuration{}, List: &ConfigurationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.configurationInfo.arn")
+	}
+	ci.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+	ci.ARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.configurationInfo.revision
+	if ci.RevisionRef == nil && ci.RevisionSelector == nil {
+		return nil
+	}
+	// The current value is ignored so that the latest revision of the
+	// configuration is picked up every time.
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		Reference: ci.RevisionRef,
+		Selector:  ci.RevisionSelector,
+		To:        reference.To{Managed: &Configuration{}, List: &ConfigurationList{}},
+		Extract:   ConfigurationLatestRevision(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.configurationInfo.revision")
+	}
+	revision, err := strconv.ParseInt(rsp.ResolvedValue, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.configurationInfo.revision")
+	}
+	ci.Revision = &revision
+	ci.RevisionRef = rsp.ResolvedReference
+	return nil
+}
@@ -27,8 +27,8 @@ import (
 // ClusterParameters defines the desired state of Cluster
 type ClusterParameters struct {
 	// Region is which region the Cluster will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Information about the brokers.
 	// +kubebuilder:validation:Required
 	BrokerNodeGroupInfo *BrokerNodeGroupInfo `json:"brokerNodeGroupInfo"`
@@ -37,8 +37,6 @@ type ClusterParameters struct {
 	// The name of the cluster.
 	// +kubebuilder:validation:Required
 	ClusterName *string `json:"clusterName"`
-	// Represents the configuration that you want MSK to use for the cluster.
-	ConfigurationInfo *ConfigurationInfo `json:"configurationInfo,omitempty"`
 	// Includes all encryption-related information.
 	EncryptionInfo *EncryptionInfo `json:"encryptionInfo,omitempty"`
 	// Specifies the level of monitoring for the MSK cluster. The possible values
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -556,11 +557,6 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ConfigurationInfo != nil {
-		in, out := &in.ConfigurationInfo, &out.ConfigurationInfo
-		*out = new(ConfigurationInfo)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.EncryptionInfo != nil {
 		in, out := &in.EncryptionInfo, &out.EncryptionInfo
 		*out = new(EncryptionInfo)
@@ -686,6 +682,90 @@ func (in *CompatibleKafkaVersion) DeepCopy() *CompatibleKafkaVersion {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
+func (in *Configuration) DeepCopy() *Configuration {
+	if in == nil {
+		return nil
+	}
+	out := new(Configuration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Configuration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationInfo) DeepCopyInto(out *ConfigurationInfo) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationInfo.
+func (in *ConfigurationInfo) DeepCopy() *ConfigurationInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationList) DeepCopyInto(out *ConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Configuration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationList.
+func (in *ConfigurationList) DeepCopy() *ConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationObservation) DeepCopyInto(out *ConfigurationObservation) {
 	*out = *in
 	if in.ARN != nil {
 		in, out := &in.ARN, &out.ARN
@@ -696,6 +776,31 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		in, out := &in.CreationTime, &out.CreationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LatestRevision != nil {
+		in, out := &in.LatestRevision, &out.LatestRevision
+		*out = new(ConfigurationRevision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationObservation.
+func (in *ConfigurationObservation) DeepCopy() *ConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationParameters) DeepCopyInto(out *ConfigurationParameters) {
+	*out = *in
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -717,23 +822,28 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(string)
 		**out = **in
 	}
+	out.CustomConfigurationParameters = in.CustomConfigurationParameters
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
-func (in *Configuration) DeepCopy() *Configuration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationParameters.
+func (in *ConfigurationParameters) DeepCopy() *ConfigurationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(Configuration)
+	out := new(ConfigurationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationInfo) DeepCopyInto(out *ConfigurationInfo) {
+func (in *ConfigurationRevision) DeepCopyInto(out *ConfigurationRevision) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
@@ -744,41 +854,46 @@ func (in *ConfigurationInfo) DeepCopyInto(out *ConfigurationInfo) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationInfo.
-func (in *ConfigurationInfo) DeepCopy() *ConfigurationInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRevision.
+func (in *ConfigurationRevision) DeepCopy() *ConfigurationRevision {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationInfo)
+	out := new(ConfigurationRevision)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationRevision) DeepCopyInto(out *ConfigurationRevision) {
+func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 	*out = *in
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Revision != nil {
-		in, out := &in.Revision, &out.Revision
-		*out = new(int64)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationSpec.
+func (in *ConfigurationSpec) DeepCopy() *ConfigurationSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(ConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRevision.
-func (in *ConfigurationRevision) DeepCopy() *ConfigurationRevision {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationStatus) DeepCopyInto(out *ConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationStatus.
+func (in *ConfigurationStatus) DeepCopy() *ConfigurationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationRevision)
+	out := new(ConfigurationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -786,6 +901,11 @@ func (in *ConfigurationRevision) DeepCopy() *ConfigurationRevision {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomClusterParameters) DeepCopyInto(out *CustomClusterParameters) {
 	*out = *in
+	if in.ConfigurationInfo != nil {
+		in, out := &in.ConfigurationInfo, &out.ConfigurationInfo
+		*out = new(CustomConfigurationInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ZookeeperConnectString != nil {
 		in, out := &in.ZookeeperConnectString, &out.ZookeeperConnectString
 		*out = new(string)
@@ -808,6 +928,66 @@ func (in *CustomClusterParameters) DeepCopy() *CustomClusterParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomConfigurationInfo) DeepCopyInto(out *CustomConfigurationInfo) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ARNRef != nil {
+		in, out := &in.ARNRef, &out.ARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ARNSelector != nil {
+		in, out := &in.ARNSelector, &out.ARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RevisionRef != nil {
+		in, out := &in.RevisionRef, &out.RevisionRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RevisionSelector != nil {
+		in, out := &in.RevisionSelector, &out.RevisionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomConfigurationInfo.
+func (in *CustomConfigurationInfo) DeepCopy() *CustomConfigurationInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomConfigurationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomConfigurationParameters) DeepCopyInto(out *CustomConfigurationParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomConfigurationParameters.
+func (in *CustomConfigurationParameters) DeepCopy() *CustomConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EBSStorageInfo) DeepCopyInto(out *EBSStorageInfo) {
 	*out = *in
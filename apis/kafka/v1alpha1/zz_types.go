@@ -163,18 +163,6 @@ type CompatibleKafkaVersion struct {
 	TargetVersions []*string `json:"targetVersions,omitempty"`
 }
 
-type Configuration struct {
-	ARN *string `json:"arn,omitempty"`
-
-	CreationTime *metav1.Time `json:"creationTime,omitempty"`
-
-	Description *string `json:"description,omitempty"`
-
-	KafkaVersions []*string `json:"kafkaVersions,omitempty"`
-
-	Name *string `json:"name,omitempty"`
-}
-
 type ConfigurationInfo struct {
 	ARN *string `json:"arn,omitempty"`
 
@@ -28,8 +28,8 @@ import (
 // AliasParameters defines the desired state of Alias
 type AliasParameters struct {
 	// Region is which region the Alias will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// Associates the alias with the specified customer managed CMK (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#customer-cmk).
 	// The CMK must be in the same AWS Region.
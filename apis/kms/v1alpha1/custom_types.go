@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
+)
+
 // CustomKeyParameters are custom parameters for Key.
 type CustomKeyParameters struct {
 	// Specifies whether the CMK is enabled.
@@ -7,4 +11,9 @@ type CustomKeyParameters struct {
 
 	// Specifies how many days the Key is retained when scheduled for deletion. Defaults to 30 days.
 	PendingWindowInDays *int64 `json:"pendingWindowInDays,omitempty"`
+
+	// PolicyDocument is the structured form of the key policy whose
+	// references are resolved to ARNs. It takes precedence over Policy.
+	// +optional
+	PolicyDocument *commonv1alpha1.PolicyDocument `json:"policyDocument,omitempty"`
 }
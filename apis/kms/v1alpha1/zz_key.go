@@ -27,8 +27,8 @@ import (
 // KeyParameters defines the desired state of Key
 type KeyParameters struct {
 	// Region is which region the Key will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A flag to indicate whether to bypass the key policy lockout safety check.
 	//
 	// Setting this value to true increases the risk that the CMK becomes unmanageable.
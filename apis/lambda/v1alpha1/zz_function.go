@@ -27,8 +27,8 @@ import (
 // FunctionParameters defines the desired state of Function
 type FunctionParameters struct {
 	// Region is which region the Function will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// To enable code signing for this function, specify the ARN of a code-signing
 	// configuration. A code-signing configuration includes a set of signing profiles,
 	// which define the trusted publishers for this function.
@@ -27,8 +27,8 @@ import (
 // BrokerParameters defines the desired state of Broker
 type BrokerParameters struct {
 	// Region is which region the Broker will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	AuthenticationStrategy *string `json:"authenticationStrategy,omitempty"`
 
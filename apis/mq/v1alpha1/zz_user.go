@@ -27,8 +27,8 @@ import (
 // UserParameters defines the desired state of User
 type UserParameters struct {
 	// Region is which region the User will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ConsoleAccess *bool `json:"consoleAccess,omitempty"`
 
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 )
 
 // Tag represent a user-provided metadata that can be associated with a
@@ -48,7 +50,8 @@ type Tag struct {
 // SNSTopicParameters define the desired state of a AWS SNS Topic
 type SNSTopicParameters struct {
 	// Region is the region you'd like your SNSTopic to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// Name refers to the name of the AWS SNS Topic
 	// +immutable
@@ -71,6 +74,11 @@ type SNSTopicParameters struct {
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
+	// PolicyDocument is the structured form of the topic's policy whose
+	// references are resolved to ARNs. It takes precedence over Policy.
+	// +optional
+	PolicyDocument *commonv1alpha1.PolicyDocument `json:"policyDocument,omitempty"`
+
 	// DeliveryRetryPolicy - the JSON serialization of the effective
 	// delivery policy, taking system defaults into account
 	// +optional
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -305,6 +306,11 @@ func (in *SNSTopicParameters) DeepCopyInto(out *SNSTopicParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyDocument != nil {
+		in, out := &in.PolicyDocument, &out.PolicyDocument
+		*out = new(commonv1alpha1.PolicyDocument)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeliveryPolicy != nil {
 		in, out := &in.DeliveryPolicy, &out.DeliveryPolicy
 		*out = new(string)
@@ -34,6 +34,19 @@ type CustomDBClusterParameterGroupParameters struct {
 
 // CustomDBClusterParameters are custom parameters for DBCluster
 type CustomDBClusterParameters struct {
+	// A value that indicates whether the modifications and any pending
+	// modifications are applied as soon as possible rather than during the
+	// next maintenance window of the DB cluster. Note that most of the
+	// modifications of a DB cluster are applied immediately regardless of
+	// this setting.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// A value that indicates whether major version upgrades are allowed when
+	// EngineVersion is changed to a version whose major version is different
+	// than the current one.
+	// +optional
+	AllowMajorVersionUpgrade *bool `json:"allowMajorVersionUpgrade,omitempty"`
 
 	// DomainIAMRoleNameRef is a reference to an IAMRole used to set
 	// DomainIAMRoleName.
@@ -57,6 +70,11 @@ type CustomDBClusterParameters struct {
 	// printable ASCII character except "/", """, or "@".
 	//
 	// Constraints: Must contain from 8 to 41 characters. Required.
+	//
+	// The password of the DB cluster is changed whenever the referenced
+	// secret changes, which is detected by comparing it with the password in
+	// the connection secret. Therefore the password is only changed if
+	// writeConnectionSecretToRef is set.
 	MasterUserPasswordSecretRef xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef"`
 
 	// A list of EC2 VPC security groups to associate with this DB cluster.
@@ -127,6 +145,39 @@ type CustomGlobalClusterParameters struct {
 	// +immutable
 	// +optional
 	SourceDBClusterIdentifierSelector *xpv1.Selector `json:"sourceDBClusterIdentifierSelector,omitempty"`
+
+	// DBClusterARNs is the list of ARNs of the DB clusters that should be
+	// members of the global cluster. A DB cluster joins the global cluster
+	// either as its source DB cluster or by being created with its
+	// identifier, since existing DB clusters cannot be added to it. The DB
+	// clusters in this list that are not members are reported as an error.
+	// The members that are not in this list are removed from the global
+	// cluster and continue as standalone DB clusters. Members are not
+	// managed if the list is empty.
+	// +optional
+	DBClusterARNs []string `json:"dbClusterARNs,omitempty"`
+
+	// DBClusterARNRefs are references to DBClusters used to set the
+	// DBClusterARNs. Unlike other references, they are resolved on every
+	// reconcile and the DBClusters that don't have an ARN yet, e.g. since they
+	// wait for this global cluster to be created, are skipped. No member is
+	// removed from the global cluster until all of them are resolved.
+	// +optional
+	DBClusterARNRefs []xpv1.Reference `json:"dbClusterARNRefs,omitempty"`
+
+	// DBClusterARNSelector selects references to DBClusters used to set the
+	// DBClusterARNs. The selected DBClusters are resolved like
+	// DBClusterARNRefs.
+	// +optional
+	DBClusterARNSelector *xpv1.Selector `json:"dbClusterARNSelector,omitempty"`
+
+	// PrimaryRegion is the region of the member DB cluster that should be the
+	// primary, i.e. writer, cluster of the global cluster. The global cluster
+	// is failed over to the member in this region if the current primary is
+	// in another region. It is reported as an error if none of the members
+	// is in this region.
+	// +optional
+	PrimaryRegion *string `json:"primaryRegion,omitempty"`
 }
 
 // CustomDBInstanceParameters are custom parameters for the DBInstance
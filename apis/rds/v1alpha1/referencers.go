@@ -24,12 +24,20 @@ import (
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	errListDBClusters = "cannot list the selected DB clusters"
+	errGetDBCluster   = "cannot get the referenced DB cluster"
+)
+
 // ResolveReferences of this DBCluster
 func (mg *DBCluster) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -111,6 +119,43 @@ func (mg *GlobalCluster) ResolveReferences(ctx context.Context, c client.Reader)
 	mg.Spec.ForProvider.SourceDBClusterIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.SourceDBClusterIdentifierRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.dbClusterARNs
+	return errors.Wrap(mg.resolveDBClusterARNs(ctx, c), "spec.forProvider.dbClusterARNs")
+}
+
+// resolveDBClusterARNs sets the DBClusterARNs from the referenced DBClusters
+// on every reconcile. DB clusters join a global cluster by being created with
+// its identifier, so they don't have an ARN until the global cluster exists.
+// The references that cannot be resolved yet are skipped instead of blocking
+// the global cluster.
+func (mg *GlobalCluster) resolveDBClusterARNs(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	if len(p.DBClusterARNRefs) == 0 && p.DBClusterARNSelector != nil {
+		l := &DBClusterList{}
+		if err := c.List(ctx, l, client.MatchingLabels(p.DBClusterARNSelector.MatchLabels)); err != nil {
+			return errors.Wrap(err, errListDBClusters)
+		}
+		for i := range l.Items {
+			if mc := p.DBClusterARNSelector.MatchControllerRef; mc != nil && *mc && !meta.HaveSameController(mg, &l.Items[i]) {
+				continue
+			}
+			p.DBClusterARNRefs = append(p.DBClusterARNRefs, xpv1.Reference{Name: l.Items[i].GetName()})
+		}
+	}
+	if len(p.DBClusterARNRefs) == 0 {
+		return nil
+	}
+	arns := make([]string, 0, len(p.DBClusterARNRefs))
+	for _, ref := range p.DBClusterARNRefs {
+		cl := &DBCluster{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, cl); resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errGetDBCluster)
+		}
+		if a := DBClusterARN()(cl); a != "" {
+			arns = append(arns, a)
+		}
+	}
+	p.DBClusterARNs = arns
 	return nil
 }
 
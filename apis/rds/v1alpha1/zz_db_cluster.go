@@ -27,8 +27,8 @@ import (
 // DBClusterParameters defines the desired state of DBCluster
 type DBClusterParameters struct {
 	// Region is which region the DBCluster will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A list of Availability Zones (AZs) where instances in the DB cluster can
 	// be created. For information on AWS Regions and Availability Zones, see Choosing
 	// the Regions and Availability Zones (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Concepts.RegionsAndAvailabilityZones.html)
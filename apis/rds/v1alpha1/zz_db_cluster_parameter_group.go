@@ -27,8 +27,8 @@ import (
 // DBClusterParameterGroupParameters defines the desired state of DBClusterParameterGroup
 type DBClusterParameterGroupParameters struct {
 	// Region is which region the DBClusterParameterGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The DB cluster parameter group family name. A DB cluster parameter group
 	// can be associated with one and only one DB cluster parameter group family,
 	// and can be applied only to a DB cluster running a database engine and engine
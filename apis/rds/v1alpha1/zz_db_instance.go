@@ -27,8 +27,8 @@ import (
 // DBInstanceParameters defines the desired state of DBInstance
 type DBInstanceParameters struct {
 	// Region is which region the DBInstance will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The amount of storage (in gibibytes) to allocate for the DB instance.
 	//
 	// Type: Integer
@@ -27,8 +27,8 @@ import (
 // DBParameterGroupParameters defines the desired state of DBParameterGroup
 type DBParameterGroupParameters struct {
 	// Region is which region the DBParameterGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The DB parameter group family name. A DB parameter group can be associated
 	// with one and only one DB parameter group family, and can be applied only
 	// to a DB instance running a database engine and engine version compatible
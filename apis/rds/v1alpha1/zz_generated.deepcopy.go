@@ -396,6 +396,16 @@ func (in *CustomDBClusterParameterGroupParameters) DeepCopy() *CustomDBClusterPa
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters) {
 	*out = *in
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowMajorVersionUpgrade != nil {
+		in, out := &in.AllowMajorVersionUpgrade, &out.AllowMajorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DomainIAMRoleNameRef != nil {
 		in, out := &in.DomainIAMRoleNameRef, &out.DomainIAMRoleNameRef
 		*out = new(v1.Reference)
@@ -559,6 +569,26 @@ func (in *CustomGlobalClusterParameters) DeepCopyInto(out *CustomGlobalClusterPa
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DBClusterARNs != nil {
+		in, out := &in.DBClusterARNs, &out.DBClusterARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBClusterARNRefs != nil {
+		in, out := &in.DBClusterARNRefs, &out.DBClusterARNRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBClusterARNSelector != nil {
+		in, out := &in.DBClusterARNSelector, &out.DBClusterARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrimaryRegion != nil {
+		in, out := &in.PrimaryRegion, &out.PrimaryRegion
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomGlobalClusterParameters.
@@ -39,7 +39,8 @@ const (
 // ClusterParameters define the parameters available for an AWS Redshift cluster
 type ClusterParameters struct {
 	// Region is the region you'd like the Cluster to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// NodeType is the node type defining its size and compute capacity to be
 	// provisioned for the cluster. For information about node types,
@@ -237,9 +238,20 @@ type ClusterParameters struct {
 	// +optional
 	ManualSnapshotRetentionPeriod *int32 `json:"manualSnapshotRetentionPeriod,omitempty"`
 
+	// MasterPasswordSecretRef references the secret that contains the
+	// password of the master user account. If no reference is given, a
+	// password is generated when the cluster is created. The password of the
+	// cluster is changed whenever the referenced secret changes, which is
+	// detected by comparing it with the password in the connection secret.
+	// Therefore the password is only changed if writeConnectionSecretToRef
+	// is set.
+	// +optional
+	MasterPasswordSecretRef *xpv1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
+
 	// NewMasterUserPassword is the new password to be associated with the master user account
 	// for the cluster that has being created.
 	// Set this value if you want to change the existing password of the cluster.
+	// This field is ignored if MasterPasswordSecretRef is set.
 	// Constraints:
 	//    * Must be between 8 and 64 characters in length.
 	//    * Must contain at least one uppercase letter.
@@ -313,6 +313,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MasterPasswordSecretRef != nil {
+		in, out := &in.MasterPasswordSecretRef, &out.MasterPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 	if in.NewMasterUserPassword != nil {
 		in, out := &in.NewMasterUserPassword, &out.NewMasterUserPassword
 		*out = new(string)
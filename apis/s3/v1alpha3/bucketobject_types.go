@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BucketObjectParameters define the desired state of an AWS S3 object.
+type BucketObjectParameters struct {
+	// Region is where the Bucket referenced by this BucketObject resides.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// BucketName presents the name of the bucket.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Key is the name of the object in the bucket.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the inline content of the object. Exactly one of content,
+	// contentSecretRef and contentConfigMapRef must be specified.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentSecretRef references a key of a Secret whose value is the
+	// content of the object.
+	// +optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// ContentConfigMapRef references a key of a ConfigMap whose value is the
+	// content of the object. Both data and binaryData of the ConfigMap are
+	// looked up.
+	// +optional
+	ContentConfigMapRef *ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// A standard MIME type describing the format of the object data. S3 uses
+	// binary/octet-stream if it is not specified.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The server-side encryption algorithm used when storing this object in
+	// Amazon S3. The default encryption of the bucket is used if it is not
+	// specified.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms"
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// The ID or ARN of the AWS KMS key to be used for the encryption of the
+	// object when serverSideEncryption is aws:kms.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+
+	// Specifies whether Amazon S3 should use an S3 Bucket Key for object
+	// encryption with SSE-KMS.
+	// +optional
+	BucketKeyEnabled *bool `json:"bucketKeyEnabled,omitempty"`
+
+	// The canned ACL to apply to the object. The ACL is applied whenever the
+	// object is uploaded and changes made to it outside of Crossplane are not
+	// detected.
+	// +kubebuilder:validation:Enum=private;public-read;public-read-write;authenticated-read;aws-exec-read;bucket-owner-read;bucket-owner-full-control
+	// +optional
+	ACL *string `json:"acl,omitempty"`
+
+	// Tags is the set of tags of the object.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// BucketObjectObservation keeps the state of the external S3 object.
+type BucketObjectObservation struct {
+	// ETag is the entity tag of the object.
+	ETag string `json:"eTag,omitempty"`
+
+	// VersionID is the version of the object if the bucket is versioned.
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// A BucketObjectSpec defines the desired state of a BucketObject.
+type BucketObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BucketObjectParameters `json:"forProvider"`
+}
+
+// A BucketObjectStatus represents the observed state of a BucketObject.
+type BucketObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BucketObject is a managed resource that represents an object in an AWS
+// S3 Bucket.
+// +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BucketObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketObjectSpec   `json:"spec"`
+	Status BucketObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketObjectList contains a list of BucketObjects
+type BucketObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketObject `json:"items"`
+}
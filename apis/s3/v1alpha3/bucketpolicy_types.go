@@ -20,24 +20,37 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 )
 
 // BucketPolicyParameters define the desired state of an AWS BucketPolicy.
 type BucketPolicyParameters struct {
 	// Region is where the Bucket referenced by this BucketPolicy resides.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// RawPolicy is a stringified version of the S3 Bucket Policy.
-	// either policy or rawPolicy must be specified in the policy
+	// either policy, rawPolicy or policyDocument must be specified in the policy
 	// +optional
 	RawPolicy *string `json:"rawPolicy,omitempty"`
 
 	// Policy is a well defined type which can be parsed into an JSON S3 Bucket Policy
-	// either policy or rawPolicy must be specified in the policy
+	// either policy, rawPolicy or policyDocument must be specified in the policy
+	//
+	// Deprecated: Use PolicyDocument, which supports references and is
+	// shared with the policies of the other resources. Specs that set both
+	// policy and policyDocument are rejected.
 	// +optional
 	Policy *BucketPolicyBody `json:"policy,omitempty"`
 
+	// PolicyDocument is the shared structured form of the bucket policy
+	// whose references are resolved to ARNs. It replaces policy and takes
+	// precedence over rawPolicy.
+	// +optional
+	PolicyDocument *commonv1alpha1.PolicyDocument `json:"policyDocument,omitempty"`
+
 	// BucketName presents the name of the bucket.
 	// +optional
 	// +immutable
@@ -45,6 +45,15 @@ var (
 	BucketPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyKind)
 )
 
+// BucketObject type metadata.
+var (
+	BucketObjectKind             = reflect.TypeOf(BucketObject{}).Name()
+	BucketObjectGroupKind        = schema.GroupKind{Group: Group, Kind: BucketObjectKind}.String()
+	BucketObjectKindAPIVersion   = BucketObjectKind + "." + SchemeGroupVersion.String()
+	BucketObjectGroupVersionKind = SchemeGroupVersion.WithKind(BucketObjectKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{})
+	SchemeBuilder.Register(&BucketObject{}, &BucketObjectList{})
 }
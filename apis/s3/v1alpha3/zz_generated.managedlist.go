@@ -20,6 +20,15 @@ package v1alpha3
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this BucketObjectList.
+func (l *BucketObjectList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this BucketPolicyList.
 func (l *BucketPolicyList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -142,6 +142,43 @@ type BucketParameters struct {
 	// PublicAccessBlockConfiguration that you want to apply to this Amazon
 	// S3 bucket.
 	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// Places an Object Lock configuration on the bucket. The bucket must be
+	// created with objectLockEnabledForBucket set to true.
+	// For more information, see Locking Objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/object-lock.html).
+	// +optional
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
+
+	// Ownership controls of the bucket. When the object ownership is
+	// BucketOwnerEnforced, ACLs are disabled and the ACL fields of the bucket
+	// are not applied.
+	// +optional
+	OwnershipControls *OwnershipControls `json:"ownershipControls,omitempty"`
+
+	// S3 Intelligent-Tiering configurations of the bucket. The configurations
+	// are identified by their IDs and the ones that are not in this list are
+	// removed from the bucket.
+	// +optional
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `json:"intelligentTieringConfigurations,omitempty"`
+
+	// Inventory configurations of the bucket. The configurations are
+	// identified by their IDs and the ones that are not in this list are
+	// removed from the bucket.
+	// +optional
+	InventoryConfigurations []InventoryConfiguration `json:"inventoryConfigurations,omitempty"`
+
+	// Analytics configurations of the bucket. The configurations are
+	// identified by their IDs and the ones that are not in this list are
+	// removed from the bucket.
+	// +optional
+	AnalyticsConfigurations []AnalyticsConfiguration `json:"analyticsConfigurations,omitempty"`
+
+	// CloudWatch request metrics configurations of the bucket. The
+	// configurations are identified by their IDs and the ones that are not in
+	// this list are removed from the bucket.
+	// +optional
+	MetricsConfigurations []MetricsConfiguration `json:"metricsConfigurations,omitempty"`
 }
 
 // BucketSpec represents the desired state of the Bucket.
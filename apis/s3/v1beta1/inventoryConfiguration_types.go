@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// InventoryConfiguration specifies the inventory configuration for an Amazon
+// S3 bucket. For more information, see GET Bucket inventory
+// (https://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketGETInventoryConfig.html)
+// in the Amazon Simple Storage Service API Reference.
+type InventoryConfiguration struct {
+	// The ID used to identify the inventory configuration.
+	ID string `json:"id"`
+
+	// Contains information about where to publish the inventory results.
+	Destination InventoryDestination `json:"destination"`
+
+	// Specifies an inventory filter. The inventory only includes objects that
+	// meet the filter's criteria.
+	// +optional
+	Filter *InventoryFilter `json:"filter,omitempty"`
+
+	// Object versions to include in the inventory list. If set to All, the
+	// list includes all the object versions, which adds the version-related
+	// fields VersionId, IsLatest, and DeleteMarker to the list. If set to
+	// Current, the list does not contain these version-related fields.
+	// +kubebuilder:validation:Enum=All;Current
+	IncludedObjectVersions string `json:"includedObjectVersions"`
+
+	// Specifies whether the inventory is enabled or disabled. If set to true,
+	// an inventory list is generated. If set to false, no inventory list is
+	// generated.
+	IsEnabled bool `json:"isEnabled"`
+
+	// Contains the optional fields that are included in the inventory results.
+	// +optional
+	OptionalFields []string `json:"optionalFields,omitempty"`
+
+	// Specifies the schedule for generating inventory results.
+	Schedule InventorySchedule `json:"schedule"`
+}
+
+// InventoryDestination specifies the inventory configuration for an Amazon S3
+// bucket.
+type InventoryDestination struct {
+	// Contains the bucket name, file format, bucket owner (optional), and
+	// prefix (optional) where inventory results are published.
+	S3BucketDestination InventoryS3BucketDestination `json:"s3BucketDestination"`
+}
+
+// InventoryS3BucketDestination contains the bucket name, file format, bucket
+// owner (optional), and prefix (optional) where inventory results are
+// published.
+type InventoryS3BucketDestination struct {
+	// The account ID that owns the destination S3 bucket. If no account ID is
+	// provided, the owner is not validated before exporting data.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the bucket where inventory results
+	// will be published.
+	Bucket string `json:"bucket"`
+
+	// Contains the type of server-side encryption used to encrypt the
+	// inventory results.
+	// +optional
+	Encryption *InventoryEncryption `json:"encryption,omitempty"`
+
+	// Specifies the output format of the inventory results.
+	// +kubebuilder:validation:Enum=CSV;ORC;Parquet
+	Format string `json:"format"`
+
+	// The prefix that is prepended to all inventory results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// InventoryEncryption contains the type of server-side encryption used to
+// encrypt the inventory results. Only one of sseKMS and sseS3 can be given.
+type InventoryEncryption struct {
+	// Specifies the use of SSE-KMS to encrypt delivered inventory reports.
+	// +optional
+	SSEKMS *SSEKMS `json:"sseKMS,omitempty"`
+
+	// Specifies the use of SSE-S3 to encrypt delivered inventory reports.
+	// +optional
+	SSES3 *bool `json:"sseS3,omitempty"`
+}
+
+// SSEKMS specifies the use of SSE-KMS to encrypt delivered inventory reports.
+type SSEKMS struct {
+	// Specifies the ID of the AWS Key Management Service (AWS KMS) symmetric
+	// customer managed customer master key (CMK) to use for encrypting
+	// inventory reports.
+	KeyID string `json:"keyId"`
+}
+
+// InventoryFilter specifies an inventory filter. The inventory only includes
+// objects that meet the filter's criteria.
+type InventoryFilter struct {
+	// The prefix that an object must have to be included in the inventory
+	// results.
+	Prefix string `json:"prefix"`
+}
+
+// InventorySchedule specifies the schedule for generating inventory results.
+type InventorySchedule struct {
+	// Specifies how frequently inventory results are produced.
+	// +kubebuilder:validation:Enum=Daily;Weekly
+	Frequency string `json:"frequency"`
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// ObjectLockConfiguration places an Object Lock configuration on the bucket.
+// The rule specified in the Object Lock configuration is applied by default to
+// every new object placed in the bucket. Object Lock can be configured only on
+// buckets that are created with objectLockEnabledForBucket. For more
+// information, see Locking Objects (https://docs.aws.amazon.com/AmazonS3/latest/dev/object-lock.html).
+type ObjectLockConfiguration struct {
+	// Indicates whether this bucket has an Object Lock configuration enabled.
+	// +kubebuilder:validation:Enum=Enabled
+	// +optional
+	ObjectLockEnabled *string `json:"objectLockEnabled,omitempty"`
+
+	// Specifies the Object Lock rule for the bucket. The rule is applied by
+	// default to every new object placed in the bucket.
+	// +optional
+	Rule *ObjectLockRule `json:"rule,omitempty"`
+}
+
+// ObjectLockRule is the container for an Object Lock rule.
+type ObjectLockRule struct {
+	// The default retention period that you want to apply to new objects
+	// placed in the bucket.
+	DefaultRetention DefaultRetention `json:"defaultRetention"`
+}
+
+// DefaultRetention is the default retention period applied to new objects
+// placed in the bucket. Exactly one of days or years must be given.
+type DefaultRetention struct {
+	// The number of days that you want to specify for the default retention
+	// period.
+	// +optional
+	Days *int32 `json:"days,omitempty"`
+
+	// The default Object Lock retention mode you want to apply to new objects
+	// placed in the bucket. Objects in COMPLIANCE mode cannot be overwritten or
+	// deleted by any user, including the root user, until the retention period
+	// expires.
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode"`
+
+	// The number of years that you want to specify for the default retention
+	// period.
+	// +optional
+	Years *int32 `json:"years,omitempty"`
+}
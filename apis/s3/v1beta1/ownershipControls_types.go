@@ -0,0 +1,44 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// OwnershipControls is the container for a bucket's ownership controls. For
+// more information, see Controlling ownership of objects and disabling ACLs
+// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html).
+type OwnershipControls struct {
+	// The container element for an ownership control rule.
+	Rules []OwnershipControlsRule `json:"rules"`
+}
+
+// OwnershipControlsRule is the container element for an ownership control rule.
+type OwnershipControlsRule struct {
+	// The container element for object ownership for a bucket's ownership
+	// controls.
+	//
+	// BucketOwnerPreferred - Objects uploaded to the bucket change ownership
+	// to the bucket owner if the objects are uploaded with the
+	// bucket-owner-full-control canned ACL.
+	//
+	// ObjectWriter - The uploading account will own the object if the object
+	// is uploaded with the bucket-owner-full-control canned ACL.
+	//
+	// BucketOwnerEnforced - ACLs are disabled, and the bucket owner owns every
+	// object in the bucket. The bucket accepts only requests that don't
+	// specify an ACL or that specify bucket owner full control ACLs.
+	// +kubebuilder:validation:Enum=BucketOwnerPreferred;ObjectWriter;BucketOwnerEnforced
+	ObjectOwnership string `json:"objectOwnership"`
+}
@@ -34,12 +34,14 @@ type CustomSecretParameters struct {
 	// as string to AWS. If key parameter is given, only the value of that key
 	// will be used. Otherwise, all data in the Secret will be marshalled into
 	// JSON and sent to AWS.
+	// +optional
 	StringSecretRef *SecretReference `json:"stringSecretRef,omitempty"`
 
 	// BinarySecretRef points to the Kubernetes Secret whose data will be encoded
 	// as binary data to AWS. If key parameter is given, only the value of that
 	// key will be used. Otherwise, all data in the Secret will be marshalled
 	// into JSON and sent to AWS.
+	// +optional
 	BinarySecretRef *SecretReference `json:"binarySecretRef,omitempty"`
 
 	// (Optional) Specifies that the secret is to be deleted without any recovery
@@ -75,6 +77,65 @@ type CustomSecretParameters struct {
 	// ResourcePolicy is a required field
 	// +optional
 	ResourcePolicy *string `json:"resourcePolicy,omitempty"`
+
+	// RotationEnabled cancels the rotation of the secret when set to false
+	// and re-enables it with the last used Lambda function when set to true.
+	// The rotation of the secret is left as is when neither this nor
+	// RotationLambdaARN is given.
+	// +optional
+	RotationEnabled *bool `json:"rotationEnabled,omitempty"`
+
+	// RotationLambdaARN is the ARN of the Lambda function that rotates the
+	// secret. Rotation is enabled with this function when it is given unless
+	// RotationEnabled is false.
+	// +optional
+	RotationLambdaARN *string `json:"rotationLambdaARN,omitempty"`
+
+	// RotationLambdaARNRef is a reference to a lambda/v1alpha1.Function used
+	// to set the RotationLambdaARN field.
+	// +optional
+	RotationLambdaARNRef *xpv1.Reference `json:"rotationLambdaARNRef,omitempty"`
+
+	// RotationLambdaARNSelector selects a reference to a
+	// lambda/v1alpha1.Function used to set the RotationLambdaARN field.
+	// +optional
+	RotationLambdaARNSelector *xpv1.Selector `json:"rotationLambdaARNSelector,omitempty"`
+
+	// RotationRules configures when the secret is rotated. It is used only
+	// when RotationLambdaARN is given.
+	// +optional
+	RotationRules *RotationRules `json:"rotationRules,omitempty"`
+
+	// ReverseSync makes the controller read the AWSCURRENT value of the
+	// secret and write it to the connection secret of this resource, so that
+	// the value rotated in AWS is available in Kubernetes. In this mode, the
+	// value in StringSecretRef or BinarySecretRef is sent to AWS only when the
+	// secret is created and either of them can be omitted.
+	// +optional
+	ReverseSync *ReverseSync `json:"reverseSync,omitempty"`
+}
+
+// RotationRules configures the rotation schedule of a secret.
+type RotationRules struct {
+	// AutomaticallyAfterDays is the number of days between automatic
+	// rotations of the secret.
+	// +kubebuilder:validation:Minimum=1
+	AutomaticallyAfterDays int64 `json:"automaticallyAfterDays"`
+}
+
+// ReverseSync configures how the value of a secret is written to the
+// connection secret.
+type ReverseSync struct {
+	// Key of the connection secret that the value is written to. Ignored if
+	// ExplodeJSON is true.
+	// +kubebuilder:default=value
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// ExplodeJSON writes every field of the value, which must be a JSON
+	// object, to its own key in the connection secret.
+	// +optional
+	ExplodeJSON bool `json:"explodeJSON,omitempty"`
 }
 
 // A SecretReference is a reference to a secret in an arbitrary namespace.
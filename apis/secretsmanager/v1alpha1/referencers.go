@@ -23,10 +23,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambda "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
 )
 
+// FunctionARN returns a function that returns the ARN of the given Lambda
+// Function.
+func FunctionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*lambda.Function)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.FunctionARN)
+	}
+}
+
 // ResolveReferences of this Secret
 func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -45,5 +59,19 @@ func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	mg.Spec.ForProvider.KMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.KMSKeyIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.rotationLambdaARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RotationLambdaARN),
+		Reference:    mg.Spec.ForProvider.RotationLambdaARNRef,
+		Selector:     mg.Spec.ForProvider.RotationLambdaARNSelector,
+		To:           reference.To{Managed: &lambda.Function{}, List: &lambda.FunctionList{}},
+		Extract:      FunctionARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.rotationLambdaARN")
+	}
+	mg.Spec.ForProvider.RotationLambdaARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RotationLambdaARNRef = rsp.ResolvedReference
 	return nil
 }
@@ -63,6 +63,36 @@ func (in *CustomSecretParameters) DeepCopyInto(out *CustomSecretParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RotationEnabled != nil {
+		in, out := &in.RotationEnabled, &out.RotationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RotationLambdaARN != nil {
+		in, out := &in.RotationLambdaARN, &out.RotationLambdaARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationLambdaARNRef != nil {
+		in, out := &in.RotationLambdaARNRef, &out.RotationLambdaARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RotationLambdaARNSelector != nil {
+		in, out := &in.RotationLambdaARNSelector, &out.RotationLambdaARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotationRules != nil {
+		in, out := &in.RotationRules, &out.RotationRules
+		*out = new(RotationRules)
+		**out = **in
+	}
+	if in.ReverseSync != nil {
+		in, out := &in.ReverseSync, &out.ReverseSync
+		*out = new(ReverseSync)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSecretParameters.
@@ -106,6 +136,36 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReverseSync) DeepCopyInto(out *ReverseSync) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReverseSync.
+func (in *ReverseSync) DeepCopy() *ReverseSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ReverseSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotationRules) DeepCopyInto(out *RotationRules) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationRules.
+func (in *RotationRules) DeepCopy() *RotationRules {
+	if in == nil {
+		return nil
+	}
+	out := new(RotationRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RotationRulesType) DeepCopyInto(out *RotationRulesType) {
 	*out = *in
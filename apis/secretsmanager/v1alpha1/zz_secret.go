@@ -27,8 +27,8 @@ import (
 // SecretParameters defines the desired state of Secret
 type SecretParameters struct {
 	// Region is which region the Secret will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// (Optional) Specifies a user-provided description of the secret.
 	Description *string `json:"description,omitempty"`
 	// (Optional) Specifies the ARN, Key ID, or alias of the AWS KMS customer master
@@ -27,8 +27,8 @@ import (
 // PrivateDNSNamespaceParameters defines the desired state of PrivateDNSNamespace
 type PrivateDNSNamespaceParameters struct {
 	// Region is which region the PrivateDNSNamespace will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A description for the namespace.
 	Description *string `json:"description,omitempty"`
 	// The name that you want to assign to this namespace. When you create a private
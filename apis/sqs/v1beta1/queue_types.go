@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 )
 
 // Enum values for Queue attribute names
@@ -66,7 +68,8 @@ type RedrivePolicy struct {
 // QueueParameters define the desired state of an AWS Queue
 type QueueParameters struct {
 	// Region is the region you'd like your Queue to be created in.
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// DelaySeconds - The length of time, in seconds, for which the delivery
 	// of all messages in the queue is delayed. Valid values: An integer from
@@ -92,6 +95,11 @@ type QueueParameters struct {
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
+	// PolicyDocument is the structured form of the queue's policy whose
+	// references are resolved to ARNs. It takes precedence over Policy.
+	// +optional
+	PolicyDocument *commonv1alpha1.PolicyDocument `json:"policyDocument,omitempty"`
+
 	// ReceiveMessageWaitTimeSeconds - The length of time, in seconds, for
 	// which a ReceiveMessage action waits for a message to arrive. Valid values:
 	// an integer from 0 to 20 (seconds). Default: 0.
@@ -27,8 +27,8 @@ import (
 // ServerParameters defines the desired state of Server
 type ServerParameters struct {
 	// Region is which region the Server will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The Amazon Resource Name (ARN) of the AWS Certificate Manager (ACM) certificate.
 	// Required when Protocols is set to FTPS.
 	//
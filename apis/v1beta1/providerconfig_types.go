@@ -31,17 +31,126 @@ type ProviderConfigSpec struct {
 	// of AWS calls made by the provider.
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// AssumeRoleChain is the list of IAM roles that will be assumed in order
+	// after the credentials are resolved. The first role is assumed with the
+	// credentials given in the Credentials field and every following role is
+	// assumed with the temporary credentials of the previous one.
+	// +optional
+	AssumeRoleChain []AssumeRoleOptions `json:"assumeRoleChain,omitempty"`
+
+	// DefaultRegion is the region used for the managed resources that don't
+	// specify one.
+	// +optional
+	DefaultRegion string `json:"defaultRegion,omitempty"`
+
+	// DefaultTags are added to the tags of the managed resources that use
+	// this ProviderConfig. A tag given in the managed resource takes
+	// precedence over a default tag with the same key.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+}
+
+// AssumeRoleOptions define the options for assuming an IAM Role.
+type AssumeRoleOptions struct {
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is a unique identifier that might be required when you
+	// assume a role in another account.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+
+	// SessionName is an identifier for the assumed role session. A name is
+	// generated by the SDK if it is not given.
+	// +optional
+	SessionName *string `json:"sessionName,omitempty"`
+
+	// Duration is the expiry duration of the role session. AWS defaults to
+	// 15 minutes if it is not given.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Tags is the list of session tags that you want to pass. Each session
+	// tag consists of a key name and an associated value. For more
+	// information about session tags, see Tagging STS Sessions
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html).
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// TransitiveTagKeys is the list of session tag keys that you want to set
+	// as transitive so that they are passed to the subsequent sessions in the
+	// role chain.
+	// +optional
+	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+}
+
+// Tag is a key-value pair.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
 }
 
+// CredentialsSourceWebIdentity indicates that the provider should assume an
+// IAM role with an OIDC token, i.e. via AssumeRoleWithWebIdentity.
+const CredentialsSourceWebIdentity xpv1.CredentialsSource = "WebIdentity"
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;WebIdentity
 	Source xpv1.CredentialsSource `json:"source"`
 
+	// Profile is the name of the profile to use when the credentials are in
+	// the shared configuration format, i.e. the content of ~/.aws/config or
+	// ~/.aws/credentials. Profiles with role_arn and source_profile are
+	// resolved by assuming the role. Defaults to the default profile.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// WebIdentity defines the role to assume and the OIDC token to use when
+	// the source is WebIdentity.
+	// +optional
+	WebIdentity *WebIdentityConfig `json:"webIdentity,omitempty"`
+
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// WebIdentityConfig defines the options for assuming an IAM role with an OIDC
+// token issued by an identity provider that AWS IAM is federated with.
+type WebIdentityConfig struct {
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// RoleSessionName is an identifier for the assumed role session. A name
+	// is generated by the SDK if it is not given.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// TokenConfig is the location of the OIDC token.
+	TokenConfig WebIdentityTokenConfig `json:"tokenConfig"`
+}
+
+// WebIdentityTokenConfig is the location of an OIDC token.
+type WebIdentityTokenConfig struct {
+	// Source of the token. Filesystem should be used for projected service
+	// account tokens, which are read again whenever the credentials are
+	// refreshed.
+	// +kubebuilder:validation:Enum=Secret;Filesystem
+	Source xpv1.CredentialsSource `json:"source"`
+
+	// SecretRef is a reference to a secret key that contains the token.
+	// +optional
+	SecretRef *xpv1.SecretKeySelector `json:"secretRef,omitempty"`
+
+	// Fs is a reference to a filesystem location that contains the token.
+	// +optional
+	Fs *xpv1.FsSelector `json:"fs,omitempty"`
+}
+
 // EndpointConfig is used to configure the AWS client for a custom endpoint.
 type EndpointConfig struct {
 	// URL lets you configure the endpoint URL to be used in SDK calls.
@@ -21,9 +21,51 @@ limitations under the License.
 package v1beta1
 
 import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRoleOptions) DeepCopyInto(out *AssumeRoleOptions) {
+	*out = *in
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionName != nil {
+		in, out := &in.SessionName, &out.SessionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.TransitiveTagKeys != nil {
+		in, out := &in.TransitiveTagKeys, &out.TransitiveTagKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleOptions.
+func (in *AssumeRoleOptions) DeepCopy() *AssumeRoleOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRoleOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicURLConfig) DeepCopyInto(out *DynamicURLConfig) {
 	*out = *in
@@ -153,6 +195,20 @@ func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 		*out = new(EndpointConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AssumeRoleChain != nil {
+		in, out := &in.AssumeRoleChain, &out.AssumeRoleChain
+		*out = make([]AssumeRoleOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -242,6 +298,11 @@ func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
 	*out = *in
+	if in.WebIdentity != nil {
+		in, out := &in.WebIdentity, &out.WebIdentity
+		*out = new(WebIdentityConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
 }
 
@@ -255,6 +316,21 @@ func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLConfig) DeepCopyInto(out *URLConfig) {
 	*out = *in
@@ -279,3 +355,49 @@ func (in *URLConfig) DeepCopy() *URLConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebIdentityConfig) DeepCopyInto(out *WebIdentityConfig) {
+	*out = *in
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+	in.TokenConfig.DeepCopyInto(&out.TokenConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebIdentityConfig.
+func (in *WebIdentityConfig) DeepCopy() *WebIdentityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebIdentityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebIdentityTokenConfig) DeepCopyInto(out *WebIdentityTokenConfig) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Fs != nil {
+		in, out := &in.Fs, &out.Fs
+		*out = new(commonv1.FsSelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebIdentityTokenConfig.
+func (in *WebIdentityTokenConfig) DeepCopy() *WebIdentityTokenConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebIdentityTokenConfig)
+	in.DeepCopyInto(out)
+	return out
+}
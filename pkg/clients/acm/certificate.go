@@ -11,9 +11,14 @@ import (
 	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
 
 	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// validationRecordTTL is the TTL of the DNS validation records that are
+// created in Route53.
+const validationRecordTTL = 300
+
 // Client defines the CertificateManager operations
 type Client interface {
 	// GetCertificate(*acm.GetCertificateInput) acm.GetCertificate
@@ -25,6 +30,8 @@ type Client interface {
 	AddTagsToCertificate(context.Context, *acm.AddTagsToCertificateInput, ...func(*acm.Options)) (*acm.AddTagsToCertificateOutput, error)
 	RenewCertificate(context.Context, *acm.RenewCertificateInput, ...func(*acm.Options)) (*acm.RenewCertificateOutput, error)
 	RemoveTagsFromCertificate(context.Context, *acm.RemoveTagsFromCertificateInput, ...func(*acm.Options)) (*acm.RemoveTagsFromCertificateOutput, error)
+	ImportCertificate(context.Context, *acm.ImportCertificateInput, ...func(*acm.Options)) (*acm.ImportCertificateOutput, error)
+	GetCertificate(context.Context, *acm.GetCertificateInput, ...func(*acm.Options)) (*acm.GetCertificateOutput, error)
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -89,15 +96,56 @@ func GenerateCertificateStatus(certificate types.CertificateDetail) v1alpha1.Cer
 					Value: certificate.DomainValidationOptions[0].ResourceRecord.Value,
 					Type:  (*string)(&certificate.DomainValidationOptions[0].ResourceRecord.Type),
 				},
+				DomainValidationRecords: GenerateDomainValidationRecords(certificate),
 			}
 		}
 	}
 
 	return v1alpha1.CertificateExternalStatus{
-		CertificateARN:     aws.ToString(certificate.CertificateArn),
-		RenewalEligibility: certificate.RenewalEligibility,
-		Status:             certificate.Status,
-		Type:               certificate.Type,
+		CertificateARN:          aws.ToString(certificate.CertificateArn),
+		RenewalEligibility:      certificate.RenewalEligibility,
+		Status:                  certificate.Status,
+		Type:                    certificate.Type,
+		DomainValidationRecords: GenerateDomainValidationRecords(certificate),
+	}
+}
+
+// GenerateDomainValidationRecords returns the DNS validation records of the
+// certificate. ACM uses the same record for a domain and its wildcard, so the
+// records are deduplicated by their names.
+func GenerateDomainValidationRecords(certificate types.CertificateDetail) []v1alpha1.DomainValidationRecord {
+	var records []v1alpha1.DomainValidationRecord
+	seen := map[string]bool{}
+	for _, o := range certificate.DomainValidationOptions {
+		if o.ResourceRecord == nil || seen[aws.ToString(o.ResourceRecord.Name)] {
+			continue
+		}
+		seen[aws.ToString(o.ResourceRecord.Name)] = true
+		records = append(records, v1alpha1.DomainValidationRecord{
+			DomainName:       aws.ToString(o.DomainName),
+			Name:             aws.ToString(o.ResourceRecord.Name),
+			Type:             string(o.ResourceRecord.Type),
+			Value:            aws.ToString(o.ResourceRecord.Value),
+			ValidationStatus: o.ValidationStatus,
+		})
+	}
+	return records
+}
+
+// IsDNSValidationManaged returns whether the DNS validation records of the
+// certificate should be managed in Route53.
+func IsDNSValidationManaged(p v1alpha1.CertificateParameters) bool {
+	return p.HostedZoneID != nil && p.ValidationMethod != nil && *p.ValidationMethod == types.ValidationMethodDns
+}
+
+// GenerateValidationRecordSetParameters returns the parameters of the Route53
+// record set of the given validation record in the given hosted zone.
+func GenerateValidationRecordSetParameters(zoneID string, r v1alpha1.DomainValidationRecord) route53v1alpha1.ResourceRecordSetParameters {
+	return route53v1alpha1.ResourceRecordSetParameters{
+		Type:            r.Type,
+		TTL:             aws.Int64(validationRecordTTL),
+		ResourceRecords: []route53v1alpha1.ResourceRecord{{Value: r.Value}},
+		ZoneID:          aws.String(zoneID),
 	}
 }
 
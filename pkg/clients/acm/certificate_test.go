@@ -197,6 +197,14 @@ func TestGenerateCertificateStatus(t *testing.T) {
 					Value: &sValue,
 					Type:  &sType,
 				},
+				DomainValidationRecords: []v1alpha1.DomainValidationRecord{
+					{
+						DomainName: sName,
+						Name:       sName,
+						Type:       sType,
+						Value:      sValue,
+					},
+				},
 			},
 		},
 	}
@@ -211,6 +219,55 @@ func TestGenerateCertificateStatus(t *testing.T) {
 	}
 }
 
+func TestGenerateDomainValidationRecords(t *testing.T) {
+	sName := "_xyz.crossplane.io."
+	sType := "CNAME"
+	sValue := "_xxx.zzz.acm-validations.aws."
+	cases := map[string]struct {
+		in  acmtypes.CertificateDetail
+		out []v1alpha1.DomainValidationRecord
+	}{
+		"NoRecords": {
+			in: acmtypes.CertificateDetail{
+				DomainValidationOptions: []acmtypes.DomainValidation{{DomainName: aws.String("crossplane.io")}},
+			},
+		},
+		"SharedRecord": {
+			in: acmtypes.CertificateDetail{
+				DomainValidationOptions: []acmtypes.DomainValidation{
+					{
+						DomainName:       aws.String("crossplane.io"),
+						ValidationStatus: acmtypes.DomainStatusSuccess,
+						ResourceRecord:   &acmtypes.ResourceRecord{Name: &sName, Value: &sValue, Type: acmtypes.RecordTypeCname},
+					},
+					{
+						DomainName:     aws.String("*.crossplane.io"),
+						ResourceRecord: &acmtypes.ResourceRecord{Name: &sName, Value: &sValue, Type: acmtypes.RecordTypeCname},
+					},
+				},
+			},
+			out: []v1alpha1.DomainValidationRecord{
+				{
+					DomainName:       "crossplane.io",
+					Name:             sName,
+					Type:             sType,
+					Value:            sValue,
+					ValidationStatus: acmtypes.DomainStatusSuccess,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateDomainValidationRecords(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateDomainValidationRecords(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsCertificateUpToDate(t *testing.T) {
 	certificateTransparencyLoggingPreference := acmtypes.CertificateTransparencyLoggingPreferenceDisabled
 	type args struct {
@@ -37,6 +37,8 @@ type MockCertificateClient struct {
 	MockListTagsForCertificate    func(context.Context, *acm.ListTagsForCertificateInput, []func(*acm.Options)) (*acm.ListTagsForCertificateOutput, error)
 	MockRenewCertificate          func(context.Context, *acm.RenewCertificateInput, []func(*acm.Options)) (*acm.RenewCertificateOutput, error)
 	MockRemoveTagsFromCertificate func(context.Context, *acm.RemoveTagsFromCertificateInput, []func(*acm.Options)) (*acm.RemoveTagsFromCertificateOutput, error)
+	MockImportCertificate         func(context.Context, *acm.ImportCertificateInput, []func(*acm.Options)) (*acm.ImportCertificateOutput, error)
+	MockGetCertificate            func(context.Context, *acm.GetCertificateInput, []func(*acm.Options)) (*acm.GetCertificateOutput, error)
 }
 
 // DescribeCertificate mocks DescribeCertificate method
@@ -78,3 +80,13 @@ func (m *MockCertificateClient) RemoveTagsFromCertificate(ctx context.Context, i
 func (m *MockCertificateClient) AddTagsToCertificate(ctx context.Context, input *acm.AddTagsToCertificateInput, opts ...func(*acm.Options)) (*acm.AddTagsToCertificateOutput, error) {
 	return m.MockAddTagsToCertificate(ctx, input, opts)
 }
+
+// ImportCertificate mocks ImportCertificate method
+func (m *MockCertificateClient) ImportCertificate(ctx context.Context, input *acm.ImportCertificateInput, opts ...func(*acm.Options)) (*acm.ImportCertificateOutput, error) {
+	return m.MockImportCertificate(ctx, input, opts)
+}
+
+// GetCertificate mocks GetCertificate method
+func (m *MockCertificateClient) GetCertificate(ctx context.Context, input *acm.GetCertificateInput, opts ...func(*acm.Options)) (*acm.GetCertificateOutput, error) {
+	return m.MockGetCertificate(ctx, input, opts)
+}
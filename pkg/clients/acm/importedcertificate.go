@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"bytes"
+	"encoding/pem"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+)
+
+const (
+	errNoCertificate = "tls.crt key of the secret does not contain a PEM encoded certificate"
+	errNoPrivateKey  = "tls.key key of the secret is empty"
+
+	pemTypeCertificate = "CERTIFICATE"
+
+	// tlsCACertKey is the key of the CA certificate in the TLS secrets that
+	// are issued by cert-manager.
+	tlsCACertKey = "ca.crt"
+)
+
+// CertificateMaterial is the certificate, its private key and its chain
+// that are imported to ACM.
+type CertificateMaterial struct {
+	Certificate []byte
+	PrivateKey  []byte
+	Chain       []byte
+}
+
+// GetCertificateMaterial extracts the certificate material from the data of
+// a kubernetes.io/tls Secret. The first certificate in tls.crt is the
+// certificate and the rest is its chain. If there is no chain in tls.crt,
+// ca.crt is used as the chain.
+func GetCertificateMaterial(data map[string][]byte) (CertificateMaterial, error) {
+	certs := pemCertificates(data[corev1.TLSCertKey])
+	if len(certs) == 0 {
+		return CertificateMaterial{}, errors.New(errNoCertificate)
+	}
+	if len(data[corev1.TLSPrivateKeyKey]) == 0 {
+		return CertificateMaterial{}, errors.New(errNoPrivateKey)
+	}
+	m := CertificateMaterial{
+		Certificate: encodeCertificates(certs[:1]),
+		PrivateKey:  data[corev1.TLSPrivateKeyKey],
+		Chain:       encodeCertificates(certs[1:]),
+	}
+	if m.Chain == nil {
+		m.Chain = encodeCertificates(pemCertificates(data[tlsCACertKey]))
+	}
+	return m, nil
+}
+
+// GenerateImportCertificateInput returns the input to import the given
+// certificate material. A certificate is imported again if its ARN is given.
+// ACM doesn't allow tagging a certificate that is imported again, so the tags
+// are given only when the ARN is empty.
+func GenerateImportCertificateInput(arn string, m CertificateMaterial, p v1alpha1.ImportedCertificateParameters) *acm.ImportCertificateInput {
+	input := &acm.ImportCertificateInput{
+		Certificate:      m.Certificate,
+		PrivateKey:       m.PrivateKey,
+		CertificateChain: m.Chain,
+	}
+	if arn != "" {
+		input.CertificateArn = aws.String(arn)
+		return input
+	}
+	for _, t := range p.Tags {
+		input.Tags = append(input.Tags, types.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	return input
+}
+
+// IsCertificateMaterialUpToDate returns whether the certificate and the chain
+// in ACM are the same as the ones in the given material. The certificates are
+// compared in their DER encodings so that formatting differences in PEM
+// encodings are ignored.
+func IsCertificateMaterialUpToDate(m CertificateMaterial, out acm.GetCertificateOutput) bool {
+	return equalCertificates(m.Certificate, []byte(aws.ToString(out.Certificate))) &&
+		equalCertificates(m.Chain, []byte(aws.ToString(out.CertificateChain)))
+}
+
+// GenerateImportedCertificateObservation is used to produce
+// v1alpha1.ImportedCertificateObservation from types.CertificateDetail.
+func GenerateImportedCertificateObservation(cd types.CertificateDetail) v1alpha1.ImportedCertificateObservation {
+	o := v1alpha1.ImportedCertificateObservation{
+		CertificateARN:          aws.ToString(cd.CertificateArn),
+		DomainName:              aws.ToString(cd.DomainName),
+		SubjectAlternativeNames: cd.SubjectAlternativeNames,
+		Serial:                  aws.ToString(cd.Serial),
+		Status:                  cd.Status,
+		InUseBy:                 cd.InUseBy,
+	}
+	if cd.NotBefore != nil {
+		t := metav1.NewTime(*cd.NotBefore)
+		o.NotBefore = &t
+	}
+	if cd.NotAfter != nil {
+		t := metav1.NewTime(*cd.NotAfter)
+		o.NotAfter = &t
+	}
+	if cd.ImportedAt != nil {
+		t := metav1.NewTime(*cd.ImportedAt)
+		o.ImportedAt = &t
+	}
+	return o
+}
+
+// DiffTags returns the tags that should be added and removed so that the
+// current tags of a certificate are the same as the desired ones.
+func DiffTags(desired []v1alpha1.Tag, current []types.Tag) (add, remove []types.Tag) {
+	currentMap := make(map[string]string, len(current))
+	for _, t := range current {
+		currentMap[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	desiredMap := make(map[string]string, len(desired))
+	for _, t := range desired {
+		desiredMap[t.Key] = t.Value
+		if v, ok := currentMap[t.Key]; !ok || v != t.Value {
+			add = append(add, types.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+	}
+	for k, v := range currentMap {
+		if _, ok := desiredMap[k]; !ok {
+			remove = append(remove, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+	}
+	sort.Slice(remove, func(i, j int) bool { return aws.ToString(remove[i].Key) < aws.ToString(remove[j].Key) })
+	return add, remove
+}
+
+// pemCertificates returns the DER encodings of the PEM encoded certificates
+// in the given data.
+func pemCertificates(data []byte) [][]byte {
+	var certs [][]byte
+	for {
+		var b *pem.Block
+		b, data = pem.Decode(data)
+		if b == nil {
+			return certs
+		}
+		if b.Type == pemTypeCertificate {
+			certs = append(certs, b.Bytes)
+		}
+	}
+}
+
+func encodeCertificates(certs [][]byte) []byte {
+	var out []byte
+	for _, c := range certs {
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: c})...)
+	}
+	return out
+}
+
+func equalCertificates(a, b []byte) bool {
+	ca, cb := pemCertificates(a), pemCertificates(b)
+	if len(ca) != len(cb) {
+		return false
+	}
+	for i := range ca {
+		if !bytes.Equal(ca[i], cb[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acm
+
+import (
+	"encoding/pem"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+)
+
+func pemCert(s string) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: []byte(s)})
+}
+
+func TestGetCertificateMaterial(t *testing.T) {
+	key := []byte("key")
+	type want struct {
+		m   CertificateMaterial
+		err error
+	}
+	cases := map[string]struct {
+		data map[string][]byte
+		want want
+	}{
+		"ChainInCertificate": {
+			data: map[string][]byte{
+				"tls.crt": append(pemCert("leaf"), pemCert("intermediate")...),
+				"tls.key": key,
+				"ca.crt":  pemCert("root"),
+			},
+			want: want{
+				m: CertificateMaterial{Certificate: pemCert("leaf"), PrivateKey: key, Chain: pemCert("intermediate")},
+			},
+		},
+		"ChainFromCA": {
+			data: map[string][]byte{
+				"tls.crt": pemCert("leaf"),
+				"tls.key": key,
+				"ca.crt":  pemCert("root"),
+			},
+			want: want{
+				m: CertificateMaterial{Certificate: pemCert("leaf"), PrivateKey: key, Chain: pemCert("root")},
+			},
+		},
+		"NoChain": {
+			data: map[string][]byte{
+				"tls.crt": pemCert("leaf"),
+				"tls.key": key,
+			},
+			want: want{
+				m: CertificateMaterial{Certificate: pemCert("leaf"), PrivateKey: key},
+			},
+		},
+		"NoCertificate": {
+			data: map[string][]byte{
+				"tls.key": key,
+			},
+			want: want{
+				err: errors.New(errNoCertificate),
+			},
+		},
+		"NoPrivateKey": {
+			data: map[string][]byte{
+				"tls.crt": pemCert("leaf"),
+			},
+			want: want{
+				err: errors.New(errNoPrivateKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m, err := GetCertificateMaterial(tc.data)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.m, m); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCertificateMaterialUpToDate(t *testing.T) {
+	m := CertificateMaterial{Certificate: pemCert("leaf"), Chain: pemCert("root")}
+	cases := map[string]struct {
+		out  acm.GetCertificateOutput
+		want bool
+	}{
+		"UpToDate": {
+			out: acm.GetCertificateOutput{
+				Certificate:      aws.String("\n" + string(pemCert("leaf"))),
+				CertificateChain: aws.String(string(pemCert("root"))),
+			},
+			want: true,
+		},
+		"CertificateChanged": {
+			out: acm.GetCertificateOutput{
+				Certificate:      aws.String(string(pemCert("old"))),
+				CertificateChain: aws.String(string(pemCert("root"))),
+			},
+			want: false,
+		},
+		"ChainChanged": {
+			out: acm.GetCertificateOutput{
+				Certificate: aws.String(string(pemCert("leaf"))),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCertificateMaterialUpToDate(m, tc.out)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []acmtypes.Tag
+		remove []acmtypes.Tag
+	}
+	cases := map[string]struct {
+		desired []v1alpha1.Tag
+		current []acmtypes.Tag
+		want    want
+	}{
+		"Same": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			current: []acmtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"Changed": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "new"}, {Key: "a", Value: "b"}},
+			current: []acmtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}, {Key: aws.String("x"), Value: aws.String("y")}},
+			want: want{
+				add: []acmtypes.Tag{
+					{Key: aws.String("k"), Value: aws.String("new")},
+					{Key: aws.String("a"), Value: aws.String("b")},
+				},
+				remove: []acmtypes.Tag{{Key: aws.String("x"), Value: aws.String("y")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(acmtypes.Tag{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreUnexported(acmtypes.Tag{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,9 +25,27 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 )
 
+const (
+	// RootCACertificateTemplateARN is the ARN of the template that is used to
+	// issue the CA certificate of ROOT CAs by default.
+	RootCACertificateTemplateARN = "arn:aws:acm-pca:::template/RootCACertificate/V1"
+
+	// SubordinateCACertificateTemplateARN is the ARN of the template that is
+	// used to issue the CA certificate of SUBORDINATE CAs by default.
+	SubordinateCACertificateTemplateARN = "arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen0/V1"
+
+	// maxIdempotencyTokenLength is the maximum length of the idempotency
+	// token accepted by IssueCertificate.
+	maxIdempotencyTokenLength = 36
+
+	errNoParentCertificateAuthority = "parent certificate authority ARN is required to activate a SUBORDINATE certificate authority"
+)
+
 // Client defines the CertificateManager operations
 type Client interface {
 	CreateCertificateAuthority(context.Context, *acmpca.CreateCertificateAuthorityInput, ...func(*acmpca.Options)) (*acmpca.CreateCertificateAuthorityOutput, error)
@@ -37,6 +55,11 @@ type Client interface {
 	ListTags(context.Context, *acmpca.ListTagsInput, ...func(*acmpca.Options)) (*acmpca.ListTagsOutput, error)
 	UntagCertificateAuthority(context.Context, *acmpca.UntagCertificateAuthorityInput, ...func(*acmpca.Options)) (*acmpca.UntagCertificateAuthorityOutput, error)
 	TagCertificateAuthority(context.Context, *acmpca.TagCertificateAuthorityInput, ...func(*acmpca.Options)) (*acmpca.TagCertificateAuthorityOutput, error)
+	GetCertificateAuthorityCsr(context.Context, *acmpca.GetCertificateAuthorityCsrInput, ...func(*acmpca.Options)) (*acmpca.GetCertificateAuthorityCsrOutput, error)
+	IssueCertificate(context.Context, *acmpca.IssueCertificateInput, ...func(*acmpca.Options)) (*acmpca.IssueCertificateOutput, error)
+	GetCertificate(context.Context, *acmpca.GetCertificateInput, ...func(*acmpca.Options)) (*acmpca.GetCertificateOutput, error)
+	ImportCertificateAuthorityCertificate(context.Context, *acmpca.ImportCertificateAuthorityCertificateInput, ...func(*acmpca.Options)) (*acmpca.ImportCertificateAuthorityCertificateOutput, error)
+	GetCertificateAuthorityCertificate(context.Context, *acmpca.GetCertificateAuthorityCertificateInput, ...func(*acmpca.Options)) (*acmpca.GetCertificateAuthorityCertificateOutput, error)
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -196,6 +219,96 @@ func GenerateCertificateAuthorityExternalStatus(certificateAuthority types.Certi
 	}
 }
 
+// IsActivationPending returns true if the certificate authority waits for a CA
+// certificate that the controller is configured to issue.
+func IsActivationPending(p v1alpha1.CertificateAuthorityParameters, status string) bool {
+	return p.Activation != nil && status == string(types.CertificateAuthorityStatusPendingCertificate)
+}
+
+// HasCACertificate returns true if a CA certificate is imported to the
+// certificate authority with the given status.
+func HasCACertificate(status string) bool {
+	switch types.CertificateAuthorityStatus(status) {
+	case types.CertificateAuthorityStatusActive, types.CertificateAuthorityStatusDisabled, types.CertificateAuthorityStatusExpired:
+		return true
+	}
+	return false
+}
+
+// GetIssuerARN returns the ARN of the certificate authority that issues the
+// CA certificate of the certificate authority with the given ARN. ROOT CAs
+// issue their own certificate.
+func GetIssuerARN(p v1alpha1.CertificateAuthorityParameters, arn string) (string, error) {
+	if p.Type != types.CertificateAuthorityTypeSubordinate {
+		return arn, nil
+	}
+	if p.Activation == nil || aws.ToString(p.Activation.ParentCertificateAuthorityARN) == "" {
+		return "", errors.New(errNoParentCertificateAuthority)
+	}
+	return aws.ToString(p.Activation.ParentCertificateAuthorityARN), nil
+}
+
+// GenerateIssueCertificateInput returns the input to issue the CA certificate
+// of a certificate authority for the given CSR. The idempotency token is
+// derived from the given UID so that a retry after the issued certificate ARN
+// could not be stored does not issue a second certificate.
+func GenerateIssueCertificateInput(p v1alpha1.CertificateAuthorityParameters, uid, issuerARN string, alg types.SigningAlgorithm, csr []byte) *acmpca.IssueCertificateInput {
+	if len(uid) > maxIdempotencyTokenLength {
+		uid = uid[:maxIdempotencyTokenLength]
+	}
+	in := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(issuerARN),
+		Csr:                     csr,
+		IdempotencyToken:        aws.String(uid),
+		SigningAlgorithm:        alg,
+		TemplateArn:             aws.String(RootCACertificateTemplateARN),
+		Validity: &types.Validity{
+			Type:  p.Activation.Validity.Type,
+			Value: aws.Int64(p.Activation.Validity.Value),
+		},
+	}
+	if p.Type == types.CertificateAuthorityTypeSubordinate {
+		in.TemplateArn = aws.String(SubordinateCACertificateTemplateARN)
+	}
+	if p.Activation.TemplateARN != nil {
+		in.TemplateArn = p.Activation.TemplateARN
+	}
+	return in
+}
+
+// GenerateImportCertificateAuthorityCertificateInput returns the input to
+// import the issued CA certificate. The chain is imported only for
+// SUBORDINATE CAs since the certificate of a ROOT CA is self-signed.
+func GenerateImportCertificateAuthorityCertificateInput(p v1alpha1.CertificateAuthorityParameters, arn string, cert *acmpca.GetCertificateOutput) *acmpca.ImportCertificateAuthorityCertificateInput {
+	in := &acmpca.ImportCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(arn),
+		Certificate:             []byte(aws.ToString(cert.Certificate)),
+	}
+	if p.Type == types.CertificateAuthorityTypeSubordinate && aws.ToString(cert.CertificateChain) != "" {
+		in.CertificateChain = []byte(aws.ToString(cert.CertificateChain))
+	}
+	return in
+}
+
+// GetConnectionDetails returns the connection details of the certificate
+// authority with its CA certificate and chain.
+func GetConnectionDetails(out *acmpca.GetCertificateAuthorityCertificateOutput) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretCertificateKey: []byte(aws.ToString(out.Certificate)),
+	}
+	if out.CertificateChain != nil {
+		cd[v1alpha1.ResourceCredentialsSecretCertificateChainKey] = []byte(aws.ToString(out.CertificateChain))
+	}
+	return cd
+}
+
+// IsErrorRequestInProgress returns true if the error indicates that the
+// requested certificate is not issued yet.
+func IsErrorRequestInProgress(err error) bool {
+	var ripe *types.RequestInProgressException
+	return errors.As(err, &ripe)
+}
+
 // IsErrorNotFound returns true if the error code indicates that the item was not found
 func IsErrorNotFound(err error) bool {
 	var ise *types.InvalidStateException
@@ -352,3 +352,112 @@ func TestIsCertificateAuthorityUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateIssueCertificateInput(t *testing.T) {
+	csr := []byte("somecsr")
+	activation := &v1alpha1.Activation{
+		Validity: v1alpha1.Validity{Type: types.ValidityPeriodTypeYears, Value: 10},
+	}
+	type args struct {
+		p      v1alpha1.CertificateAuthorityParameters
+		uid    string
+		issuer string
+		alg    types.SigningAlgorithm
+	}
+
+	cases := map[string]struct {
+		args args
+		want *acmpca.IssueCertificateInput
+	}{
+		"Root": {
+			args: args{
+				p:      v1alpha1.CertificateAuthorityParameters{Type: types.CertificateAuthorityTypeRoot, Activation: activation},
+				uid:    "2c5ac2f4-8a7e-4f6b-9c1d-0e3b5a7d9f11",
+				issuer: "root",
+				alg:    types.SigningAlgorithmSha256withrsa,
+			},
+			want: &acmpca.IssueCertificateInput{
+				CertificateAuthorityArn: aws.String("root"),
+				Csr:                     csr,
+				IdempotencyToken:        aws.String("2c5ac2f4-8a7e-4f6b-9c1d-0e3b5a7d9f11"),
+				SigningAlgorithm:        types.SigningAlgorithmSha256withrsa,
+				TemplateArn:             aws.String(RootCACertificateTemplateARN),
+				Validity:                &types.Validity{Type: types.ValidityPeriodTypeYears, Value: aws.Int64(10)},
+			},
+		},
+		"SubordinateWithTemplate": {
+			args: args{
+				p: v1alpha1.CertificateAuthorityParameters{
+					Type: types.CertificateAuthorityTypeSubordinate,
+					Activation: &v1alpha1.Activation{
+						Validity:    activation.Validity,
+						TemplateARN: aws.String("template"),
+					},
+				},
+				uid:    "2c5ac2f4-8a7e-4f6b-9c1d-0e3b5a7d9f11-too-long",
+				issuer: "parent",
+				alg:    types.SigningAlgorithmSha384withecdsa,
+			},
+			want: &acmpca.IssueCertificateInput{
+				CertificateAuthorityArn: aws.String("parent"),
+				Csr:                     csr,
+				IdempotencyToken:        aws.String("2c5ac2f4-8a7e-4f6b-9c1d-0e3b5a7d9f11"),
+				SigningAlgorithm:        types.SigningAlgorithmSha384withecdsa,
+				TemplateArn:             aws.String("template"),
+				Validity:                &types.Validity{Type: types.ValidityPeriodTypeYears, Value: aws.Int64(10)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateIssueCertificateInput(tc.args.p, tc.args.uid, tc.args.issuer, tc.args.alg, csr)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetIssuerARN(t *testing.T) {
+	type want struct {
+		arn string
+		err bool
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.CertificateAuthorityParameters
+		want want
+	}{
+		"Root": {
+			p:    v1alpha1.CertificateAuthorityParameters{Type: types.CertificateAuthorityTypeRoot},
+			want: want{arn: "self"},
+		},
+		"Subordinate": {
+			p: v1alpha1.CertificateAuthorityParameters{
+				Type:       types.CertificateAuthorityTypeSubordinate,
+				Activation: &v1alpha1.Activation{ParentCertificateAuthorityARN: aws.String("parent")},
+			},
+			want: want{arn: "parent"},
+		},
+		"SubordinateWithoutParent": {
+			p: v1alpha1.CertificateAuthorityParameters{
+				Type:       types.CertificateAuthorityTypeSubordinate,
+				Activation: &v1alpha1.Activation{},
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			arn, err := GetIssuerARN(tc.p, "self")
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.arn, arn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
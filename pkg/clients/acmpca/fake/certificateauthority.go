@@ -38,6 +38,12 @@ type MockCertificateAuthorityClient struct {
 	MockListTags                     func(context.Context, *acmpca.ListTagsInput, []func(*acmpca.Options)) (*acmpca.ListTagsOutput, error)
 	MockUntagCertificateAuthority    func(context.Context, *acmpca.UntagCertificateAuthorityInput, []func(*acmpca.Options)) (*acmpca.UntagCertificateAuthorityOutput, error)
 	MockTagCertificateAuthority      func(context.Context, *acmpca.TagCertificateAuthorityInput, []func(*acmpca.Options)) (*acmpca.TagCertificateAuthorityOutput, error)
+	MockGetCertificateAuthorityCsr   func(context.Context, *acmpca.GetCertificateAuthorityCsrInput, []func(*acmpca.Options)) (*acmpca.GetCertificateAuthorityCsrOutput, error)
+	MockIssueCertificate             func(context.Context, *acmpca.IssueCertificateInput, []func(*acmpca.Options)) (*acmpca.IssueCertificateOutput, error)
+	MockGetCertificate               func(context.Context, *acmpca.GetCertificateInput, []func(*acmpca.Options)) (*acmpca.GetCertificateOutput, error)
+
+	MockImportCertificateAuthorityCertificate func(context.Context, *acmpca.ImportCertificateAuthorityCertificateInput, []func(*acmpca.Options)) (*acmpca.ImportCertificateAuthorityCertificateOutput, error)
+	MockGetCertificateAuthorityCertificate    func(context.Context, *acmpca.GetCertificateAuthorityCertificateInput, []func(*acmpca.Options)) (*acmpca.GetCertificateAuthorityCertificateOutput, error)
 }
 
 // CreateCertificateAuthority mocks CreateCertificateAuthority method
@@ -84,3 +90,28 @@ func (m *MockCertificateAuthorityClient) UpdateCertificateAuthority(ctx context.
 func (m *MockCertificateAuthorityClient) DeletePermission(ctx context.Context, input *acmpca.DeletePermissionInput, opts ...func(*acmpca.Options)) (*acmpca.DeletePermissionOutput, error) {
 	return m.MockDeletePermission(ctx, input, opts)
 }
+
+// GetCertificateAuthorityCsr mocks GetCertificateAuthorityCsr method
+func (m *MockCertificateAuthorityClient) GetCertificateAuthorityCsr(ctx context.Context, input *acmpca.GetCertificateAuthorityCsrInput, opts ...func(*acmpca.Options)) (*acmpca.GetCertificateAuthorityCsrOutput, error) {
+	return m.MockGetCertificateAuthorityCsr(ctx, input, opts)
+}
+
+// IssueCertificate mocks IssueCertificate method
+func (m *MockCertificateAuthorityClient) IssueCertificate(ctx context.Context, input *acmpca.IssueCertificateInput, opts ...func(*acmpca.Options)) (*acmpca.IssueCertificateOutput, error) {
+	return m.MockIssueCertificate(ctx, input, opts)
+}
+
+// GetCertificate mocks GetCertificate method
+func (m *MockCertificateAuthorityClient) GetCertificate(ctx context.Context, input *acmpca.GetCertificateInput, opts ...func(*acmpca.Options)) (*acmpca.GetCertificateOutput, error) {
+	return m.MockGetCertificate(ctx, input, opts)
+}
+
+// ImportCertificateAuthorityCertificate mocks ImportCertificateAuthorityCertificate method
+func (m *MockCertificateAuthorityClient) ImportCertificateAuthorityCertificate(ctx context.Context, input *acmpca.ImportCertificateAuthorityCertificateInput, opts ...func(*acmpca.Options)) (*acmpca.ImportCertificateAuthorityCertificateOutput, error) {
+	return m.MockImportCertificateAuthorityCertificate(ctx, input, opts)
+}
+
+// GetCertificateAuthorityCertificate mocks GetCertificateAuthorityCertificate method
+func (m *MockCertificateAuthorityClient) GetCertificateAuthorityCertificate(ctx context.Context, input *acmpca.GetCertificateAuthorityCertificateInput, opts ...func(*acmpca.Options)) (*acmpca.GetCertificateAuthorityCertificateOutput, error) {
+	return m.MockGetCertificateAuthorityCertificate(ctx, input, opts)
+}
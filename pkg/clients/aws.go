@@ -28,17 +28,20 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	ec2type "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	awsv1 "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	stscredsv1 "github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
+	stsv1 "github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/smithy-go"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-ini/ini"
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -65,6 +68,17 @@ const (
 	URLConfigTypeDynamic = "Dynamic"
 )
 
+// credentialsExpiryWindow is how long before their expiry the temporary
+// credentials are refreshed so that no call is made with credentials that
+// expire while the call is in flight.
+const credentialsExpiryWindow = 5 * time.Minute
+
+// withExpiryWindow makes the credentials cache refresh the credentials
+// credentialsExpiryWindow before they expire.
+func withExpiryWindow(o *aws.CredentialsCacheOptions) {
+	o.ExpiryWindow = credentialsExpiryWindow
+}
+
 // A FieldOption determines how common Go types are translated to the types
 // required by the AWS Go SDK.
 type FieldOption int
@@ -91,6 +105,34 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 	}
 }
 
+// GetDefaultTags returns the default tags given in the ProviderConfig of
+// given managed resource. Taggers are expected to add them to the tags of the
+// resource unless the resource has a tag with the same key.
+func GetDefaultTags(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return nil, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
+	}
+	return pc.Spec.DefaultTags, nil
+}
+
+// GetRegion returns given region if it's not empty, and the default region
+// given in the ProviderConfig of given managed resource otherwise. It's the
+// region that the configurations of the managed resource are built for.
+func GetRegion(ctx context.Context, c client.Client, mg resource.Managed, region string) (string, error) {
+	if region != "" || mg.GetProviderConfigReference() == nil {
+		return region, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return "", errors.Wrap(err, "cannot get referenced ProviderConfig")
+	}
+	return pc.Spec.DefaultRegion, nil
+}
+
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
@@ -103,23 +145,99 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	if region == "" {
+		region = pc.Spec.DefaultRegion
+	}
+	key, version, err := configCacheKey(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	if cfg, ok := configs.GetConfig(key, version); ok {
+		return cfg, nil
+	}
+	cfg, err := configForProviderConfig(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	configs.SetConfig(key, version, cfg)
+	return cfg, nil
+}
+
+func configForProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
 		if err != nil {
 			return nil, err
 		}
-		return SetResolver(pc, cfg), nil
+		return UseAssumeRoleChain(pc, SetResolver(pc, cfg)), nil
+	case v1beta1.CredentialsSourceWebIdentity:
+		cfg, err := UseWebIdentity(ctx, c, pc, region)
+		if err != nil {
+			return nil, err
+		}
+		return UseAssumeRoleChain(pc, cfg), nil
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials")
 		}
-		cfg, err := UseProviderSecret(ctx, data, DefaultSection, region)
+		sp, err := ResolveSharedProfile(data, credentialsProfile(pc))
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse credentials secret")
+		}
+		cfg, err := useSharedProfile(ctx, sp, region)
 		if err != nil {
 			return nil, err
 		}
-		return SetResolver(pc, cfg), nil
+		// Roles of the profile are assumed before the ones in the ProviderConfig
+		// and all with the custom endpoint, if any.
+		return UseAssumeRoleChain(pc, assumeRoleChain(SetResolver(pc, cfg), sp.AssumeRoleChain)), nil
+	}
+}
+
+// credentialsProfile returns the name of the profile to use from the
+// credentials of given ProviderConfig.
+func credentialsProfile(pc *v1beta1.ProviderConfig) string {
+	if pc.Spec.Credentials.Profile != "" {
+		return pc.Spec.Credentials.Profile
+	}
+	return DefaultSection
+}
+
+// UseAssumeRoleChain returns a configuration whose credentials are the result
+// of assuming the roles in the AssumeRoleChain of given ProviderConfig in
+// order, starting with the credentials of given configuration. The returned
+// configuration is the given one if there is no role to assume.
+func UseAssumeRoleChain(pc *v1beta1.ProviderConfig, cfg *aws.Config) *aws.Config {
+	return assumeRoleChain(cfg, pc.Spec.AssumeRoleChain)
+}
+
+func assumeRoleChain(cfg *aws.Config, chain []v1beta1.AssumeRoleOptions) *aws.Config {
+	for _, aro := range chain {
+		// Every role in the chain is assumed by an STS client that is
+		// configured with the credentials of the previous link.
+		p := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(*cfg), aro.RoleARN, assumeRoleOptions(aro))
+		next := cfg.Copy()
+		next.Credentials = aws.NewCredentialsCache(p, withExpiryWindow)
+		cfg = &next
+	}
+	return cfg
+}
+
+func assumeRoleOptions(aro v1beta1.AssumeRoleOptions) func(*stscreds.AssumeRoleOptions) {
+	return func(o *stscreds.AssumeRoleOptions) {
+		o.ExternalID = aro.ExternalID
+		if aro.SessionName != nil {
+			o.RoleSessionName = *aro.SessionName
+		}
+		if aro.Duration != nil {
+			o.Duration = aro.Duration.Duration
+		}
+		for _, t := range aro.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+		o.TransitiveTagKeys = aro.TransitiveTagKeys
 	}
 }
 
@@ -249,17 +367,31 @@ func CredentialsIDSecret(data []byte, profile string) (aws.Credentials, error) {
 // AuthMethod is a method of authenticating to the AWS API
 type AuthMethod func(context.Context, []byte, string, string) (*aws.Config, error)
 
-// UseProviderSecret - AWS configuration which can be used to issue requests against AWS API
+// UseProviderSecret - AWS configuration which can be used to issue requests against AWS API.
+// The data is in the shared configuration format and the given profile is
+// resolved as described in ResolveSharedProfile.
 func UseProviderSecret(ctx context.Context, data []byte, profile, region string) (*aws.Config, error) {
-	creds, err := CredentialsIDSecret(data, profile)
+	sp, err := ResolveSharedProfile(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
 	}
+	cfg, err := useSharedProfile(ctx, sp, region)
+	if err != nil {
+		return nil, err
+	}
+	return assumeRoleChain(cfg, sp.AssumeRoleChain), nil
+}
 
-	config, err := config.LoadDefaultConfig(ctx, config.WithRegion(region), config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-		Value: creds,
+// useSharedProfile returns a configuration with the static credentials of
+// given profile. The region of the profile is used if no region is given.
+func useSharedProfile(ctx context.Context, sp SharedProfile, region string) (*aws.Config, error) {
+	if region == "" {
+		region = sp.Region
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region), config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+		Value: sp.Credentials,
 	}))
-	return &config, err
+	return &cfg, err
 }
 
 // UsePodServiceAccount assumes an IAM role configured via a ServiceAccount.
@@ -275,12 +407,60 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 	return &cfg, err
 }
 
+// UseWebIdentity assumes the IAM role given in the WebIdentity configuration of
+// the ProviderConfig using the OIDC token it points to. This doesn't rely on
+// any environment variable injected into the pod, so it works outside of EKS
+// as long as the token issuer is registered as an OIDC provider in IAM.
+func UseWebIdentity(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	wi := pc.Spec.Credentials.WebIdentity
+	if wi == nil {
+		return nil, errors.New("webIdentity configuration is required when credentials source is WebIdentity")
+	}
+	var tr stscreds.IdentityTokenRetriever
+	switch s := wi.TokenConfig.Source; s { //nolint:exhaustive
+	case xpv1.CredentialsSourceFilesystem:
+		if wi.TokenConfig.Fs == nil {
+			return nil, errors.New("fs is required when web identity token source is Filesystem")
+		}
+		// The token file is read whenever the credentials are retrieved, so
+		// that rotated projected tokens are picked up.
+		tr = stscreds.IdentityTokenFile(wi.TokenConfig.Fs.Path)
+	default:
+		token, err := resource.CommonCredentialExtractor(ctx, s, c, xpv1.CommonCredentialSelectors{SecretRef: wi.TokenConfig.SecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get web identity token")
+		}
+		tr = identityToken(token)
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	// STS client has to use the custom endpoint, if any.
+	SetResolver(pc, &cfg)
+	p := stscreds.NewWebIdentityRoleProvider(sts.NewFromConfig(cfg), wi.RoleARN, tr, func(o *stscreds.WebIdentityRoleOptions) {
+		if wi.RoleSessionName != nil {
+			o.RoleSessionName = *wi.RoleSessionName
+		}
+	})
+	cfg.Credentials = aws.NewCredentialsCache(p, withExpiryWindow)
+	return &cfg, nil
+}
+
+// identityToken is an OIDC token that is already read into memory.
+type identityToken []byte
+
+// GetIdentityToken returns the token.
+func (t identityToken) GetIdentityToken() ([]byte, error) {
+	return t, nil
+}
+
 // NOTE(muvaf): ACK-generated controllers use aws/aws-sdk-go instead of
 // aws/aws-sdk-go-v2. These functions are implemented to be used by those controllers.
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -293,56 +473,107 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+
+	if region == "" {
+		region = pc.Spec.DefaultRegion
+	}
+	key, version, err := configCacheKey(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	if sess, ok := configs.GetSession(key, version); ok {
+		return sess, nil
+	}
+	sess, err := sessionForProviderConfig(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	configs.SetSession(key, version, sess)
+	return sess, nil
+}
+
+func sessionForProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*session.Session, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, pc, DefaultSection, region)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use pod service account")
 		}
-		return session.NewSession(cfg)
+		return UseAssumeRoleChainV1(pc, cfg)
+	case v1beta1.CredentialsSourceWebIdentity:
+		cfg, err := UseWebIdentityV1(ctx, c, pc, region)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot use web identity")
+		}
+		return UseAssumeRoleChainV1(pc, cfg)
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials")
 		}
-		cfg, err := UseProviderSecretV1(ctx, data, pc, DefaultSection, region)
+		cfg, err := UseProviderSecretV1(ctx, data, pc, credentialsProfile(pc), region)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use secret")
 		}
-		return session.NewSession(cfg)
+		return UseAssumeRoleChainV1(pc, cfg)
+	}
+}
+
+// UseAssumeRoleChainV1 returns a session whose credentials are the result of
+// assuming the roles in the AssumeRoleChain of given ProviderConfig in order,
+// starting with the credentials of given configuration.
+func UseAssumeRoleChainV1(pc *v1beta1.ProviderConfig, cfg *awsv1.Config) (*session.Session, error) {
+	cfg, err := assumeRoleChainV1(cfg, pc.Spec.AssumeRoleChain)
+	if err != nil {
+		return nil, err
+	}
+	return session.NewSession(cfg)
+}
+
+func assumeRoleChainV1(cfg *awsv1.Config, chain []v1beta1.AssumeRoleOptions) (*awsv1.Config, error) {
+	for _, aro := range chain {
+		sess, err := session.NewSession(cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot create session to assume role %s", aro.RoleARN)
+		}
+		cfg = cfg.Copy().WithCredentials(stscredsv1.NewCredentials(sess, aro.RoleARN, assumeRoleOptionsV1(aro)))
+	}
+	return cfg, nil
+}
+
+func assumeRoleOptionsV1(aro v1beta1.AssumeRoleOptions) func(*stscredsv1.AssumeRoleProvider) {
+	return func(p *stscredsv1.AssumeRoleProvider) {
+		p.ExternalID = aro.ExternalID
+		if aro.SessionName != nil {
+			p.RoleSessionName = *aro.SessionName
+		}
+		if aro.Duration != nil {
+			p.Duration = aro.Duration.Duration
+		}
+		for _, t := range aro.Tags {
+			p.Tags = append(p.Tags, &stsv1.Tag{Key: awsv1.String(t.Key), Value: awsv1.String(t.Value)})
+		}
+		p.TransitiveTagKeys = awsv1.StringSlice(aro.TransitiveTagKeys)
 	}
 }
 
-// UseProviderSecretV1 retrieves AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY from
-// the data which contains aws credentials under given profile and produces a *awsv1.Config
+// UseProviderSecretV1 resolves the given profile in the data which contains
+// aws credentials in the shared configuration format as described in
+// ResolveSharedProfile and produces a *awsv1.Config
 // Example:
 // [default]
 // aws_access_key_id = <YOUR_ACCESS_KEY_ID>
 // aws_secret_access_key = <YOUR_SECRET_ACCESS_KEY>
 func UseProviderSecretV1(_ context.Context, data []byte, pc *v1beta1.ProviderConfig, profile, region string) (*awsv1.Config, error) {
-	cfg, err := ini.InsensitiveLoad(data)
+	sp, err := ResolveSharedProfile(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
 	}
-
-	iniProfile, err := cfg.GetSection(profile)
-	if err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("cannot get %s profile in credentials secret", profile))
-	}
-
-	accessKeyID := iniProfile.Key("aws_access_key_id")
-	secretAccessKey := iniProfile.Key("aws_secret_access_key")
-	sessionToken := iniProfile.Key("aws_session_token")
-
-	// NOTE(muvaf): Key function implementation never returns nil but still its
-	// type is pointer so we check to make sure its next versions doesn't break
-	// that implicit contract.
-	if accessKeyID == nil || secretAccessKey == nil || sessionToken == nil {
-		return nil, errors.New("returned key can be empty but cannot be nil")
+	if region == "" {
+		region = sp.Region
 	}
-
-	creds := credentialsv1.NewStaticCredentials(accessKeyID.Value(), secretAccessKey.Value(), sessionToken.Value())
-	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(creds).WithRegion(region)), nil
+	creds := credentialsv1.NewStaticCredentials(sp.Credentials.AccessKeyID, sp.Credentials.SecretAccessKey, sp.Credentials.SessionToken)
+	return assumeRoleChainV1(SetResolverV1(pc, awsv1.NewConfig().WithCredentials(creds).WithRegion(region)), sp.AssumeRoleChain)
 }
 
 // UsePodServiceAccountV1 assumes an IAM role configured via a ServiceAccount.
@@ -355,17 +586,60 @@ func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderC
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	v2creds, err := cfg.Credentials.Retrieve(ctx)
+	v1creds := credentialsv1.NewCredentials(&credentialsProviderV1{provider: cfg.Credentials})
+	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
+}
+
+// UseWebIdentityV1 assumes the IAM role given in the WebIdentity configuration
+// of the ProviderConfig using the OIDC token it points to and produces an
+// *awsv1.Config.
+func UseWebIdentityV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*awsv1.Config, error) {
+	cfg, err := UseWebIdentity(ctx, c, pc, region)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to retrieve credentials")
+		return nil, err
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
+	v1creds := credentialsv1.NewCredentials(&credentialsProviderV1{provider: cfg.Credentials})
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
+// credentialsProviderV1 makes an SDK v2 credentials provider usable by SDK v1
+// clients. The credentials are retrieved again once they expire, so that
+// sessions that live longer than the temporary credentials keep working.
+type credentialsProviderV1 struct {
+	credentialsv1.Expiry
+
+	provider  aws.CredentialsProvider
+	canExpire bool
+}
+
+// Retrieve returns the credentials of the SDK v2 provider.
+func (p *credentialsProviderV1) Retrieve() (credentialsv1.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+// RetrieveWithContext returns the credentials of the SDK v2 provider.
+func (p *credentialsProviderV1) RetrieveWithContext(ctx credentialsv1.Context) (credentialsv1.Value, error) {
+	v, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		return credentialsv1.Value{}, errors.Wrap(err, "failed to retrieve credentials")
+	}
+	p.canExpire = v.CanExpire
+	if v.CanExpire {
+		p.SetExpiration(v.Expires, credentialsExpiryWindow)
+	}
+	return credentialsv1.Value{
+		AccessKeyID:     v.AccessKeyID,
+		SecretAccessKey: v.SecretAccessKey,
+		SessionToken:    v.SessionToken,
+		ProviderName:    v.Source,
+	}, nil
+}
+
+// IsExpired returns whether the credentials need to be retrieved again.
+func (p *credentialsProviderV1) IsExpired() bool {
+	return p.canExpire && p.Expiry.IsExpired()
+}
+
 // SetResolverV1 parses annotations from the managed resource
 // and returns a V1 configuration accordingly.
 func SetResolverV1(pc *v1beta1.ProviderConfig, cfg *awsv1.Config) *awsv1.Config { // nolint:gocyclo
@@ -757,36 +1031,6 @@ func DiffLabels(local, remote map[string]string) (addOrModify map[string]string,
 	return
 }
 
-// IsPolicyUpToDate Marshall policies to json for a compare to get around string ordering
-func IsPolicyUpToDate(local, remote *string) bool {
-	var localUnmarshalled interface{}
-	var remoteUnmarshalled interface{}
-
-	var err error
-	err = json.Unmarshal([]byte(*local), &localUnmarshalled)
-	if err != nil {
-		return false
-	}
-	err = json.Unmarshal([]byte(*remote), &remoteUnmarshalled)
-	if err != nil {
-		return false
-	}
-
-	sortSlicesOpt := cmpopts.SortSlices(func(x, y interface{}) bool {
-		if a, ok := x.(string); ok {
-			if b, ok := y.(string); ok {
-				return a < b
-			}
-		}
-		// Note: Unknown types in slices will not cause a panic, but
-		// may not be sorted correctly. Depending on how AWS handles
-		// these, it may cause constant updates - but better this than
-		// panicing.
-		return false
-	})
-	return cmp.Equal(localUnmarshalled, remoteUnmarshalled, cmpopts.EquateEmpty(), sortSlicesOpt)
-}
-
 // Wrap will remove the request-specific information from the error and only then
 // wrap it.
 func Wrap(err error, msg string) error {
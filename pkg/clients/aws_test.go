@@ -20,10 +20,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/document"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
@@ -35,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
@@ -474,73 +478,6 @@ func TestDiffLabels(t *testing.T) {
 	}
 }
 
-const (
-	policy = `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":"*"}],"Version":"2012-10-17"}`
-
-	cpxPolicy = `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:userARN","111122223334","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`
-	// Note: different sort order of principals than input above
-	cpxRemPolicy = `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["111122223334","arn:aws:iam::111122223333:userARN","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`
-)
-
-func TestIsPolicyUpToDate(t *testing.T) {
-	type args struct {
-		local  string
-		remote string
-	}
-
-	cases := map[string]struct {
-		args args
-		want bool
-	}{
-		"SameFields": {
-			args: args{
-				local:  "{\"testone\": \"one\", \"testtwo\": \"two\"}",
-				remote: "{\"testtwo\": \"two\", \"testone\": \"one\"}",
-			},
-			want: true,
-		},
-		"SameFieldsRealPolicy": {
-			args: args{
-				local:  policy,
-				remote: `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":"*"}],"Version":"2012-10-17"}`,
-			},
-			want: true,
-		},
-		"DifferentFields": {
-			args: args{
-				local:  "{\"testone\": \"one\", \"testtwo\": \"two\"}",
-				remote: "{\"testthree\": \"three\", \"testone\": \"one\"}",
-			},
-			want: false,
-		},
-		"SameFieldsPrincipalPolicy": {
-			args: args{
-				local:  cpxPolicy,
-				remote: cpxRemPolicy,
-			},
-			want: true,
-		},
-		"SameFieldsNumericPrincipals": {
-			args: args{
-				// This is to test that our slice sorting does not
-				// panic with unexpected value types.
-				local:  `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"foo","bar"]}],"Version":"2012-10-17"}`,
-				remote: `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"bar","foo"]}],"Version":"2012-10-17"}`,
-			},
-			want: true,
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsPolicyUpToDate(&tc.args.local, &tc.args.remote)
-			if diff := cmp.Diff(tc.want, got); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-		})
-	}
-}
-
 func TestWrap(t *testing.T) {
 	rootErr := &smithy.GenericAPIError{
 		Code:    "InvalidVpcID.NotFound",
@@ -697,6 +634,307 @@ func TestUseProviderConfigResolveEndpoint(t *testing.T) {
 	}
 }
 
+func TestUseProviderConfigDefaultRegion(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		want   string
+	}{
+		"RegionNotGiven": {
+			want: "eu-west-1",
+		},
+		"RegionGiven": {
+			region: "us-east-1",
+			want:   "us-east-1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{
+				ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}},
+			}
+			kube := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					if pc, ok := obj.(*v1beta1.ProviderConfig); ok {
+						pc.Spec = v1beta1.ProviderConfigSpec{
+							Credentials:   v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+							DefaultRegion: "eu-west-1",
+						}
+					}
+					return nil
+				}),
+			}
+			cfg, err := UseProviderConfig(context.TODO(), kube, mg, tc.region)
+			if err != nil {
+				t.Fatalf("UseProviderConfig(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, cfg.Region); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDefaultTags(t *testing.T) {
+	type want struct {
+		tags map[string]string
+		err  error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		kube client.Client
+		want want
+	}{
+		"NoProviderConfigReference": {
+			mg:   &fake.Managed{},
+			want: want{},
+		},
+		"DefaultTags": {
+			mg: &fake.Managed{
+				ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}},
+			},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"cost-center": "1234"}
+					return nil
+				}),
+			},
+			want: want{
+				tags: map[string]string{"cost-center": "1234"},
+			},
+		},
+		"CannotGetProviderConfig": {
+			mg: &fake.Managed{
+				ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}},
+			},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+			want: want{
+				err: errors.Wrap(errors.New(errBoom), "cannot get referenced ProviderConfig"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tags, err := GetDefaultTags(context.TODO(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, tags); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetRegion(t *testing.T) {
+	type args struct {
+		mg     resource.Managed
+		kube   client.Client
+		region string
+	}
+	type want struct {
+		region string
+		err    error
+	}
+
+	pcRef := fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"RegionGiven": {
+			args: args{
+				mg:     &fake.Managed{ProviderConfigReferencer: pcRef},
+				region: "eu-west-1",
+			},
+			want: want{region: "eu-west-1"},
+		},
+		"NoProviderConfigReference": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{},
+		},
+		"DefaultRegion": {
+			args: args{
+				mg: &fake.Managed{ProviderConfigReferencer: pcRef},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*v1beta1.ProviderConfig).Spec.DefaultRegion = "us-east-1"
+						return nil
+					}),
+				},
+			},
+			want: want{region: "us-east-1"},
+		},
+		"CannotGetProviderConfig": {
+			args: args{
+				mg:   &fake.Managed{ProviderConfigReferencer: pcRef},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errBoom), "cannot get referenced ProviderConfig"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			region, err := GetRegion(context.TODO(), tc.args.kube, tc.args.mg, tc.args.region)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.region, region); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleOptions(t *testing.T) {
+	cases := map[string]struct {
+		aro  v1beta1.AssumeRoleOptions
+		want stscreds.AssumeRoleOptions
+	}{
+		"OnlyRoleARN": {
+			aro:  v1beta1.AssumeRoleOptions{RoleARN: "arn:aws:iam::123456789012:role/foo"},
+			want: stscreds.AssumeRoleOptions{},
+		},
+		"AllOptions": {
+			aro: v1beta1.AssumeRoleOptions{
+				RoleARN:           "arn:aws:iam::123456789012:role/foo",
+				ExternalID:        aws.String("ext"),
+				SessionName:       aws.String("session"),
+				Duration:          &v1.Duration{Duration: time.Hour},
+				Tags:              []v1beta1.Tag{{Key: "k", Value: "v"}},
+				TransitiveTagKeys: []string{"k"},
+			},
+			want: stscreds.AssumeRoleOptions{
+				ExternalID:        aws.String("ext"),
+				RoleSessionName:   "session",
+				Duration:          time.Hour,
+				Tags:              []ststypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				TransitiveTagKeys: []string{"k"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := stscreds.AssumeRoleOptions{}
+			assumeRoleOptions(tc.aro)(&got)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ststypes.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUseAssumeRoleChain(t *testing.T) {
+	cfg := &aws.Config{Region: "us-east-1", Credentials: aws.AnonymousCredentials{}}
+
+	got := UseAssumeRoleChain(&v1beta1.ProviderConfig{}, cfg)
+	if got != cfg {
+		t.Errorf("expected the same config to be returned when there is no role to assume")
+	}
+
+	got = UseAssumeRoleChain(&v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+		AssumeRoleChain: []v1beta1.AssumeRoleOptions{
+			{RoleARN: "arn:aws:iam::123456789012:role/foo"},
+			{RoleARN: "arn:aws:iam::210987654321:role/bar"},
+		},
+	}}, cfg)
+	if _, ok := got.Credentials.(*aws.CredentialsCache); !ok {
+		t.Errorf("expected credentials of the last role in the chain to be cached, got %T", got.Credentials)
+	}
+	if _, ok := cfg.Credentials.(aws.AnonymousCredentials); !ok {
+		t.Errorf("expected the given config not to be mutated")
+	}
+}
+
+func TestUseWebIdentity(t *testing.T) {
+	roleARN := "arn:aws:iam::123456789012:role/foo"
+	secretRef := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "token", Namespace: "crossplane-system"},
+		Key:             "token",
+	}
+
+	type args struct {
+		kube client.Client
+		wi   *v1beta1.WebIdentityConfig
+	}
+
+	cases := map[string]struct {
+		args args
+		err  error
+	}{
+		"NoWebIdentityConfig": {
+			args: args{},
+			err:  errors.New("webIdentity configuration is required when credentials source is WebIdentity"),
+		},
+		"NoFsSelector": {
+			args: args{
+				wi: &v1beta1.WebIdentityConfig{
+					RoleARN:     roleARN,
+					TokenConfig: v1beta1.WebIdentityTokenConfig{Source: xpv1.CredentialsSourceFilesystem},
+				},
+			},
+			err: errors.New("fs is required when web identity token source is Filesystem"),
+		},
+		"CannotGetTokenSecret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+				wi: &v1beta1.WebIdentityConfig{
+					RoleARN:     roleARN,
+					TokenConfig: v1beta1.WebIdentityTokenConfig{Source: xpv1.CredentialsSourceSecret, SecretRef: secretRef},
+				},
+			},
+			err: errors.Wrap(errors.Wrap(errors.New(errBoom), "cannot get credentials secret"), "cannot get web identity token"),
+		},
+		"TokenFromSecret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				wi: &v1beta1.WebIdentityConfig{
+					RoleARN:     roleARN,
+					TokenConfig: v1beta1.WebIdentityTokenConfig{Source: xpv1.CredentialsSourceSecret, SecretRef: secretRef},
+				},
+			},
+		},
+		"TokenFromFilesystem": {
+			args: args{
+				wi: &v1beta1.WebIdentityConfig{
+					RoleARN: roleARN,
+					TokenConfig: v1beta1.WebIdentityTokenConfig{
+						Source: xpv1.CredentialsSourceFilesystem,
+						Fs:     &xpv1.FsSelector{Path: "/var/run/secrets/token"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: v1beta1.CredentialsSourceWebIdentity, WebIdentity: tc.args.wi},
+			}}
+			cfg, err := UseWebIdentity(context.TODO(), tc.args.kube, pc, "us-east-1")
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if _, ok := cfg.Credentials.(*aws.CredentialsCache); !ok {
+				t.Errorf("expected web identity credentials to be cached, got %T", cfg.Credentials)
+			}
+		})
+	}
+}
+
 func TestDiffTagsMapPtr(t *testing.T) {
 	type args struct {
 		cr  map[string]*string
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// configs is the cache of configurations shared by all controllers.
+var configs = newConfigCache()
+
+type cachedConfig struct {
+	version string
+	config  *aws.Config
+}
+
+type cachedSession struct {
+	version string
+	session *session.Session
+}
+
+// configCache stores the AWS configurations built from ProviderConfigs so
+// that credentials don't have to be extracted and parsed again, and STS
+// tokens don't have to be requested again, in every reconcile. There is a
+// single entry for every ProviderConfig and region pair, and the entry is
+// replaced once the ProviderConfig or the secret it refers to changes. The
+// entries of a ProviderConfig are evicted once it's deleted.
+type configCache struct {
+	mu       sync.RWMutex
+	configs  map[string]cachedConfig
+	sessions map[string]cachedSession
+}
+
+func newConfigCache() *configCache {
+	return &configCache{
+		configs:  map[string]cachedConfig{},
+		sessions: map[string]cachedSession{},
+	}
+}
+
+// GetConfig returns a copy of the configuration stored with given key if its
+// version matches.
+func (c *configCache) GetConfig(key, version string) (*aws.Config, bool) {
+	if key == "" {
+		return nil, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.configs[key]
+	if !ok || e.version != version {
+		return nil, false
+	}
+	return copyConfig(e.config), true
+}
+
+// SetConfig stores a copy of given configuration with given key and version,
+// replacing the configuration of any other version.
+func (c *configCache) SetConfig(key, version string, cfg *aws.Config) {
+	if key == "" {
+		return
+	}
+	cp := copyConfig(cfg)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configs[key] = cachedConfig{version: version, config: cp}
+}
+
+// GetSession returns the session stored with given key if its version
+// matches.
+func (c *configCache) GetSession(key, version string) (*session.Session, bool) {
+	if key == "" {
+		return nil, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.sessions[key]
+	if !ok || e.version != version {
+		return nil, false
+	}
+	return e.session, true
+}
+
+// SetSession stores given session with given key and version, replacing the
+// session of any other version.
+func (c *configCache) SetSession(key, version string, s *session.Session) {
+	if key == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions[key] = cachedSession{version: version, session: s}
+}
+
+// Evict removes the configurations and sessions of the ProviderConfig with
+// given name, except the ones that belong to the ProviderConfig with given
+// UID. An empty UID evicts all entries of the name.
+func (c *configCache) Evict(name string, uid types.UID) {
+	prefix, keep := name+"/", name+"/"+string(uid)+"/"
+	evict := func(k string) bool {
+		return strings.HasPrefix(k, prefix) && (uid == "" || !strings.HasPrefix(k, keep))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.configs {
+		if evict(k) {
+			delete(c.configs, k)
+		}
+	}
+	for k := range c.sessions {
+		if evict(k) {
+			delete(c.sessions, k)
+		}
+	}
+}
+
+// EvictProviderConfig removes the cached configurations of the ProviderConfig
+// with given name that don't belong to given UID, i.e. the ones of a
+// ProviderConfig that is deleted or replaced by another one with the same
+// name. An empty UID evicts all of them.
+func EvictProviderConfig(name string, uid types.UID) {
+	configs.Evict(name, uid)
+}
+
+// copyConfig returns a copy of given configuration that doesn't share the
+// API options with it. aws.Config.Copy copies only the slice header.
+func copyConfig(cfg *aws.Config) *aws.Config {
+	cp := cfg.Copy()
+	if cfg.APIOptions != nil {
+		cp.APIOptions = make([]func(*middleware.Stack) error, len(cfg.APIOptions))
+		copy(cp.APIOptions, cfg.APIOptions)
+	}
+	return &cp
+}
+
+// configCacheKey returns the key and the version of the configuration that is
+// built from given ProviderConfig for given region. The version consists of
+// the generation of the ProviderConfig and the UID and resource version of the
+// secret it refers to, so that a change in any of them results in a cache
+// miss. The generation is used instead of the resource version of the
+// ProviderConfig since the latter changes with every status write, e.g. when
+// its usages are tracked. An empty key is
+// returned for ProviderConfigs that are not read from the API server since
+// there is no way to tell whether they changed.
+func configCacheKey(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (string, string, error) {
+	if pc.GetUID() == "" || pc.GetGeneration() == 0 {
+		return "", "", nil
+	}
+	v := []string{strconv.FormatInt(pc.GetGeneration(), 10)}
+	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case xpv1.CredentialsSourceSecret:
+		sv, err := secretVersion(ctx, c, pc.Spec.Credentials.SecretRef)
+		if err != nil {
+			return "", "", err
+		}
+		v = append(v, sv)
+	case xpv1.CredentialsSourceFilesystem:
+		if pc.Spec.Credentials.Fs == nil {
+			break
+		}
+		// Mounted secrets are updated by kubelet by replacing the file, so
+		// the modification time tells whether the content has changed.
+		fi, err := os.Stat(pc.Spec.Credentials.Fs.Path)
+		if err != nil {
+			return "", "", errors.Wrap(err, "cannot stat credentials file")
+		}
+		v = append(v, fi.ModTime().String())
+	case v1beta1.CredentialsSourceWebIdentity:
+		// Tokens read from the filesystem are read again by the credentials
+		// provider whenever it needs to, so only secrets need tracking.
+		wi := pc.Spec.Credentials.WebIdentity
+		if wi == nil || wi.TokenConfig.Source != xpv1.CredentialsSourceSecret {
+			break
+		}
+		sv, err := secretVersion(ctx, c, wi.TokenConfig.SecretRef)
+		if err != nil {
+			return "", "", err
+		}
+		v = append(v, sv)
+	}
+	return pc.GetName() + "/" + string(pc.GetUID()) + "/" + region, strings.Join(v, "/"), nil
+}
+
+func secretVersion(ctx context.Context, c client.Client, ref *xpv1.SecretKeySelector) (string, error) {
+	// Configuration builder will report the missing reference.
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, "cannot get credentials secret")
+	}
+	return string(s.GetUID()) + "/" + s.GetResourceVersion(), nil
+}
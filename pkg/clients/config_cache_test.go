@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestConfigCacheKey(t *testing.T) {
+	secretRef := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"},
+		Key:             "credentials",
+	}
+	pcMeta := metav1.ObjectMeta{Name: "pc", UID: "pc-uid", Generation: 1, ResourceVersion: "12"}
+
+	type args struct {
+		kube   client.Client
+		pc     *v1beta1.ProviderConfig
+		region string
+	}
+	type want struct {
+		key     string
+		version string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFromAPIServer": {
+			args: args{
+				pc:     &v1beta1.ProviderConfig{},
+				region: "us-east-1",
+			},
+			want: want{},
+		},
+		"NoGeneration": {
+			args: args{
+				pc: &v1beta1.ProviderConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "pc", UID: "pc-uid", ResourceVersion: "12"},
+				},
+				region: "us-east-1",
+			},
+			want: want{},
+		},
+		"InjectedIdentity": {
+			args: args{
+				pc: &v1beta1.ProviderConfig{
+					ObjectMeta: pcMeta,
+					Spec: v1beta1.ProviderConfigSpec{
+						Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+					},
+				},
+				region: "us-east-1",
+			},
+			want: want{
+				key:     "pc/pc-uid/us-east-1",
+				version: "1",
+			},
+		},
+		"Secret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).SetUID("secret-uid")
+					obj.(*corev1.Secret).SetResourceVersion("5")
+					return nil
+				})},
+				pc: &v1beta1.ProviderConfig{
+					ObjectMeta: pcMeta,
+					Spec: v1beta1.ProviderConfigSpec{
+						Credentials: v1beta1.ProviderCredentials{
+							Source:                    xpv1.CredentialsSourceSecret,
+							CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef},
+						},
+					},
+				},
+				region: "eu-west-1",
+			},
+			want: want{
+				key:     "pc/pc-uid/eu-west-1",
+				version: "1/secret-uid/5",
+			},
+		},
+		"WebIdentityTokenSecret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).SetUID("secret-uid")
+					obj.(*corev1.Secret).SetResourceVersion("7")
+					return nil
+				})},
+				pc: &v1beta1.ProviderConfig{
+					ObjectMeta: pcMeta,
+					Spec: v1beta1.ProviderConfigSpec{
+						Credentials: v1beta1.ProviderCredentials{
+							Source: v1beta1.CredentialsSourceWebIdentity,
+							WebIdentity: &v1beta1.WebIdentityConfig{
+								TokenConfig: v1beta1.WebIdentityTokenConfig{Source: xpv1.CredentialsSourceSecret, SecretRef: secretRef},
+							},
+						},
+					},
+				},
+				region: "eu-west-1",
+			},
+			want: want{
+				key:     "pc/pc-uid/eu-west-1",
+				version: "1/secret-uid/7",
+			},
+		},
+		"CannotGetSecret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+				pc: &v1beta1.ProviderConfig{
+					ObjectMeta: pcMeta,
+					Spec: v1beta1.ProviderConfigSpec{
+						Credentials: v1beta1.ProviderCredentials{
+							Source:                    xpv1.CredentialsSourceSecret,
+							CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef},
+						},
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errBoom), "cannot get credentials secret"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			key, version, err := configCacheKey(context.TODO(), tc.args.kube, tc.args.pc, tc.args.region)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, key); diff != "" {
+				t.Errorf("key: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, version); diff != "" {
+				t.Errorf("version: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigCache(t *testing.T) {
+	c := newConfigCache()
+	c.SetConfig("pc/pc-uid/us-east-1", "1", &aws.Config{Region: "us-east-1"})
+
+	if _, ok := c.GetConfig("pc/pc-uid/us-east-1", "2"); ok {
+		t.Errorf("expected a miss for a different version")
+	}
+	if _, ok := c.GetConfig("pc/pc-uid/eu-west-1", "1"); ok {
+		t.Errorf("expected a miss for a different key")
+	}
+	cfg, ok := c.GetConfig("pc/pc-uid/us-east-1", "1")
+	if !ok {
+		t.Fatalf("expected a hit for the same key and version")
+	}
+	cfg.Region = "mutated"
+	if cfg, _ := c.GetConfig("pc/pc-uid/us-east-1", "1"); cfg.Region != "us-east-1" {
+		t.Errorf("expected the cached config not to be mutated through the returned copy")
+	}
+
+	c.SetConfig("pc/pc-uid/us-east-1", "2", &aws.Config{Region: "us-east-1"})
+	if _, ok := c.GetConfig("pc/pc-uid/us-east-1", "1"); ok {
+		t.Errorf("expected the old version to be replaced")
+	}
+
+	c.SetConfig("", "", &aws.Config{})
+	if _, ok := c.GetConfig("", ""); ok {
+		t.Errorf("expected configs with empty keys not to be cached")
+	}
+
+	c.SetConfig("pc/pc-uid/us-east-1", "1", &aws.Config{APIOptions: []func(*middleware.Stack) error{nopAPIOption}})
+	cfg, _ = c.GetConfig("pc/pc-uid/us-east-1", "1")
+	cfg.APIOptions[0] = nil
+	if cfg, _ := c.GetConfig("pc/pc-uid/us-east-1", "1"); cfg.APIOptions[0] == nil {
+		t.Errorf("expected the cached API options not to be mutated through the returned copy")
+	}
+}
+
+func nopAPIOption(*middleware.Stack) error { return nil }
+
+func TestConfigCacheEvict(t *testing.T) {
+	keys := []string{"pc/old-uid/us-east-1", "pc/new-uid/us-east-1", "pc/new-uid/eu-west-1", "pc2/uid/us-east-1", "pcx/uid/us-east-1"}
+
+	type args struct {
+		name string
+		uid  types.UID
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"Deleted": {
+			args: args{name: "pc"},
+			want: []string{"pc2/uid/us-east-1", "pcx/uid/us-east-1"},
+		},
+		"Replaced": {
+			args: args{name: "pc", uid: "new-uid"},
+			want: []string{"pc/new-uid/us-east-1", "pc/new-uid/eu-west-1", "pc2/uid/us-east-1", "pcx/uid/us-east-1"},
+		},
+		"Unknown": {
+			args: args{name: "other"},
+			want: keys,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newConfigCache()
+			for _, k := range keys {
+				c.SetConfig(k, "1", &aws.Config{})
+				c.SetSession(k, "1", &session.Session{})
+			}
+			c.Evict(tc.args.name, tc.args.uid)
+			var gotConfigs, gotSessions []string
+			for _, k := range keys {
+				if _, ok := c.GetConfig(k, "1"); ok {
+					gotConfigs = append(gotConfigs, k)
+				}
+				if _, ok := c.GetSession(k, "1"); ok {
+					gotSessions = append(gotSessions, k)
+				}
+			}
+			if diff := cmp.Diff(tc.want, gotConfigs); diff != "" {
+				t.Errorf("configs: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, gotSessions); diff != "" {
+				t.Errorf("sessions: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
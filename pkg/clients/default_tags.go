@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errUpdateDefaultTags = "cannot update managed resource with the default tags"
+
+// tagPaths are the paths of the tag fields under spec.forProvider that
+// default tags are added to. The first path that exists in the managed
+// resource is used.
+var tagPaths = [][]string{
+	{"Tags"},
+	{"BucketTagging", "TagSet"},
+}
+
+// tagSpecificationTypes are the resource types of the tag specifications that
+// default tags are added to for the managed resources that are tagged through
+// tag specifications, keyed by their kinds.
+var tagSpecificationTypes = map[string]string{
+	"LaunchTemplate":       "launch-template",
+	"VPCPeeringConnection": "vpc-peering-connection",
+}
+
+// NewDefaultTagger returns an initializer that adds the default tags of the
+// ProviderConfig to the tags of the managed resource.
+func NewDefaultTagger(kube client.Client) *DefaultTagger {
+	return &DefaultTagger{kube: kube}
+}
+
+// DefaultTagger adds the default tags of the ProviderConfig of a managed
+// resource to its tags. A tag given in the managed resource takes precedence
+// over a default tag with the same key.
+type DefaultTagger struct {
+	kube client.Client
+}
+
+// Initialize adds the default tags to the tags of given managed resource and
+// updates it if any of them is missing.
+func (t *DefaultTagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	defaultTags, err := GetDefaultTags(ctx, t.kube, mg)
+	if err != nil {
+		return err
+	}
+	if !AddDefaultTags(mg, defaultTags) {
+		return nil
+	}
+	return errors.Wrap(t.kube.Update(ctx, mg), errUpdateDefaultTags)
+}
+
+// AddDefaultTags adds given default tags to the tags of given managed resource
+// unless it has a tag with the same key, and returns whether any tag is added.
+// The tags can either be a map of strings or a list of key-value pairs, whose
+// fields are named Key and Value, or TagKey and TagValue. The managed
+// resources that are tagged through tag specifications get the default tags in
+// the tag specification of their own resource type. Managed resources without
+// such a tag field are left as is.
+func AddDefaultTags(mg resource.Managed, defaultTags map[string]string) bool {
+	if len(defaultTags) == 0 {
+		return false
+	}
+	tags, ok := tagField(mg)
+	if !ok {
+		return false
+	}
+	keys := make([]string, 0, len(defaultTags))
+	for k := range defaultTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	switch tags.Kind() { // nolint:exhaustive
+	case reflect.Map:
+		return addDefaultTagsToMap(tags, keys, defaultTags)
+	case reflect.Slice:
+		return addDefaultTagsToSlice(tags, keys, defaultTags)
+	}
+	return false
+}
+
+// tagField returns the settable tag field of given managed resource. The
+// structs on the path of the tag field are allocated if they are nil.
+func tagField(mg resource.Managed) (reflect.Value, bool) {
+	v := reflect.ValueOf(mg)
+	for _, name := range []string{"Spec", "ForProvider"} {
+		if v = fieldByName(v, name); !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	for _, path := range tagPaths {
+		if !hasPath(v.Type(), path) {
+			continue
+		}
+		f := v
+		for _, name := range path {
+			f = fieldByName(f, name)
+		}
+		return f, f.CanSet()
+	}
+	rt, ok := tagSpecificationTypes[reflect.Indirect(reflect.ValueOf(mg)).Type().Name()]
+	if !ok || !hasPath(v.Type(), []string{"TagSpecifications"}) {
+		return reflect.Value{}, false
+	}
+	return tagSpecificationTags(v.FieldByName("TagSpecifications"), rt)
+}
+
+// tagSpecificationTags returns the tags of the tag specification with given
+// resource type in given list of tag specifications. A tag specification is
+// appended to the list if there is none with the resource type.
+func tagSpecificationTags(specs reflect.Value, resourceType string) (reflect.Value, bool) {
+	if specs.Kind() != reflect.Slice || specs.Type().Elem().Kind() != reflect.Ptr {
+		return reflect.Value{}, false
+	}
+	for i := 0; i < specs.Len(); i++ {
+		spec := specs.Index(i)
+		if spec.IsNil() {
+			continue
+		}
+		t := spec.Elem().FieldByName("ResourceType")
+		if t.Kind() == reflect.Ptr && !t.IsNil() && t.Elem().String() == resourceType {
+			return spec.Elem().FieldByName("Tags"), true
+		}
+	}
+	spec := reflect.New(specs.Type().Elem().Elem())
+	t, ok := stringValue(spec.Elem().FieldByName("ResourceType").Type(), resourceType)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	spec.Elem().FieldByName("ResourceType").Set(t)
+	specs.Set(reflect.Append(specs, spec))
+	return spec.Elem().FieldByName("Tags"), true
+}
+
+// hasPath returns whether given struct type has the fields on given path.
+func hasPath(t reflect.Type, path []string) bool {
+	for _, name := range path {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+		f, ok := t.FieldByName(name)
+		if !ok {
+			return false
+		}
+		t = f.Type
+	}
+	return true
+}
+
+// fieldByName returns the field of given struct, or the struct given pointer
+// points to, allocating the struct if the pointer is nil.
+func fieldByName(v reflect.Value, name string) reflect.Value {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			if !v.CanSet() {
+				return reflect.Value{}
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v.FieldByName(name)
+}
+
+func addDefaultTagsToMap(tags reflect.Value, keys []string, defaultTags map[string]string) bool {
+	if tags.Type().Key().Kind() != reflect.String {
+		return false
+	}
+	added := false
+	for _, k := range keys {
+		key := reflect.ValueOf(k).Convert(tags.Type().Key())
+		if tags.Len() != 0 && tags.MapIndex(key).IsValid() {
+			continue
+		}
+		val, ok := stringValue(tags.Type().Elem(), defaultTags[k])
+		if !ok {
+			return false
+		}
+		if tags.IsNil() {
+			tags.Set(reflect.MakeMap(tags.Type()))
+		}
+		tags.SetMapIndex(key, val)
+		added = true
+	}
+	return added
+}
+
+func addDefaultTagsToSlice(tags reflect.Value, keys []string, defaultTags map[string]string) bool {
+	et := tags.Type().Elem()
+	st := et
+	if st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if st.Kind() != reflect.Struct {
+		return false
+	}
+	keyField, valueField, ok := tagFieldNames(st)
+	if !ok {
+		return false
+	}
+	existing := map[string]bool{}
+	for i := 0; i < tags.Len(); i++ {
+		e := tags.Index(i)
+		if e.Kind() == reflect.Ptr {
+			if e.IsNil() {
+				continue
+			}
+			e = e.Elem()
+		}
+		k := e.FieldByName(keyField)
+		if k.Kind() == reflect.Ptr {
+			if k.IsNil() {
+				continue
+			}
+			k = k.Elem()
+		}
+		existing[k.String()] = true
+	}
+	added := false
+	for _, k := range keys {
+		if existing[k] {
+			continue
+		}
+		e := reflect.New(st)
+		kv, ok := stringValue(e.Elem().FieldByName(keyField).Type(), k)
+		if !ok {
+			return false
+		}
+		vv, ok := stringValue(e.Elem().FieldByName(valueField).Type(), defaultTags[k])
+		if !ok {
+			return false
+		}
+		e.Elem().FieldByName(keyField).Set(kv)
+		e.Elem().FieldByName(valueField).Set(vv)
+		if et.Kind() != reflect.Ptr {
+			e = e.Elem()
+		}
+		tags.Set(reflect.Append(tags, e))
+		added = true
+	}
+	return added
+}
+
+// tagFieldNames returns the names of the key and value fields of given tag
+// struct type.
+func tagFieldNames(t reflect.Type) (string, string, bool) {
+	for _, names := range [][2]string{{"Key", "Value"}, {"TagKey", "TagValue"}} {
+		_, hasKey := t.FieldByName(names[0])
+		_, hasValue := t.FieldByName(names[1])
+		if hasKey && hasValue {
+			return names[0], names[1], true
+		}
+	}
+	return "", "", false
+}
+
+// stringValue returns given string as a value of given type, which can be a
+// string or a pointer to a string.
+func stringValue(t reflect.Type, s string) (reflect.Value, bool) {
+	switch {
+	case t.Kind() == reflect.String:
+		return reflect.ValueOf(s).Convert(t), true
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.String:
+		v := reflect.New(t.Elem())
+		v.Elem().Set(reflect.ValueOf(s).Convert(t.Elem()))
+		return v, true
+	}
+	return reflect.Value{}, false
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	apigatewayv2 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	docdb "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	eks "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	route53 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	s3 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestAddDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "platform", "cost-center": "1234"}
+
+	type want struct {
+		mg    resource.Managed
+		added bool
+	}
+
+	cases := map[string]struct {
+		mg          resource.Managed
+		defaultTags map[string]string
+		want        want
+	}{
+		"NoDefaultTags": {
+			mg: &eks.Cluster{},
+			want: want{
+				mg: &eks.Cluster{},
+			},
+		},
+		"StringMap": {
+			mg: &eks.Cluster{Spec: eks.ClusterSpec{ForProvider: eks.ClusterParameters{
+				Tags: map[string]string{"team": "mine"},
+			}}},
+			defaultTags: defaultTags,
+			want: want{
+				mg: &eks.Cluster{Spec: eks.ClusterSpec{ForProvider: eks.ClusterParameters{
+					Tags: map[string]string{"team": "mine", "cost-center": "1234"},
+				}}},
+				added: true,
+			},
+		},
+		"NilStringPointerMap": {
+			mg:          &apigatewayv2.API{},
+			defaultTags: defaultTags,
+			want: want{
+				mg: &apigatewayv2.API{Spec: apigatewayv2.APISpec{ForProvider: apigatewayv2.APIParameters{
+					Tags: map[string]*string{"team": String("platform"), "cost-center": String("1234")},
+				}}},
+				added: true,
+			},
+		},
+		"StringList": {
+			mg: &ec2.VPC{Spec: ec2.VPCSpec{ForProvider: ec2.VPCParameters{
+				Tags: []ec2.Tag{{Key: "team", Value: "mine"}},
+			}}},
+			defaultTags: defaultTags,
+			want: want{
+				mg: &ec2.VPC{Spec: ec2.VPCSpec{ForProvider: ec2.VPCParameters{
+					Tags: []ec2.Tag{{Key: "team", Value: "mine"}, {Key: "cost-center", Value: "1234"}},
+				}}},
+				added: true,
+			},
+		},
+		"StringPointerList": {
+			mg: &docdb.DBCluster{Spec: docdb.DBClusterSpec{ForProvider: docdb.DBClusterParameters{
+				Tags: []*docdb.Tag{{Key: String("team"), Value: String("mine")}},
+			}}},
+			defaultTags: defaultTags,
+			want: want{
+				mg: &docdb.DBCluster{Spec: docdb.DBClusterSpec{ForProvider: docdb.DBClusterParameters{
+					Tags: []*docdb.Tag{{Key: String("team"), Value: String("mine")}, {Key: String("cost-center"), Value: String("1234")}},
+				}}},
+				added: true,
+			},
+		},
+		"TagKeyList": {
+			mg:          &kms.Key{},
+			defaultTags: map[string]string{"team": "platform"},
+			want: want{
+				mg: &kms.Key{Spec: kms.KeySpec{ForProvider: kms.KeyParameters{
+					Tags: []*kms.Tag{{TagKey: String("team"), TagValue: String("platform")}},
+				}}},
+				added: true,
+			},
+		},
+		"BucketTagging": {
+			mg:          &s3.Bucket{},
+			defaultTags: map[string]string{"team": "platform"},
+			want: want{
+				mg: &s3.Bucket{Spec: s3.BucketSpec{ForProvider: s3.BucketParameters{
+					BucketTagging: &s3.Tagging{TagSet: []s3.Tag{{Key: "team", Value: "platform"}}},
+				}}},
+				added: true,
+			},
+		},
+		"TagSpecification": {
+			mg: &ec2v1alpha1.VPCPeeringConnection{Spec: ec2v1alpha1.VPCPeeringConnectionSpec{ForProvider: ec2v1alpha1.VPCPeeringConnectionParameters{
+				TagSpecifications: []*ec2v1alpha1.TagSpecification{{
+					ResourceType: String("vpc-peering-connection"),
+					Tags:         []*ec2v1alpha1.Tag{{Key: String("team"), Value: String("mine")}},
+				}},
+			}}},
+			defaultTags: defaultTags,
+			want: want{
+				mg: &ec2v1alpha1.VPCPeeringConnection{Spec: ec2v1alpha1.VPCPeeringConnectionSpec{ForProvider: ec2v1alpha1.VPCPeeringConnectionParameters{
+					TagSpecifications: []*ec2v1alpha1.TagSpecification{{
+						ResourceType: String("vpc-peering-connection"),
+						Tags:         []*ec2v1alpha1.Tag{{Key: String("team"), Value: String("mine")}, {Key: String("cost-center"), Value: String("1234")}},
+					}},
+				}}},
+				added: true,
+			},
+		},
+		"NoTagSpecification": {
+			mg:          &ec2v1alpha1.LaunchTemplate{},
+			defaultTags: map[string]string{"team": "platform"},
+			want: want{
+				mg: &ec2v1alpha1.LaunchTemplate{Spec: ec2v1alpha1.LaunchTemplateSpec{ForProvider: ec2v1alpha1.LaunchTemplateParameters{
+					TagSpecifications: []*ec2v1alpha1.TagSpecification{{
+						ResourceType: String("launch-template"),
+						Tags:         []*ec2v1alpha1.Tag{{Key: String("team"), Value: String("platform")}},
+					}},
+				}}},
+				added: true,
+			},
+		},
+		"AllTagsGiven": {
+			mg: &eks.Cluster{Spec: eks.ClusterSpec{ForProvider: eks.ClusterParameters{
+				Tags: map[string]string{"team": "mine", "cost-center": "5678"},
+			}}},
+			defaultTags: defaultTags,
+			want: want{
+				mg: &eks.Cluster{Spec: eks.ClusterSpec{ForProvider: eks.ClusterParameters{
+					Tags: map[string]string{"team": "mine", "cost-center": "5678"},
+				}}},
+			},
+		},
+		"NotTaggable": {
+			mg:          &route53.HostedZone{},
+			defaultTags: defaultTags,
+			want: want{
+				mg: &route53.HostedZone{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			added := AddDefaultTags(tc.mg, tc.defaultTags)
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultTaggerInitialize(t *testing.T) {
+	pcRef := &xpv1.Reference{Name: "default"}
+	withDefaultTags := func(obj client.Object) error {
+		obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"team": "platform"}
+		return nil
+	}
+	cluster := func(tags map[string]string) *eks.Cluster {
+		cr := &eks.Cluster{Spec: eks.ClusterSpec{ForProvider: eks.ClusterParameters{Tags: tags}}}
+		cr.SetProviderConfigReference(pcRef)
+		return cr
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		kube client.Client
+		want want
+	}{
+		"DefaultTagsAdded": {
+			mg: cluster(map[string]string{"foo": "bar"}),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withDefaultTags),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			want: want{
+				mg: cluster(map[string]string{"foo": "bar", "team": "platform"}),
+			},
+		},
+		"NoUpdateNeeded": {
+			mg: cluster(map[string]string{"team": "mine"}),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withDefaultTags),
+				MockUpdate: test.NewMockUpdateFn(errors.New(errBoom)),
+			},
+			want: want{
+				mg: cluster(map[string]string{"team": "mine"}),
+			},
+		},
+		"CannotGetProviderConfig": {
+			mg:   cluster(nil),
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+			want: want{
+				mg:  cluster(nil),
+				err: errors.Wrap(errors.New(errBoom), "cannot get referenced ProviderConfig"),
+			},
+		},
+		"CannotUpdate": {
+			mg: cluster(nil),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withDefaultTags),
+				MockUpdate: test.NewMockUpdateFn(errors.New(errBoom)),
+			},
+			want: want{
+				mg:  cluster(map[string]string{"team": "platform"}),
+				err: errors.Wrap(errors.New(errBoom), errUpdateDefaultTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewDefaultTagger(tc.kube).Initialize(context.TODO(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
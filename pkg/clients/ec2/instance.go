@@ -15,9 +15,11 @@ package ec2
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -33,6 +35,10 @@ import (
 const (
 	// InstanceNotFound is the code that is returned by ec2 when the given InstanceID is not valid
 	InstanceNotFound = "InvalidInstanceID.NotFound"
+
+	errDecodeUserData = "cannot decode base64 encoded user data"
+
+	systemTagPrefix = "aws:"
 )
 
 // InstanceClient is the external client used for Instance Custom Resource
@@ -43,6 +49,9 @@ type InstanceClient interface {
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(context.Context, *ec2.DeleteTagsInput, ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 }
 
 // NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
@@ -59,27 +68,159 @@ func IsInstanceNotFoundErr(err error) bool {
 // IsInstanceUpToDate returns true if there is no update-able difference between desired
 // and observed state of the resource.
 func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.Instance, attributes ec2.DescribeInstanceAttributeOutput) bool {
-	// DisableApiTermination
-	if awsclients.BoolValue(spec.DisableAPITermination) != attributeBoolValue(attributes.DisableApiTermination) {
+	if mods, err := GenerateModifyInstanceAttributeInputs("", spec, instance, attributes); err != nil || len(mods) != 0 {
 		return false
 	}
-	// InstanceInitiatedShutdownBehavior
-	if spec.InstanceInitiatedShutdownBehavior != attributeValue(attributes.InstanceInitiatedShutdownBehavior) {
+	if add, remove := DiffInstanceTags(spec.Tags, instance.Tags); len(add) != 0 || len(remove) != 0 {
 		return false
 	}
-	// KernalID
-	if awsclients.StringValue(spec.KernelID) != awsclients.StringValue(instance.KernelId) {
-		return false
+	return instance.State == nil || IsInstanceStateUpToDate(spec, instance.State.Name)
+}
+
+// DiffInstanceTags returns the tags that should be added to and removed from
+// the instance. Tags whose keys start with aws: are reserved for AWS, cannot
+// be modified and are ignored.
+func DiffInstanceTags(spec []manualv1alpha1.Tag, observed []types.Tag) (add, remove []types.Tag) {
+	filtered := make([]types.Tag, 0, len(observed))
+	for _, t := range observed {
+		if !strings.HasPrefix(aws.ToString(t.Key), systemTagPrefix) {
+			filtered = append(filtered, t)
+		}
 	}
-	// RamDiskID
-	if awsclients.StringValue(spec.RAMDiskID) != awsclients.StringValue(instance.RamdiskId) {
-		return false
+	return awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(spec), filtered)
+}
+
+// GenerateModifyInstanceAttributeInputs returns the ModifyInstanceAttribute
+// inputs for the attributes whose desired value differs from the observed
+// one. Attributes that are not given in spec are not modified. EC2 allows
+// modifying only one attribute per call, so every attribute has its own input.
+func GenerateModifyInstanceAttributeInputs(id string, spec manualv1alpha1.InstanceParameters, instance types.Instance, attributes ec2.DescribeInstanceAttributeOutput) ([]*ec2.ModifyInstanceAttributeInput, error) { // nolint:gocyclo
+	var mods []*ec2.ModifyInstanceAttributeInput
+	if spec.DisableAPITermination != nil && *spec.DisableAPITermination != attributeBoolValue(attributes.DisableApiTermination) {
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:            aws.String(id),
+			DisableApiTermination: &types.AttributeBooleanValue{Value: spec.DisableAPITermination},
+		})
+	}
+	if spec.InstanceInitiatedShutdownBehavior != "" && spec.InstanceInitiatedShutdownBehavior != attributeValue(attributes.InstanceInitiatedShutdownBehavior) {
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:                        aws.String(id),
+			InstanceInitiatedShutdownBehavior: &types.AttributeValue{Value: aws.String(spec.InstanceInitiatedShutdownBehavior)},
+		})
+	}
+	if !isInstanceTypeUpToDate(spec, instance) {
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   aws.String(id),
+			InstanceType: &types.AttributeValue{Value: aws.String(spec.InstanceType)},
+		})
+	}
+	if !isEBSOptimizedUpToDate(spec, instance) {
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   aws.String(id),
+			EbsOptimized: &types.AttributeBooleanValue{Value: spec.EBSOptimized},
+		})
+	}
+	if !isStringAttributeUpToDate(spec.KernelID, attributes.KernelId) {
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Kernel:     &types.AttributeValue{Value: spec.KernelID},
+		})
+	}
+	if !isStringAttributeUpToDate(spec.RAMDiskID, attributes.RamdiskId) {
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Ramdisk:    &types.AttributeValue{Value: spec.RAMDiskID},
+		})
+	}
+	if !isStringAttributeUpToDate(spec.UserData, attributes.UserData) {
+		// The user data is base64 encoded both in spec and in
+		// DescribeInstanceAttribute output but the SDK encodes the blob
+		// value itself, so we send the decoded data.
+		data, err := base64.StdEncoding.DecodeString(*spec.UserData)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errDecodeUserData, err)
+		}
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			UserData:   &types.BlobAttributeValue{Value: data},
+		})
+	}
+	if !isSecurityGroupsUpToDate(spec.SecurityGroupIDs, instance.SecurityGroups) {
+		mods = append(mods, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Groups:     spec.SecurityGroupIDs,
+		})
+	}
+	return mods, nil
+}
+
+// IsInstanceStopRequired returns true if the instance needs to be stopped to
+// modify its attributes to match the desired ones. EC2 allows changing the
+// instance type, EBS optimization, kernel, RAM disk and user data only when
+// the instance is stopped.
+func IsInstanceStopRequired(spec manualv1alpha1.InstanceParameters, instance types.Instance, attributes ec2.DescribeInstanceAttributeOutput) bool {
+	return !isInstanceTypeUpToDate(spec, instance) ||
+		!isEBSOptimizedUpToDate(spec, instance) ||
+		!isStringAttributeUpToDate(spec.KernelID, attributes.KernelId) ||
+		!isStringAttributeUpToDate(spec.RAMDiskID, attributes.RamdiskId) ||
+		!isStringAttributeUpToDate(spec.UserData, attributes.UserData)
+}
+
+// DesiredInstanceState returns the state the instance should be in, which is
+// running unless it is given otherwise.
+func DesiredInstanceState(spec manualv1alpha1.InstanceParameters) types.InstanceStateName {
+	if spec.State == nil {
+		return types.InstanceStateNameRunning
+	}
+	return types.InstanceStateName(*spec.State)
+}
+
+// IsInstanceStateUpToDate returns true if the instance is in or transitioning
+// to its desired state. Instances that are being terminated are considered up
+// to date since they can neither be started nor stopped.
+func IsInstanceStateUpToDate(spec manualv1alpha1.InstanceParameters, observed types.InstanceStateName) bool {
+	switch observed { // nolint:exhaustive
+	case types.InstanceStateNamePending, types.InstanceStateNameRunning:
+		return DesiredInstanceState(spec) == types.InstanceStateNameRunning
+	case types.InstanceStateNameStopping, types.InstanceStateNameStopped:
+		return DesiredInstanceState(spec) == types.InstanceStateNameStopped
+	default:
+		return true
+	}
+}
+
+func isInstanceTypeUpToDate(spec manualv1alpha1.InstanceParameters, instance types.Instance) bool {
+	return spec.InstanceType == "" || spec.InstanceType == string(instance.InstanceType)
+}
+
+func isEBSOptimizedUpToDate(spec manualv1alpha1.InstanceParameters, instance types.Instance) bool {
+	return spec.EBSOptimized == nil || *spec.EBSOptimized == awsclients.BoolValue(instance.EbsOptimized)
+}
+
+func isStringAttributeUpToDate(spec *string, observed *types.AttributeValue) bool {
+	return spec == nil || *spec == attributeValue(observed)
+}
+
+// isSecurityGroupsUpToDate returns true if the instance is in exactly the
+// desired security groups, in any order. Security groups are not managed if
+// none is given.
+func isSecurityGroupsUpToDate(spec []string, observed []types.GroupIdentifier) bool {
+	if len(spec) == 0 {
+		return true
 	}
-	// UserData
-	if awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
+	if len(spec) != len(observed) {
 		return false
 	}
-	return manualv1alpha1.CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
+	ids := make(map[string]bool, len(observed))
+	for _, g := range observed {
+		ids[awsclients.StringValue(g.GroupId)] = true
+	}
+	for _, id := range spec {
+		if !ids[id] {
+			return false
+		}
+	}
+	return true
 }
 
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
@@ -140,6 +281,8 @@ func GenerateInstanceObservation(i types.Instance) manualv1alpha1.InstanceObserv
 // * Available
 // * Creating
 // * Deleting
+// * Stopping
+// * Stopped
 func GenerateInstanceCondition(o manualv1alpha1.InstanceObservation) Condition {
 	switch o.State {
 	case string(types.InstanceStateNameRunning):
@@ -147,9 +290,9 @@ func GenerateInstanceCondition(o manualv1alpha1.InstanceObservation) Condition {
 	case string(types.InstanceStateNameShuttingDown):
 		return Deleting
 	case string(types.InstanceStateNameStopped):
-		return Deleting
+		return Stopped
 	case string(types.InstanceStateNameStopping):
-		return Deleting
+		return Stopping
 	case string(types.InstanceStateNameTerminated):
 		return Deleted
 	default:
@@ -173,6 +316,11 @@ const (
 	// Deleted is the condition that represents all instances have entered
 	// the terminated state
 	Deleted Condition = "deleted"
+	// Stopping is the condition that represents the instance is being
+	// stopped
+	Stopping Condition = "stopping"
+	// Stopped is the condition that represents the instance is stopped
+	Stopped Condition = "stopped"
 )
 
 // LateInitializeInstance fills the empty fields in *manualv1alpha1.InstanceParameters with
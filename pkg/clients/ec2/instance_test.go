@@ -116,7 +116,15 @@ func TestGenerateInstanceConditions(t *testing.T) {
 					State: string(types.InstanceStateNameStopping),
 				},
 			},
-			want: Deleting,
+			want: Stopping,
+		},
+		"InstanceIsStopped": {
+			args: args{
+				obeserved: manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				},
+			},
+			want: Stopped,
 		},
 		"InstanceIsShuttingDown": {
 			args: args{
@@ -147,6 +155,263 @@ func TestGenerateInstanceConditions(t *testing.T) {
 	}
 }
 
+func TestIsInstanceStateUpToDate(t *testing.T) {
+	stopped := string(types.InstanceStateNameStopped)
+	type args struct {
+		spec     manualv1alpha1.InstanceParameters
+		observed types.InstanceStateName
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"RunningByDefault": {
+			args: args{
+				observed: types.InstanceStateNameRunning,
+			},
+			want: true,
+		},
+		"StartStoppedInstance": {
+			args: args{
+				observed: types.InstanceStateNameStopped,
+			},
+			want: false,
+		},
+		"StopRunningInstance": {
+			args: args{
+				spec:     manualv1alpha1.InstanceParameters{State: &stopped},
+				observed: types.InstanceStateNameRunning,
+			},
+			want: false,
+		},
+		"Stopping": {
+			args: args{
+				spec:     manualv1alpha1.InstanceParameters{State: &stopped},
+				observed: types.InstanceStateNameStopping,
+			},
+			want: true,
+		},
+		"ShuttingDown": {
+			args: args{
+				spec:     manualv1alpha1.InstanceParameters{State: &stopped},
+				observed: types.InstanceStateNameShuttingDown,
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceStateUpToDate(tc.args.spec, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceStopRequired(t *testing.T) {
+	type args struct {
+		spec       manualv1alpha1.InstanceParameters
+		instance   types.Instance
+		attributes ec2.DescribeInstanceAttributeOutput
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoChange": {
+			args: args{
+				spec:     manualv1alpha1.InstanceParameters{InstanceType: "m5.large", EBSOptimized: aws.Bool(true)},
+				instance: types.Instance{InstanceType: types.InstanceTypeM5Large, EbsOptimized: aws.Bool(true)},
+			},
+			want: false,
+		},
+		"InstanceTypeChanged": {
+			args: args{
+				spec:     manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"},
+				instance: types.Instance{InstanceType: types.InstanceTypeM5Large},
+			},
+			want: true,
+		},
+		"EBSOptimizationChanged": {
+			args: args{
+				spec:     manualv1alpha1.InstanceParameters{InstanceType: "m5.large", EBSOptimized: aws.Bool(true)},
+				instance: types.Instance{InstanceType: types.InstanceTypeM5Large},
+			},
+			want: true,
+		},
+		"UserDataChanged": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{UserData: aws.String("Zm9v")},
+				attributes: ec2.DescribeInstanceAttributeOutput{UserData: &types.AttributeValue{Value: aws.String("YmFy")}},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceStopRequired(tc.args.spec, tc.args.instance, tc.args.attributes)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyInstanceAttributeInputs(t *testing.T) {
+	id := "i-123"
+	type args struct {
+		spec       manualv1alpha1.InstanceParameters
+		instance   types.Instance
+		attributes ec2.DescribeInstanceAttributeOutput
+	}
+	type want struct {
+		mods []*ec2.ModifyInstanceAttributeInput
+		err  error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					DisableAPITermination:             aws.Bool(false),
+					InstanceInitiatedShutdownBehavior: "stop",
+					InstanceType:                      "m5.large",
+					UserData:                          aws.String("Zm9v"),
+					SecurityGroupIDs:                  []string{"sg-2", "sg-1"},
+				},
+				instance: types.Instance{
+					InstanceType: types.InstanceTypeM5Large,
+					SecurityGroups: []types.GroupIdentifier{
+						{GroupId: aws.String("sg-1")},
+						{GroupId: aws.String("sg-2")},
+					},
+				},
+				attributes: ec2.DescribeInstanceAttributeOutput{
+					DisableApiTermination:             &types.AttributeBooleanValue{Value: aws.Bool(false)},
+					InstanceInitiatedShutdownBehavior: &types.AttributeValue{Value: aws.String("stop")},
+					UserData:                          &types.AttributeValue{Value: aws.String("Zm9v")},
+				},
+			},
+		},
+		"Changed": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					DisableAPITermination:             aws.Bool(true),
+					InstanceInitiatedShutdownBehavior: "stop",
+					UserData:                          aws.String("Zm9v"),
+					SecurityGroupIDs:                  []string{"sg-1"},
+				},
+				instance: types.Instance{
+					SecurityGroups: []types.GroupIdentifier{
+						{GroupId: aws.String("sg-2")},
+					},
+				},
+				attributes: ec2.DescribeInstanceAttributeOutput{
+					InstanceInitiatedShutdownBehavior: &types.AttributeValue{Value: aws.String("stop")},
+					UserData:                          &types.AttributeValue{Value: aws.String("YmFy")},
+				},
+			},
+			want: want{
+				mods: []*ec2.ModifyInstanceAttributeInput{
+					{
+						InstanceId:            aws.String(id),
+						DisableApiTermination: &types.AttributeBooleanValue{Value: aws.Bool(true)},
+					},
+					{
+						InstanceId: aws.String(id),
+						UserData:   &types.BlobAttributeValue{Value: []byte("foo")},
+					},
+					{
+						InstanceId: aws.String(id),
+						Groups:     []string{"sg-1"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mods, err := GenerateModifyInstanceAttributeInputs(id, tc.args.spec, tc.args.instance, tc.args.attributes)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mods, mods, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	running := &types.InstanceState{Name: types.InstanceStateNameRunning}
+	type args struct {
+		spec     manualv1alpha1.InstanceParameters
+		instance types.Instance
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{Tags: []manualv1alpha1.Tag{{Key: "foo", Value: "bar"}}},
+				instance: types.Instance{
+					State: running,
+					Tags:  []types.Tag{{Key: aws.String("foo"), Value: aws.String("bar")}},
+				},
+			},
+			want: true,
+		},
+		"StaleTag": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{Tags: []manualv1alpha1.Tag{{Key: "foo", Value: "bar"}}},
+				instance: types.Instance{
+					State: running,
+					Tags: []types.Tag{
+						{Key: aws.String("foo"), Value: aws.String("bar")},
+						{Key: aws.String("stale"), Value: aws.String("tag")},
+					},
+				},
+			},
+			want: false,
+		},
+		"SystemTagIgnored": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{Tags: []manualv1alpha1.Tag{{Key: "foo", Value: "bar"}}},
+				instance: types.Instance{
+					State: running,
+					Tags: []types.Tag{
+						{Key: aws.String("foo"), Value: aws.String("bar")},
+						{Key: aws.String("aws:autoscaling:groupName"), Value: aws.String("asg")},
+					},
+				},
+			},
+			want: true,
+		},
+		"StateDiffers": {
+			args: args{
+				instance: types.Instance{State: &types.InstanceState{Name: types.InstanceStateNameStopped}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceUpToDate(tc.args.spec, tc.args.instance, ec2.DescribeInstanceAttributeOutput{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDescribeInstancesByExternalTags(t *testing.T) {
 	type args struct {
 		extTags map[string]string
@@ -12,6 +12,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
@@ -205,6 +206,8 @@ func RawPolicyData(original *v1alpha1.RepositoryPolicy) (string, error) {
 		return "", errors.New(errNotSpecified)
 	}
 	switch {
+	case original.Spec.ForProvider.PolicyDocument != nil:
+		return awspolicy.Marshal(original.Spec.ForProvider.PolicyDocument), nil
 	case original.Spec.ForProvider.RawPolicy != nil:
 		return *original.Spec.ForProvider.RawPolicy, nil
 	case original.Spec.ForProvider.Policy != nil:
@@ -9,6 +9,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -236,6 +237,24 @@ func TestFormat(t *testing.T) {
 				str: policy,
 			},
 		},
+		"PolicyDocument": {
+			args: formatarg{
+				cr: repositoryPolicy(withPolicy(&v1alpha1.RepositoryPolicyParameters{
+					RawPolicy: aws.String(`{"Version":"2012-10-17"}`),
+					PolicyDocument: &commonv1alpha1.PolicyDocument{
+						Version: "2012-10-17",
+						Statements: []commonv1alpha1.PolicyStatement{{
+							Effect:    "Allow",
+							Principal: &commonv1alpha1.PolicyPrincipal{AllowAnon: true},
+							Action:    []string{"ecr:ListImages"},
+						}},
+					},
+				})),
+			},
+			want: want{
+				str: policy,
+			},
+		},
 		"NoPolicy": {
 			args: formatarg{
 				cr: repositoryPolicy(withPolicy(&v1alpha1.RepositoryPolicyParameters{})),
@@ -20,17 +20,21 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
-	"errors"
-
+	"fmt"
 	"net"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -49,6 +53,11 @@ const (
 	expireHeader     = "X-Amz-Expires"
 	expireHeaderTime = "60"
 	v1Prefix         = "k8s-aws-v1."
+	execAPIVersion   = "client.authentication.k8s.io/v1beta1"
+
+	// TokenLifetime is how long EKS accepts a token after it is signed,
+	// regardless of the expiry of the presigned URL in it.
+	TokenLifetime = 15 * time.Minute
 )
 
 // Client defines EKS Client operations
@@ -60,6 +69,7 @@ type Client interface {
 	TagResource(ctx context.Context, input *eks.TagResourceInput, opts ...func(*eks.Options)) (*eks.TagResourceOutput, error)
 	UntagResource(ctx context.Context, input *eks.UntagResourceInput, opts ...func(*eks.Options)) (*eks.UntagResourceOutput, error)
 	UpdateClusterVersion(ctx context.Context, input *eks.UpdateClusterVersionInput, opts ...func(*eks.Options)) (*eks.UpdateClusterVersionOutput, error)
+	DescribeUpdate(ctx context.Context, input *eks.DescribeUpdateInput, opts ...func(*eks.Options)) (*eks.DescribeUpdateOutput, error)
 
 	DescribeNodegroup(ctx context.Context, input *eks.DescribeNodegroupInput, opts ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
 	CreateNodegroup(ctx context.Context, input *eks.CreateNodegroupInput, opts ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error)
@@ -209,6 +219,74 @@ func GenerateUpdateClusterConfigInput(name string, p *v1beta1.ClusterParameters)
 	return u
 }
 
+// GenerateClusterUpdate returns the observation of given update that is
+// requested for given generation of a Cluster.
+func GenerateClusterUpdate(u *ekstypes.Update, generation int64) *v1beta1.ClusterUpdate {
+	if u == nil || u.Id == nil {
+		return nil
+	}
+	return &v1beta1.ClusterUpdate{
+		ID:         aws.ToString(u.Id),
+		Type:       string(u.Type),
+		Status:     v1beta1.ClusterUpdateStatus(u.Status),
+		Generation: generation,
+	}
+}
+
+// UpdateErrors returns the errors of given update in a human readable form.
+func UpdateErrors(u *ekstypes.Update) string {
+	if u == nil || len(u.Errors) == 0 {
+		return "no error details are given"
+	}
+	errs := make([]string, len(u.Errors))
+	for i, e := range u.Errors {
+		errs[i] = fmt.Sprintf("%s: %s", e.ErrorCode, aws.ToString(e.ErrorMessage))
+	}
+	return strings.Join(errs, "; ")
+}
+
+// NextVersion returns the version that a cluster at current version needs to
+// be updated to in order to reach the desired version. EKS updates clusters
+// one minor version at a time, so the next minor version is returned if
+// multiple minor versions are allowed to be stepped through, and an error is
+// returned otherwise. The desired version is returned as is if any of the
+// versions cannot be parsed, leaving the validation to EKS.
+func NextVersion(current, desired string, allowSteps bool) (string, error) {
+	curMajor, curMinor, err := parseVersion(current)
+	if err != nil {
+		return desired, nil
+	}
+	desMajor, desMinor, err := parseVersion(desired)
+	if err != nil || curMajor != desMajor {
+		return desired, nil
+	}
+	switch {
+	case desMinor < curMinor:
+		return "", fmt.Errorf("cannot downgrade from version %s to %s", current, desired)
+	case desMinor-curMinor <= 1:
+		return desired, nil
+	case !allowSteps:
+		return "", fmt.Errorf("cannot upgrade from version %s to %s since it is more than one minor version, set %s annotation to true to upgrade one minor version at a time", current, desired, v1beta1.AnnotationKeyAllowMultiMinorUpgrade)
+	}
+	return fmt.Sprintf("%d.%d", curMajor, curMinor+1), nil
+}
+
+func parseVersion(v string) (int, int, error) {
+	parts := strings.SplitN(v, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, errors.New("version is not in major.minor format")
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return major, minor, nil
+}
+
 // GenerateObservation is used to produce v1beta1.ClusterObservation from
 // ekstypes.Cluster.
 func GenerateObservation(cluster *ekstypes.Cluster) v1beta1.ClusterObservation { // nolint:gocyclo
@@ -336,45 +414,62 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) (bool,
 	}
 	res := cmp.Equal(&v1beta1.ClusterParameters{}, patch, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region"),
+		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region", "Kubeconfig"),
 		cmpopts.IgnoreFields(v1beta1.VpcConfigRequest{}, "PublicAccessCidrs", "SubnetIDs", "SecurityGroupIDs"))
 	return res, nil
 }
 
-// GetConnectionDetails extracts managed.ConnectionDetails out of ekstypes.Cluster.
-func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient) managed.ConnectionDetails {
+// GetConnectionDetails extracts managed.ConnectionDetails out of
+// ekstypes.Cluster. The kubeconfig contains a token that expires at the
+// returned time, unless kc configures it to run a command to get tokens in
+// which case the returned time is zero. Empty connection details are returned
+// for clusters whose endpoint and certificate authority are not known yet.
+func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient, kc *v1beta1.KubeconfigConfig) (managed.ConnectionDetails, time.Time, error) {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
-		return managed.ConnectionDetails{}
+		return managed.ConnectionDetails{}, time.Time{}, nil
 	}
 
-	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
-		func(po *sts.PresignOptions) {
-			po.ClientOptions = []func(*sts.Options){
-				sts.WithAPIOptions(
-					smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
-					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
-				),
-			}
-		},
-	)
+	authInfo := &clientcmdapi.AuthInfo{}
+	expiry := time.Time{}
+	if kc != nil && kc.Exec != nil {
+		authInfo.Exec = GenerateExecConfig(cluster, kc.Exec)
+	} else {
+		// The token is accepted for TokenLifetime after it is signed, so the
+		// time before signing is taken to be on the safe side.
+		signedAt := time.Now()
+		getCallerIdentity, err := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+			func(po *sts.PresignOptions) {
+				po.ClientOptions = []func(*sts.Options){
+					sts.WithAPIOptions(
+						smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
+						smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
+					),
+				}
+			},
+		)
+		if err != nil {
+			return nil, time.Time{}, errors.Wrap(err, "cannot presign GetCallerIdentity request")
+		}
 
-	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
-	// EKS cluster resource. Signing the URL means that anyone in possession of
-	// this Kubeconfig will now be able to access the EKS cluster until this URL
-	// expires. This is necessary for other systems, such as core Crossplane, to
-	// be able to schedule workloads to the cluster for now, but is not the most
-	// secure way of accessing the cluster.
-	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
+		// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
+		// EKS cluster resource. Signing the URL means that anyone in possession of
+		// this Kubeconfig will now be able to access the EKS cluster until this URL
+		// expires. This is necessary for other systems, such as core Crossplane, to
+		// be able to schedule workloads to the cluster for now, but is not the most
+		// secure way of accessing the cluster.
+		// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+		authInfo.Token = v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
+		expiry = signedAt.Add(TokenLifetime)
+	}
 
 	// NOTE(hasheddan): We must decode the CA data before constructing our
 	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
 	// written as a Secret.
 	caData, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
 	if err != nil {
-		return managed.ConnectionDetails{}
+		return nil, time.Time{}, errors.Wrap(err, "cannot decode certificate authority data")
 	}
-	kc := clientcmdapi.Config{
+	cfg := clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{
 			*cluster.Name: {
 				Server:                   *cluster.Endpoint,
@@ -388,20 +483,46 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: authInfo,
 		},
 		CurrentContext: *cluster.Name,
 	}
 
-	rawConfig, err := clientcmd.Write(kc)
+	rawConfig, err := clientcmd.Write(cfg)
 	if err != nil {
-		return managed.ConnectionDetails{}
+		return nil, time.Time{}, errors.Wrap(err, "cannot write kubeconfig")
 	}
 	return managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(*cluster.Endpoint),
 		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
 		xpv1.ResourceCredentialsSecretCAKey:         caData,
+	}, expiry, nil
+}
+
+// GenerateExecConfig returns the configuration of the credential plugin that
+// the kubeconfig of given cluster runs to get a token.
+func GenerateExecConfig(cluster *ekstypes.Cluster, e *v1beta1.KubeconfigExec) *clientcmdapi.ExecConfig {
+	ec := &clientcmdapi.ExecConfig{
+		APIVersion: awsclients.StringValue(awsclients.LateInitializeStringPtr(e.APIVersion, awsclients.String(execAPIVersion))),
+	}
+	switch e.Command {
+	case v1beta1.KubeconfigExecCommandAWSIAMAuthenticator:
+		ec.Command = string(v1beta1.KubeconfigExecCommandAWSIAMAuthenticator)
+		ec.Args = []string{"token", "-i", awsclients.StringValue(cluster.Name)}
+		if e.RoleARN != nil {
+			ec.Args = append(ec.Args, "-r", *e.RoleARN)
+		}
+	default:
+		ec.Command = string(v1beta1.KubeconfigExecCommandAWS)
+		ec.Args = []string{"eks", "get-token", "--cluster-name", awsclients.StringValue(cluster.Name)}
+		// aws CLI needs the region of the cluster, which may not be the one
+		// configured in the environment of the consumer.
+		if a, err := arn.Parse(awsclients.StringValue(cluster.Arn)); err == nil {
+			ec.Args = append(ec.Args, "--region", a.Region)
+		}
+		if e.RoleARN != nil {
+			ec.Args = append(ec.Args, "--role-arn", *e.RoleARN)
+		}
 	}
+	return ec
 }
@@ -17,18 +17,22 @@ limitations under the License.
 package eks
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 )
@@ -821,3 +825,94 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateExecConfig(t *testing.T) {
+	cluster := &ekstypes.Cluster{
+		Name: &clusterName,
+		Arn:  aws.String("arn:aws:eks:us-west-2:123456789012:cluster/my-cool-cluster"),
+	}
+
+	cases := map[string]struct {
+		exec *v1beta1.KubeconfigExec
+		want *clientcmdapi.ExecConfig
+	}{
+		"AWS": {
+			exec: &v1beta1.KubeconfigExec{RoleARN: &roleArn},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion: execAPIVersion,
+				Command:    "aws",
+				Args:       []string{"eks", "get-token", "--cluster-name", clusterName, "--region", "us-west-2", "--role-arn", roleArn},
+			},
+		},
+		"AWSIAMAuthenticator": {
+			exec: &v1beta1.KubeconfigExec{
+				Command:    v1beta1.KubeconfigExecCommandAWSIAMAuthenticator,
+				APIVersion: aws.String("client.authentication.k8s.io/v1alpha1"),
+			},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion: "client.authentication.k8s.io/v1alpha1",
+				Command:    "aws-iam-authenticator",
+				Args:       []string{"token", "-i", clusterName},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateExecConfig(cluster, tc.exec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	type args struct {
+		current    string
+		desired    string
+		allowSteps bool
+	}
+	type want struct {
+		version string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"OneMinorVersion": {
+			args: args{current: "1.20", desired: "1.21"},
+			want: want{version: "1.21"},
+		},
+		"MultipleMinorVersions": {
+			args: args{current: "1.19", desired: "1.21"},
+			want: want{err: fmt.Errorf("cannot upgrade from version 1.19 to 1.21 since it is more than one minor version, set %s annotation to true to upgrade one minor version at a time", v1beta1.AnnotationKeyAllowMultiMinorUpgrade)},
+		},
+		"StepThroughMinorVersions": {
+			args: args{current: "1.19", desired: "1.21", allowSteps: true},
+			want: want{version: "1.20"},
+		},
+		"Downgrade": {
+			args: args{current: "1.21", desired: "1.20"},
+			want: want{err: fmt.Errorf("cannot downgrade from version 1.21 to 1.20")},
+		},
+		"UnknownCurrentVersion": {
+			args: args{desired: "1.21"},
+			want: want{version: "1.21"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v, err := NextVersion(tc.args.current, tc.args.desired, tc.args.allowSteps)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, v); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
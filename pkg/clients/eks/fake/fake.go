@@ -33,6 +33,7 @@ type MockClient struct {
 	MockTagResource          func(ctx context.Context, input *eks.TagResourceInput, opts []func(*eks.Options)) (*eks.TagResourceOutput, error)
 	MockUntagResource        func(ctx context.Context, input *eks.UntagResourceInput, opts []func(*eks.Options)) (*eks.UntagResourceOutput, error)
 	MockUpdateClusterVersion func(ctx context.Context, input *eks.UpdateClusterVersionInput, opts []func(*eks.Options)) (*eks.UpdateClusterVersionOutput, error)
+	MockDescribeUpdate       func(ctx context.Context, input *eks.DescribeUpdateInput, opts []func(*eks.Options)) (*eks.DescribeUpdateOutput, error)
 
 	MockDescribeNodegroup      func(ctx context.Context, input *eks.DescribeNodegroupInput, opts []func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
 	MockCreateNodegroup        func(ctx context.Context, input *eks.CreateNodegroupInput, opts []func(*eks.Options)) (*eks.CreateNodegroupOutput, error)
@@ -97,6 +98,11 @@ func (c *MockClient) UpdateClusterVersion(ctx context.Context, input *eks.Update
 	return c.MockUpdateClusterVersion(ctx, input, opts)
 }
 
+// DescribeUpdate calls the underlying MockDescribeUpdate method.
+func (c *MockClient) DescribeUpdate(ctx context.Context, input *eks.DescribeUpdateInput, opts ...func(*eks.Options)) (*eks.DescribeUpdateOutput, error) {
+	return c.MockDescribeUpdate(ctx, input, opts)
+}
+
 // DescribeNodegroup calls the underlying MockDescribeNodegroup
 // method.
 func (c *MockClient) DescribeNodegroup(ctx context.Context, input *eks.DescribeNodegroupInput, opts ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error) {
@@ -75,18 +75,101 @@ func GenerateCreateNodeGroupInput(name string, p *manualv1alpha1.NodeGroupParame
 		}
 	}
 	if len(p.Taints) != 0 {
-		c.Taints = make([]ekstypes.Taint, len(p.Taints))
-		for i, t := range p.Taints {
-			c.Taints[i] = ekstypes.Taint{
-				Effect: ekstypes.TaintEffect(t.Effect),
-				Key:    t.Key,
-				Value:  t.Value,
-			}
+		c.Taints = generateTaints(p.Taints)
+	}
+	if p.UpdateConfig != nil {
+		c.UpdateConfig = &ekstypes.NodegroupUpdateConfig{
+			MaxUnavailable:           p.UpdateConfig.MaxUnavailable,
+			MaxUnavailablePercentage: p.UpdateConfig.MaxUnavailablePercentage,
 		}
 	}
 	return c
 }
 
+func generateTaints(in []manualv1alpha1.Taint) []ekstypes.Taint {
+	out := make([]ekstypes.Taint, len(in))
+	for i, t := range in {
+		out[i] = ekstypes.Taint{
+			Effect: ekstypes.TaintEffect(t.Effect),
+			Key:    t.Key,
+			Value:  t.Value,
+		}
+	}
+	return out
+}
+
+// DiffTaints returns the taints that need to be added or updated and the ones
+// that need to be removed so that the observed taints match the desired ones.
+// A taint is identified by its key and effect. Taints are not managed if the
+// desired ones are nil, and all of them are removed if the desired ones are
+// an empty list.
+func DiffTaints(spec []manualv1alpha1.Taint, current []ekstypes.Taint) (addOrUpdate, remove []ekstypes.Taint) {
+	if spec == nil {
+		return nil, nil
+	}
+	desired := generateTaints(spec)
+	type taintID struct {
+		key    string
+		effect ekstypes.TaintEffect
+	}
+	observed := make(map[taintID]ekstypes.Taint, len(current))
+	for _, t := range current {
+		observed[taintID{key: aws.ToString(t.Key), effect: t.Effect}] = t
+	}
+	wanted := make(map[taintID]bool, len(desired))
+	for _, t := range desired {
+		id := taintID{key: aws.ToString(t.Key), effect: t.Effect}
+		wanted[id] = true
+		if o, ok := observed[id]; !ok || aws.ToString(o.Value) != aws.ToString(t.Value) {
+			addOrUpdate = append(addOrUpdate, t)
+		}
+	}
+	for _, t := range current {
+		if !wanted[taintID{key: aws.ToString(t.Key), effect: t.Effect}] {
+			remove = append(remove, t)
+		}
+	}
+	return addOrUpdate, remove
+}
+
+// GenerateUpdateNodeGroupVersionInput from NodeGroupParameters. The
+// Kubernetes version and the launch template are included only if they
+// differ from the observed ones since node groups with a custom AMI in their
+// launch template don't accept a version.
+func GenerateUpdateNodeGroupVersionInput(name string, p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) *eks.UpdateNodegroupVersionInput {
+	u := &eks.UpdateNodegroupVersionInput{
+		NodegroupName: &name,
+		ClusterName:   &p.ClusterName,
+	}
+	if !cmp.Equal(p.Version, ng.Version) {
+		u.Version = p.Version
+	}
+	if !isLaunchTemplateUpToDate(p.LaunchTemplate, ng.LaunchTemplate) {
+		u.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{Version: p.LaunchTemplate.Version}
+		// Only one of ID and name can be given.
+		if p.LaunchTemplate.ID != nil {
+			u.LaunchTemplate.Id = p.LaunchTemplate.ID
+		} else {
+			u.LaunchTemplate.Name = p.LaunchTemplate.Name
+		}
+	}
+	return u
+}
+
+// IsNodeGroupVersionUpToDate checks whether the Kubernetes version and the
+// launch template version of the node group are up to date. These fields can
+// only be changed with UpdateNodegroupVersion calls.
+func IsNodeGroupVersionUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) bool {
+	return cmp.Equal(p.Version, ng.Version) && isLaunchTemplateUpToDate(p.LaunchTemplate, ng.LaunchTemplate)
+}
+
+func isLaunchTemplateUpToDate(p *manualv1alpha1.LaunchTemplateSpecification, lt *ekstypes.LaunchTemplateSpecification) bool {
+	if p == nil || p.Version == nil {
+		return true
+	}
+	return lt != nil && aws.ToString(p.Version) == aws.ToString(lt.Version)
+}
+
 // GenerateUpdateNodeGroupConfigInput from NodeGroupParameters.
 func GenerateUpdateNodeGroupConfigInput(name string, p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) *eks.UpdateNodegroupConfigInput {
 	u := &eks.UpdateNodegroupConfigInput{
@@ -122,7 +205,20 @@ func GenerateUpdateNodeGroupConfigInput(name string, p *manualv1alpha1.NodeGroup
 			}
 		}
 	}
-	// TODO(muvaf): Add support for updating taints.
+	// Taints aren't late-initialized. They are left as is if none is given,
+	// and all of them are removed if an empty list is given.
+	if addOrUpdate, remove := DiffTaints(p.Taints, ng.Taints); len(addOrUpdate) != 0 || len(remove) != 0 {
+		u.Taints = &ekstypes.UpdateTaintsPayload{
+			AddOrUpdateTaints: addOrUpdate,
+			RemoveTaints:      remove,
+		}
+	}
+	if p.UpdateConfig != nil {
+		u.UpdateConfig = &ekstypes.NodegroupUpdateConfig{
+			MaxUnavailable:           p.UpdateConfig.MaxUnavailable,
+			MaxUnavailablePercentage: p.UpdateConfig.MaxUnavailablePercentage,
+		}
+	}
 	return u
 }
 
@@ -211,16 +307,30 @@ func LateInitializeNodeGroup(in *manualv1alpha1.NodeGroupParameters, ng *ekstype
 	if len(in.Tags) == 0 {
 		in.Tags = ng.Tags
 	}
-	if len(in.Taints) == 0 && len(ng.Taints) != 0 {
-		in.Taints = make([]manualv1alpha1.Taint, len(ng.Taints))
-		for i, t := range ng.Taints {
-			in.Taints[i] = manualv1alpha1.Taint{
-				Effect: string(t.Effect),
-				Key:    t.Key,
-				Value:  t.Value,
-			}
+	if in.LaunchTemplate == nil && ng.LaunchTemplate != nil {
+		in.LaunchTemplate = &manualv1alpha1.LaunchTemplateSpecification{
+			ID:      ng.LaunchTemplate.Id,
+			Version: ng.LaunchTemplate.Version,
 		}
 	}
+	if in.LaunchTemplate != nil && ng.LaunchTemplate != nil {
+		in.LaunchTemplate.Version = awsclient.LateInitializeStringPtr(in.LaunchTemplate.Version, ng.LaunchTemplate.Version)
+	}
+	if in.UpdateConfig == nil && ng.UpdateConfig != nil {
+		in.UpdateConfig = &manualv1alpha1.NodeGroupUpdateConfig{
+			MaxUnavailable:           ng.UpdateConfig.MaxUnavailable,
+			MaxUnavailablePercentage: ng.UpdateConfig.MaxUnavailablePercentage,
+		}
+	}
+}
+
+// IsCapacityTypeChanged returns whether the desired capacity type differs from
+// the one the node group was created with. Capacity type can't be updated.
+func IsCapacityTypeChanged(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) bool {
+	if p.CapacityType == nil || ng.CapacityType == "" {
+		return false
+	}
+	return aws.ToString(p.CapacityType) != string(ng.CapacityType)
 }
 
 // IsNodeGroupUpToDate checks whether there is a change in any of the modifiable fields.
@@ -228,12 +338,20 @@ func IsNodeGroupUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nod
 	if !cmp.Equal(p.Tags, ng.Tags, cmpopts.EquateEmpty()) {
 		return false
 	}
-	if !cmp.Equal(p.Version, ng.Version) {
+	if !IsNodeGroupVersionUpToDate(p, ng) {
 		return false
 	}
 	if !cmp.Equal(p.Labels, ng.Labels, cmpopts.EquateEmpty()) {
 		return false
 	}
+	if addOrUpdate, remove := DiffTaints(p.Taints, ng.Taints); len(addOrUpdate) != 0 || len(remove) != 0 {
+		return false
+	}
+	if p.UpdateConfig != nil && (ng.UpdateConfig == nil ||
+		!cmp.Equal(p.UpdateConfig.MaxUnavailable, ng.UpdateConfig.MaxUnavailable) ||
+		!cmp.Equal(p.UpdateConfig.MaxUnavailablePercentage, ng.UpdateConfig.MaxUnavailablePercentage)) {
+		return false
+	}
 	if p.ScalingConfig == nil && ng.ScalingConfig == nil {
 		return true
 	}
@@ -369,6 +369,83 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 				},
 			},
 		},
+		"TaintsAndUpdateConfig": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Taints: []manualv1alpha1.Taint{
+						{Effect: "NO_SCHEDULE", Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+						{Effect: "NO_EXECUTE", Key: awsclients.String("spot"), Value: awsclients.String("true")},
+					},
+					UpdateConfig: &manualv1alpha1.NodeGroupUpdateConfig{
+						MaxUnavailablePercentage: awsclients.Int32(25),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("gpu"), Value: awsclients.String("false")},
+						{Effect: ekstypes.TaintEffectNoExecute, Key: awsclients.String("spot"), Value: awsclients.String("true")},
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("old")},
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupConfigInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Taints: &ekstypes.UpdateTaintsPayload{
+					AddOrUpdateTaints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+					},
+					RemoveTaints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("old")},
+					},
+				},
+				UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+					MaxUnavailablePercentage: awsclients.Int32(25),
+				},
+			},
+		},
+		"TaintsNotManaged": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+				},
+				n: &ekstypes.Nodegroup{
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupConfigInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			},
+		},
+		"RemoveAllTaints": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Taints:      []manualv1alpha1.Taint{},
+				},
+				n: &ekstypes.Nodegroup{
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupConfigInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Taints: &ekstypes.UpdateTaintsPayload{
+					RemoveTaints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -564,6 +641,17 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 				Version: &version,
 			},
 		},
+		"TaintsNotLateInitialized": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{},
+				n: &ekstypes.Nodegroup{
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("gpu")},
+					},
+				},
+			},
+			want: &manualv1alpha1.NodeGroupParameters{},
+		},
 	}
 
 	for name, tc := range cases {
@@ -576,6 +664,49 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 	}
 }
 
+func TestIsCapacityTypeChanged(t *testing.T) {
+	type args struct {
+		p *manualv1alpha1.NodeGroupParameters
+		n *ekstypes.Nodegroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotSpecified": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{},
+				n: &ekstypes.Nodegroup{CapacityType: ekstypes.CapacityTypesSpot},
+			},
+			want: false,
+		},
+		"Same": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{CapacityType: awsclients.String("SPOT")},
+				n: &ekstypes.Nodegroup{CapacityType: ekstypes.CapacityTypesSpot},
+			},
+			want: false,
+		},
+		"Changed": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{CapacityType: awsclients.String("SPOT")},
+				n: &ekstypes.Nodegroup{CapacityType: ekstypes.CapacityTypesOnDemand},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCapacityTypeChanged(tc.args.p, tc.args.n)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsNodeGroupUpToDate(t *testing.T) {
 	otherVersion := "1.17"
 	otherSize := int32(100)
@@ -717,6 +848,73 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"UpdateTaints": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version: &version,
+					Taints: []manualv1alpha1.Taint{
+						{Effect: "NO_SCHEDULE", Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoExecute, Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+					},
+				},
+			},
+			want: false,
+		},
+		"TaintsNotManaged": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version: &version,
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoExecute, Key: awsclients.String("gpu"), Value: awsclients.String("true")},
+					},
+				},
+			},
+			want: true,
+		},
+		"UpdateLaunchTemplateVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version: &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						ID:      awsclients.String("lt-123"),
+						Version: awsclients.String("3"),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      awsclients.String("lt-123"),
+						Version: awsclients.String("2"),
+					},
+				},
+			},
+			want: false,
+		},
+		"UpdateConfig": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version: &version,
+					UpdateConfig: &manualv1alpha1.NodeGroupUpdateConfig{
+						MaxUnavailable: awsclients.Int32(2),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+						MaxUnavailable: awsclients.Int32(1),
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -728,3 +926,82 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateUpdateNodeGroupVersionInput(t *testing.T) {
+	otherVersion := "1.17"
+
+	type args struct {
+		name string
+		p    *manualv1alpha1.NodeGroupParameters
+		n    *ekstypes.Nodegroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.UpdateNodegroupVersionInput
+	}{
+		"VersionOnly": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &otherVersion,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						ID:      awsclients.String("lt-123"),
+						Version: awsclients.String("2"),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      awsclients.String("lt-123"),
+						Version: awsclients.String("2"),
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &otherVersion,
+			},
+		},
+		"LaunchTemplateOnly": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						Name:    awsclients.String("gpu"),
+						Version: awsclients.String("3"),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      awsclients.String("lt-123"),
+						Name:    awsclients.String("gpu"),
+						Version: awsclients.String("2"),
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Name:    awsclients.String("gpu"),
+					Version: awsclients.String("3"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateNodeGroupVersionInput(tc.args.name, tc.args.p, tc.args.n)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ package elasticache
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"reflect"
 	"strconv"
 	"strings"
@@ -147,6 +149,49 @@ func NewModifyReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 	}
 }
 
+// NewAuthTokenModifyReplicationGroupInput returns ElastiCache replication group
+// modification input that updates the auth token with the given strategy.
+// Auth token updates have to be applied immediately.
+func NewAuthTokenModifyReplicationGroupInput(id, token string, strategy elasticachetypes.AuthTokenUpdateStrategyType) *elasticache.ModifyReplicationGroupInput {
+	return &elasticache.ModifyReplicationGroupInput{
+		ReplicationGroupId:      aws.String(id),
+		ApplyImmediately:        true,
+		AuthToken:               aws.String(token),
+		AuthTokenUpdateStrategy: strategy,
+	}
+}
+
+// NextAuthTokenUpdateStrategy returns the strategy of the next step of the
+// auth token rotation to the given token. The new token is added with ROTATE
+// first and then SET removes the old one. The rotation starts over with
+// ROTATE if the token changed since the last step. An empty strategy is
+// returned once there is no step left.
+func NextAuthTokenUpdateStrategy(o v1beta1.ReplicationGroupObservation, token string) elasticachetypes.AuthTokenUpdateStrategyType {
+	if o.AuthTokenUpdateHash != AuthTokenHash(token) {
+		return elasticachetypes.AuthTokenUpdateStrategyTypeRotate
+	}
+	switch elasticachetypes.AuthTokenUpdateStrategyType(o.AuthTokenUpdateStrategy) {
+	case "":
+		return elasticachetypes.AuthTokenUpdateStrategyTypeRotate
+	case elasticachetypes.AuthTokenUpdateStrategyTypeRotate:
+		return elasticachetypes.AuthTokenUpdateStrategyTypeSet
+	}
+	return ""
+}
+
+// AuthTokenHash returns the hash of the given auth token that is stored in the
+// status to detect the changes of the token during its rotation.
+func AuthTokenHash(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// IsAuthTokenUpdateCompleted returns true if the replication group is
+// available and has no auth token update in progress.
+func IsAuthTokenUpdateCompleted(o v1beta1.ReplicationGroupObservation) bool {
+	return o.Status == v1beta1.StatusAvailable && o.PendingModifiedValues.AuthTokenStatus == ""
+}
+
 // NewDeleteReplicationGroupInput returns ElastiCache replication group deletion
 // input suitable for use with the AWS API.
 func NewDeleteReplicationGroupInput(id string) *elasticache.DeleteReplicationGroupInput {
@@ -356,6 +401,7 @@ func generateNodeGroup(ng elasticachetypes.NodeGroup) v1beta1.NodeGroup {
 
 func generateReplicationGroupPendingModifiedValues(in elasticachetypes.ReplicationGroupPendingModifiedValues) v1beta1.ReplicationGroupPendingModifiedValues {
 	r := v1beta1.ReplicationGroupPendingModifiedValues{
+		AuthTokenStatus:         string(in.AuthTokenStatus),
 		AutomaticFailoverStatus: string(in.AutomaticFailoverStatus),
 		PrimaryClusterID:        clients.StringValue(in.PrimaryClusterId),
 	}
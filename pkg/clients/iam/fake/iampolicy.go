@@ -31,15 +31,16 @@ var _ clientset.STSClient = (*MockSTSClient)(nil)
 
 // MockPolicyClient is a type that implements all the methods for PolicyClient interface
 type MockPolicyClient struct {
-	MockGetPolicy           func(ctx context.Context, input *iam.GetPolicyInput, opts []func(*iam.Options)) (*iam.GetPolicyOutput, error)
-	MockCreatePolicy        func(ctx context.Context, input *iam.CreatePolicyInput, opts []func(*iam.Options)) (*iam.CreatePolicyOutput, error)
-	MockDeletePolicy        func(ctx context.Context, input *iam.DeletePolicyInput, opts []func(*iam.Options)) (*iam.DeletePolicyOutput, error)
-	MockGetPolicyVersion    func(ctx context.Context, input *iam.GetPolicyVersionInput, opts []func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
-	MockCreatePolicyVersion func(ctx context.Context, input *iam.CreatePolicyVersionInput, opts []func(*iam.Options)) (*iam.CreatePolicyVersionOutput, error)
-	MockListPolicyVersions  func(ctx context.Context, input *iam.ListPolicyVersionsInput, opts []func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
-	MockDeletePolicyVersion func(ctx context.Context, input *iam.DeletePolicyVersionInput, opts []func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
-	MockTagPolicy           func(ctx context.Context, input *iam.TagPolicyInput, opts []func(*iam.Options)) (*iam.TagPolicyOutput, error)
-	MockUntagPolicy         func(ctx context.Context, input *iam.UntagPolicyInput, opts []func(*iam.Options)) (*iam.UntagPolicyOutput, error)
+	MockGetPolicy               func(ctx context.Context, input *iam.GetPolicyInput, opts []func(*iam.Options)) (*iam.GetPolicyOutput, error)
+	MockCreatePolicy            func(ctx context.Context, input *iam.CreatePolicyInput, opts []func(*iam.Options)) (*iam.CreatePolicyOutput, error)
+	MockDeletePolicy            func(ctx context.Context, input *iam.DeletePolicyInput, opts []func(*iam.Options)) (*iam.DeletePolicyOutput, error)
+	MockGetPolicyVersion        func(ctx context.Context, input *iam.GetPolicyVersionInput, opts []func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
+	MockCreatePolicyVersion     func(ctx context.Context, input *iam.CreatePolicyVersionInput, opts []func(*iam.Options)) (*iam.CreatePolicyVersionOutput, error)
+	MockListPolicyVersions      func(ctx context.Context, input *iam.ListPolicyVersionsInput, opts []func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
+	MockDeletePolicyVersion     func(ctx context.Context, input *iam.DeletePolicyVersionInput, opts []func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
+	MockTagPolicy               func(ctx context.Context, input *iam.TagPolicyInput, opts []func(*iam.Options)) (*iam.TagPolicyOutput, error)
+	MockUntagPolicy             func(ctx context.Context, input *iam.UntagPolicyInput, opts []func(*iam.Options)) (*iam.UntagPolicyOutput, error)
+	MockSetDefaultPolicyVersion func(ctx context.Context, input *iam.SetDefaultPolicyVersionInput, opts []func(*iam.Options)) (*iam.SetDefaultPolicyVersionOutput, error)
 }
 
 // MockSTSClient mock sts client
@@ -96,3 +97,8 @@ func (m *MockPolicyClient) TagPolicy(ctx context.Context, input *iam.TagPolicyIn
 func (m *MockPolicyClient) UntagPolicy(ctx context.Context, input *iam.UntagPolicyInput, opts ...func(*iam.Options)) (*iam.UntagPolicyOutput, error) {
 	return m.MockUntagPolicy(ctx, input, opts)
 }
+
+// SetDefaultPolicyVersion mocks SetDefaultPolicyVersion method
+func (m *MockPolicyClient) SetDefaultPolicyVersion(ctx context.Context, input *iam.SetDefaultPolicyVersionInput, opts ...func(*iam.Options)) (*iam.SetDefaultPolicyVersionOutput, error) {
+	return m.MockSetDefaultPolicyVersion(ctx, input, opts)
+}
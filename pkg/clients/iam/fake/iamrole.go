@@ -36,6 +36,10 @@ type MockRoleClient struct {
 	MockUpdateAssumeRolePolicy func(ctx context.Context, input *iam.UpdateAssumeRolePolicyInput, opts []func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
 	MockTagRole                func(ctx context.Context, input *iam.TagRoleInput, opts []func(*iam.Options)) (*iam.TagRoleOutput, error)
 	MockUntagRole              func(ctx context.Context, input *iam.UntagRoleInput, opts []func(*iam.Options)) (*iam.UntagRoleOutput, error)
+	MockListRolePolicies       func(ctx context.Context, input *iam.ListRolePoliciesInput, opts []func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	MockGetRolePolicy          func(ctx context.Context, input *iam.GetRolePolicyInput, opts []func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	MockPutRolePolicy          func(ctx context.Context, input *iam.PutRolePolicyInput, opts []func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	MockDeleteRolePolicy       func(ctx context.Context, input *iam.DeleteRolePolicyInput, opts []func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
 }
 
 // GetRole mocks GetRole method
@@ -72,3 +76,23 @@ func (m *MockRoleClient) TagRole(ctx context.Context, input *iam.TagRoleInput, o
 func (m *MockRoleClient) UntagRole(ctx context.Context, input *iam.UntagRoleInput, opts ...func(*iam.Options)) (*iam.UntagRoleOutput, error) {
 	return m.MockUntagRole(ctx, input, opts)
 }
+
+// ListRolePolicies mocks ListRolePolicies method
+func (m *MockRoleClient) ListRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error) {
+	return m.MockListRolePolicies(ctx, input, opts)
+}
+
+// GetRolePolicy mocks GetRolePolicy method
+func (m *MockRoleClient) GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, opts ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error) {
+	return m.MockGetRolePolicy(ctx, input, opts)
+}
+
+// PutRolePolicy mocks PutRolePolicy method
+func (m *MockRoleClient) PutRolePolicy(ctx context.Context, input *iam.PutRolePolicyInput, opts ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error) {
+	return m.MockPutRolePolicy(ctx, input, opts)
+}
+
+// DeleteRolePolicy mocks DeleteRolePolicy method
+func (m *MockRoleClient) DeleteRolePolicy(ctx context.Context, input *iam.DeleteRolePolicyInput, opts ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error) {
+	return m.MockDeleteRolePolicy(ctx, input, opts)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// InstanceProfileClient is the external client used for IAMInstanceProfile
+// Custom Resource
+type InstanceProfileClient interface {
+	GetInstanceProfile(ctx context.Context, input *iam.GetInstanceProfileInput, opts ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error)
+	CreateInstanceProfile(ctx context.Context, input *iam.CreateInstanceProfileInput, opts ...func(*iam.Options)) (*iam.CreateInstanceProfileOutput, error)
+	DeleteInstanceProfile(ctx context.Context, input *iam.DeleteInstanceProfileInput, opts ...func(*iam.Options)) (*iam.DeleteInstanceProfileOutput, error)
+	AddRoleToInstanceProfile(ctx context.Context, input *iam.AddRoleToInstanceProfileInput, opts ...func(*iam.Options)) (*iam.AddRoleToInstanceProfileOutput, error)
+	RemoveRoleFromInstanceProfile(ctx context.Context, input *iam.RemoveRoleFromInstanceProfileInput, opts ...func(*iam.Options)) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+	TagInstanceProfile(ctx context.Context, input *iam.TagInstanceProfileInput, opts ...func(*iam.Options)) (*iam.TagInstanceProfileOutput, error)
+	UntagInstanceProfile(ctx context.Context, input *iam.UntagInstanceProfileInput, opts ...func(*iam.Options)) (*iam.UntagInstanceProfileOutput, error)
+}
+
+// NewInstanceProfileClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewInstanceProfileClient(cfg aws.Config) InstanceProfileClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// GenerateCreateInstanceProfileInput from IAMInstanceProfileParameters
+func GenerateCreateInstanceProfileInput(name string, p *v1beta1.IAMInstanceProfileParameters) *iam.CreateInstanceProfileInput {
+	m := &iam.CreateInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		Path:                p.Path,
+	}
+	if len(p.Tags) != 0 {
+		m.Tags = make([]iamtypes.Tag, len(p.Tags))
+		for i := range p.Tags {
+			m.Tags[i] = iamtypes.Tag{
+				Key:   aws.String(p.Tags[i].Key),
+				Value: aws.String(p.Tags[i].Value),
+			}
+		}
+	}
+	return m
+}
+
+// GenerateInstanceProfileObservation is used to produce
+// IAMInstanceProfileObservation from iamtypes.InstanceProfile
+func GenerateInstanceProfileObservation(p iamtypes.InstanceProfile) v1beta1.IAMInstanceProfileObservation {
+	return v1beta1.IAMInstanceProfileObservation{
+		ARN:               aws.ToString(p.Arn),
+		InstanceProfileID: aws.ToString(p.InstanceProfileId),
+	}
+}
+
+// InstanceProfileRoleName returns the name of the role in the instance
+// profile, or an empty string if it doesn't have one.
+func InstanceProfileRoleName(p iamtypes.InstanceProfile) string {
+	if len(p.Roles) == 0 {
+		return ""
+	}
+	return aws.ToString(p.Roles[0].RoleName)
+}
+
+// LateInitializeInstanceProfile fills the empty fields in
+// *v1beta1.IAMInstanceProfileParameters with the values seen in
+// iamtypes.InstanceProfile.
+func LateInitializeInstanceProfile(in *v1beta1.IAMInstanceProfileParameters, p *iamtypes.InstanceProfile) {
+	if p == nil {
+		return
+	}
+	in.Path = awsclients.LateInitializeStringPtr(in.Path, p.Path)
+	if name := InstanceProfileRoleName(*p); name != "" {
+		in.RoleName = awsclients.LateInitializeStringPtr(in.RoleName, aws.String(name))
+	}
+	if in.Tags == nil && p.Tags != nil {
+		for _, tag := range p.Tags {
+			in.Tags = append(in.Tags, v1beta1.Tag{Key: aws.ToString(tag.Key), Value: aws.ToString(tag.Value)})
+		}
+	}
+}
+
+// IsInstanceProfileUpToDate checks whether the role and the tags of the
+// instance profile are up to date.
+func IsInstanceProfileUpToDate(in v1beta1.IAMInstanceProfileParameters, p iamtypes.InstanceProfile) bool {
+	if aws.ToString(in.RoleName) != InstanceProfileRoleName(p) {
+		return false
+	}
+	tags := make(map[string]string, len(in.Tags))
+	for _, t := range in.Tags {
+		tags[t.Key] = t.Value
+	}
+	_, _, upToDate := DiffIAMTags(tags, p.Tags)
+	return upToDate
+}
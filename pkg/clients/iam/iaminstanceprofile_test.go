@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestLateInitializeInstanceProfile(t *testing.T) {
+	type args struct {
+		spec    *v1beta1.IAMInstanceProfileParameters
+		profile *iamtypes.InstanceProfile
+	}
+	cases := map[string]struct {
+		args args
+		want *v1beta1.IAMInstanceProfileParameters
+	}{
+		"AllFilled": {
+			args: args{
+				spec: &v1beta1.IAMInstanceProfileParameters{
+					Path:     aws.String("/"),
+					RoleName: aws.String("role"),
+					Tags:     []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				profile: &iamtypes.InstanceProfile{
+					Path:  aws.String("/other/"),
+					Roles: []iamtypes.Role{{RoleName: aws.String("other")}},
+					Tags:  []iamtypes.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+				},
+			},
+			want: &v1beta1.IAMInstanceProfileParameters{
+				Path:     aws.String("/"),
+				RoleName: aws.String("role"),
+				Tags:     []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		"PartialFilled": {
+			args: args{
+				spec: &v1beta1.IAMInstanceProfileParameters{},
+				profile: &iamtypes.InstanceProfile{
+					Path:  aws.String("/"),
+					Roles: []iamtypes.Role{{RoleName: aws.String("role")}},
+					Tags:  []iamtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+			},
+			want: &v1beta1.IAMInstanceProfileParameters{
+				Path:     aws.String("/"),
+				RoleName: aws.String("role"),
+				Tags:     []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		"NoRole": {
+			args: args{
+				spec:    &v1beta1.IAMInstanceProfileParameters{},
+				profile: &iamtypes.InstanceProfile{},
+			},
+			want: &v1beta1.IAMInstanceProfileParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeInstanceProfile(tc.args.spec, tc.args.profile)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceProfileUpToDate(t *testing.T) {
+	type args struct {
+		spec    v1beta1.IAMInstanceProfileParameters
+		profile iamtypes.InstanceProfile
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				spec: v1beta1.IAMInstanceProfileParameters{
+					RoleName: aws.String("role"),
+					Tags:     []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				profile: iamtypes.InstanceProfile{
+					Roles: []iamtypes.Role{{RoleName: aws.String("role")}},
+					Tags:  []iamtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+			},
+			want: true,
+		},
+		"DifferentRole": {
+			args: args{
+				spec: v1beta1.IAMInstanceProfileParameters{
+					RoleName: aws.String("role"),
+				},
+				profile: iamtypes.InstanceProfile{
+					Roles: []iamtypes.Role{{RoleName: aws.String("other")}},
+				},
+			},
+			want: false,
+		},
+		"MissingRole": {
+			args: args{
+				spec: v1beta1.IAMInstanceProfileParameters{
+					RoleName: aws.String("role"),
+				},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{
+				spec: v1beta1.IAMInstanceProfileParameters{
+					Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				profile: iamtypes.InstanceProfile{
+					Tags: []iamtypes.Tag{{Key: aws.String("k"), Value: aws.String("other")}},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceProfileUpToDate(tc.args.spec, tc.args.profile)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,18 +2,22 @@ package iam
 
 import (
 	"context"
-	"net/url"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
-	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
+// maxPolicyVersions is the maximum number of versions a managed policy can
+// have in IAM.
+const maxPolicyVersions = 5
+
 // PolicyClient is the external client used for IAMPolicy Custom Resource
 type PolicyClient interface {
 	GetPolicy(ctx context.Context, input *iam.GetPolicyInput, opts ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
@@ -25,6 +29,7 @@ type PolicyClient interface {
 	DeletePolicyVersion(ctx context.Context, input *iam.DeletePolicyVersionInput, opts ...func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
 	TagPolicy(ctx context.Context, input *iam.TagPolicyInput, opts ...func(*iam.Options)) (*iam.TagPolicyOutput, error)
 	UntagPolicy(ctx context.Context, input *iam.UntagPolicyInput, opts ...func(*iam.Options)) (*iam.UntagPolicyOutput, error)
+	SetDefaultPolicyVersion(ctx context.Context, input *iam.SetDefaultPolicyVersionInput, opts ...func(*iam.Options)) (*iam.SetDefaultPolicyVersionOutput, error)
 }
 
 // STSClient is the external client used for STS
@@ -42,30 +47,88 @@ func NewSTSClient(cfg aws.Config) STSClient {
 	return sts.NewFromConfig(cfg)
 }
 
-// IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
-func IsPolicyUpToDate(in v1alpha1.IAMPolicyParameters, policy iamtypes.PolicyVersion) (bool, error) {
-	// The AWS API returns Policy Document as an escaped string.
-	// Due to differences in the methods to escape a string, the comparison result between
-	// the spec.Document and policy.Document can sometimes be false negative (due to spaces, line feeds).
-	// Escaping with a common method and then comparing is a safe way.
+// IAMPolicyDocument returns the JSON document of the policy, which is
+// generated from the structured policy if it is given.
+func IAMPolicyDocument(p v1alpha1.IAMPolicyParameters) string {
+	if p.PolicyDocument != nil {
+		return awspolicy.Marshal(p.PolicyDocument)
+	}
+	return p.Document
+}
 
-	if *policy.Document == "" || in.Document == "" {
-		return false, nil
+// RetainedPolicyVersions returns the maximum number of versions of the policy
+// that should be kept.
+func RetainedPolicyVersions(p v1alpha1.IAMPolicyParameters) int {
+	if p.RetainVersions == nil || *p.RetainVersions <= 0 || *p.RetainVersions > maxPolicyVersions {
+		return maxPolicyVersions
 	}
+	return int(*p.RetainVersions)
+}
 
-	unescapedPolicy, err := url.QueryUnescape(aws.ToString(policy.Document))
-	if err != nil {
-		return false, nil
+// GenerateIAMPolicyVersions returns the observation of the given policy
+// versions, newest first.
+func GenerateIAMPolicyVersions(versions []iamtypes.PolicyVersion) []v1alpha1.IAMPolicyVersion {
+	if len(versions) == 0 {
+		return nil
+	}
+	sorted := sortedPolicyVersions(versions)
+	out := make([]v1alpha1.IAMPolicyVersion, len(sorted))
+	for i, v := range sorted {
+		out[i] = v1alpha1.IAMPolicyVersion{
+			VersionID:        aws.ToString(v.VersionId),
+			IsDefaultVersion: v.IsDefaultVersion,
+		}
+		if v.CreateDate != nil {
+			t := metav1.NewTime(*v.CreateDate)
+			out[i].CreateDate = &t
+		}
 	}
+	return out
+}
 
-	compactIAMPolicy, err := awsclients.CompactAndEscapeJSON(unescapedPolicy)
-	if err != nil {
-		return false, err
+// PolicyVersionsToPrune returns the identifiers of the oldest non-default
+// versions that need to be deleted so that at most retain versions are left.
+// The default version is never pruned.
+func PolicyVersionsToPrune(versions []iamtypes.PolicyVersion, retain int) []string {
+	var ids []string
+	kept := 0
+	for _, v := range sortedPolicyVersions(versions) {
+		if v.IsDefaultVersion {
+			continue
+		}
+		// The default version always takes one of the places.
+		if kept+1 < retain {
+			kept++
+			continue
+		}
+		ids = append(ids, aws.ToString(v.VersionId))
 	}
-	compactSpecPolicy, err := awsclients.CompactAndEscapeJSON(in.Document)
-	if err != nil {
-		return false, err
+	return ids
+}
+
+// sortedPolicyVersions returns a copy of the versions sorted from the newest
+// to the oldest.
+func sortedPolicyVersions(versions []iamtypes.PolicyVersion) []iamtypes.PolicyVersion {
+	sorted := make([]iamtypes.PolicyVersion, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].CreateDate, sorted[j].CreateDate
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.After(*b)
+	})
+	return sorted
+}
+
+// IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
+func IsPolicyUpToDate(in v1alpha1.IAMPolicyParameters, policy iamtypes.PolicyVersion) (bool, error) {
+	// The AWS API returns Policy Document as an escaped string and the
+	// documents are compared semantically since IAM may reformat them.
+	doc := IAMPolicyDocument(in)
+	if aws.ToString(policy.Document) == "" || doc == "" {
+		return false, nil
 	}
 
-	return cmp.Equal(compactIAMPolicy, compactSpecPolicy), nil
+	return awspolicy.AreEquivalent(aws.ToString(policy.Document), doc), nil
 }
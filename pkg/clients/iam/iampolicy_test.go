@@ -2,11 +2,14 @@ package iam
 
 import (
 	"testing"
+	"time"
 
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 var (
@@ -35,8 +38,15 @@ var (
 		  }
 		]
 	   }`
+
+	versionTime = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
 )
 
+func policyVersion(id string, isDefault bool, age int) iamtypes.PolicyVersion {
+	t := versionTime.Add(-time.Duration(age) * time.Hour)
+	return iamtypes.PolicyVersion{VersionId: &id, IsDefaultVersion: isDefault, CreateDate: &t}
+}
+
 func TestIsPolicyUpToDate(t *testing.T) {
 	type args struct {
 		p       v1alpha1.IAMPolicyParameters
@@ -69,6 +79,25 @@ func TestIsPolicyUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"StructuredPolicy": {
+			args: args{
+				p: v1alpha1.IAMPolicyParameters{
+					Document: document2,
+					PolicyDocument: &commonv1alpha1.PolicyDocument{
+						Version: "2012-10-17",
+						Statements: []commonv1alpha1.PolicyStatement{{
+							Effect:    "Allow",
+							Principal: &commonv1alpha1.PolicyPrincipal{Service: []string{"eks.amazonaws.com"}},
+							Action:    []string{"sts:AssumeRole"},
+						}},
+					},
+				},
+				version: iamtypes.PolicyVersion{
+					Document: &document1,
+				},
+			},
+			want: true,
+		},
 		"EmptyPolicy": {
 			args: args{
 				p: v1alpha1.IAMPolicyParameters{},
@@ -89,3 +118,80 @@ func TestIsPolicyUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyVersionsToPrune(t *testing.T) {
+	type args struct {
+		versions []iamtypes.PolicyVersion
+		retain   int
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"NothingToPrune": {
+			args: args{
+				versions: []iamtypes.PolicyVersion{
+					policyVersion("v2", true, 0),
+					policyVersion("v1", false, 1),
+				},
+				retain: 2,
+			},
+		},
+		"PruneOldest": {
+			args: args{
+				versions: []iamtypes.PolicyVersion{
+					policyVersion("v1", false, 3),
+					policyVersion("v4", false, 0),
+					policyVersion("v3", false, 1),
+					policyVersion("v2", true, 2),
+				},
+				retain: 2,
+			},
+			want: []string{"v3", "v1"},
+		},
+		"KeepOnlyDefault": {
+			args: args{
+				versions: []iamtypes.PolicyVersion{
+					policyVersion("v2", false, 0),
+					policyVersion("v1", true, 1),
+				},
+				retain: 1,
+			},
+			want: []string{"v2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PolicyVersionsToPrune(tc.args.versions, tc.args.retain)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetainedPolicyVersions(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.IAMPolicyParameters
+		want int
+	}{
+		"Default": {
+			p:    v1alpha1.IAMPolicyParameters{},
+			want: maxPolicyVersions,
+		},
+		"Given": {
+			p:    v1alpha1.IAMPolicyParameters{RetainVersions: aws.Int32(2)},
+			want: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, RetainedPolicyVersions(tc.p)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/url"
+	"sort"
 
 	"github.com/aws/smithy-go/document"
 
@@ -19,6 +20,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
@@ -36,6 +38,10 @@ type RoleClient interface {
 	UpdateAssumeRolePolicy(ctx context.Context, input *iam.UpdateAssumeRolePolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
 	TagRole(ctx context.Context, input *iam.TagRoleInput, opts ...func(*iam.Options)) (*iam.TagRoleOutput, error)
 	UntagRole(ctx context.Context, input *iam.UntagRoleInput, opts ...func(*iam.Options)) (*iam.UntagRoleOutput, error)
+	ListRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, opts ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	PutRolePolicy(ctx context.Context, input *iam.PutRolePolicyInput, opts ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(ctx context.Context, input *iam.DeleteRolePolicyInput, opts ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
 }
 
 // NewRoleClient returns a new client using AWS credentials as JSON encoded data.
@@ -47,7 +53,7 @@ func NewRoleClient(conf aws.Config) RoleClient {
 func GenerateCreateRoleInput(name string, p *v1beta1.IAMRoleParameters) *iam.CreateRoleInput {
 	m := &iam.CreateRoleInput{
 		RoleName:                 aws.String(name),
-		AssumeRolePolicyDocument: aws.String(p.AssumeRolePolicyDocument),
+		AssumeRolePolicyDocument: aws.String(AssumeRolePolicyDocument(*p)),
 		Description:              p.Description,
 		MaxSessionDuration:       p.MaxSessionDuration,
 		Path:                     p.Path,
@@ -67,6 +73,48 @@ func GenerateCreateRoleInput(name string, p *v1beta1.IAMRoleParameters) *iam.Cre
 	return m
 }
 
+// AssumeRolePolicyDocument returns the JSON trust policy of the role, which is
+// generated from the structured policy if it is given.
+func AssumeRolePolicyDocument(p v1beta1.IAMRoleParameters) string {
+	if p.TrustPolicyDocument != nil {
+		return awspolicy.Marshal(p.TrustPolicyDocument)
+	}
+	return p.AssumeRolePolicyDocument
+}
+
+// InlinePolicyDocument returns the JSON document of the inline policy, which
+// is generated from the structured policy if it is given.
+func InlinePolicyDocument(p v1beta1.InlinePolicy) string {
+	if p.PolicyDocument != nil {
+		return awspolicy.Marshal(p.PolicyDocument)
+	}
+	return aws.ToString(p.Document)
+}
+
+// DiffInlinePolicies returns the documents of the inline policies that need
+// to be put, keyed by their names, and the names of the inline policies that
+// need to be deleted so that the current inline policies match the desired
+// ones.
+func DiffInlinePolicies(desired []v1beta1.InlinePolicy, current map[string]string) (put map[string]string, remove []string) {
+	put = map[string]string{}
+	names := make(map[string]struct{}, len(desired))
+	for _, p := range desired {
+		names[p.Name] = struct{}{}
+		doc := InlinePolicyDocument(p)
+		if c, ok := current[p.Name]; ok && awspolicy.AreEquivalent(doc, c) {
+			continue
+		}
+		put[p.Name] = doc
+	}
+	for name := range current {
+		if _, ok := names[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(remove)
+	return put, remove
+}
+
 // GenerateRoleObservation is used to produce IAMRoleExternalStatus from iamtypes.Role
 func GenerateRoleObservation(role iamtypes.Role) v1beta1.IAMRoleExternalStatus {
 	return v1beta1.IAMRoleExternalStatus{
@@ -78,8 +126,8 @@ func GenerateRoleObservation(role iamtypes.Role) v1beta1.IAMRoleExternalStatus {
 // GenerateIAMRole assigns the in IAMRoleParamters to role.
 func GenerateIAMRole(in v1beta1.IAMRoleParameters, role *iamtypes.Role) error {
 
-	if in.AssumeRolePolicyDocument != "" {
-		s, err := awsclients.CompactAndEscapeJSON(in.AssumeRolePolicyDocument)
+	if doc := AssumeRolePolicyDocument(in); doc != "" {
+		s, err := awsclients.CompactAndEscapeJSON(doc)
 		if err != nil {
 			return errors.Wrap(err, errPolicyJSONEscape)
 		}
@@ -108,7 +156,9 @@ func LateInitializeRole(in *v1beta1.IAMRoleParameters, role *iamtypes.Role) {
 	if role == nil {
 		return
 	}
-	in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	if in.TrustPolicyDocument == nil {
+		in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	}
 	in.Description = awsclients.LateInitializeStringPtr(in.Description, role.Description)
 	in.MaxSessionDuration = awsclients.LateInitializeInt32Ptr(in.MaxSessionDuration, role.MaxSessionDuration)
 	in.Path = awsclients.LateInitializeStringPtr(in.Path, role.Path)
@@ -157,7 +207,13 @@ func isAssumeRolePolicyUpToDate(a, b *string) (bool, error) {
 		return false, errors.Wrap(err, errPolicyJSONUnescape)
 	}
 
-	return awsclients.IsPolicyUpToDate(&jsonA, &jsonB), nil
+	return awspolicy.AreEquivalent(jsonA, jsonB), nil
+}
+
+// IsAssumeRolePolicyUpToDate checks whether the trust policy of the observed
+// role is equivalent to the desired one.
+func IsAssumeRolePolicyUpToDate(in v1beta1.IAMRoleParameters, observed iamtypes.Role) (bool, error) {
+	return isAssumeRolePolicyUpToDate(awsclients.String(AssumeRolePolicyDocument(in)), observed.AssumeRolePolicyDocument)
 }
 
 // IsRoleUpToDate checks whether there is a change in any of the modifiable fields in role.
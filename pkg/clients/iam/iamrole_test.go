@@ -13,6 +13,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -51,6 +52,16 @@ var (
 	roleName = "some name"
 	tagKey   = "key"
 	tagValue = "value"
+
+	assumeRolePolicy = &commonv1alpha1.PolicyDocument{
+		Version: "2012-10-17",
+		Statements: []commonv1alpha1.PolicyStatement{{
+			Effect:    "Allow",
+			Principal: &commonv1alpha1.PolicyPrincipal{Service: []string{"eks.amazonaws.com"}},
+			Action:    []string{"sts:AssumeRole"},
+		}},
+	}
+	assumeRolePolicyJSON = `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"eks.amazonaws.com"}}],"Version":"2012-10-17"}`
 )
 
 func roleParams(m ...func(*v1beta1.IAMRoleParameters)) *v1beta1.IAMRoleParameters {
@@ -121,6 +132,17 @@ func TestGenerateCreateRoleInput(t *testing.T) {
 				MaxSessionDuration:       aws.Int32(1),
 			},
 		},
+		"StructuredAssumeRolePolicy": {
+			in: *roleParams(func(p *v1beta1.IAMRoleParameters) {
+				p.TrustPolicyDocument = assumeRolePolicy
+			}),
+			out: iam.CreateRoleInput{
+				RoleName:                 aws.String(roleName),
+				Description:              &description,
+				AssumeRolePolicyDocument: aws.String(assumeRolePolicyJSON),
+				MaxSessionDuration:       aws.Int32(1),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -445,3 +467,85 @@ func TestDiffIAMTags(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffInlinePolicies(t *testing.T) {
+	type args struct {
+		desired []v1beta1.InlinePolicy
+		current map[string]string
+	}
+	type want struct {
+		put    map[string]string
+		remove []string
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"New": {
+			args: args{
+				desired: []v1beta1.InlinePolicy{{Name: "p", Document: aws.String(assumeRolePolicyDocument)}},
+			},
+			want: want{
+				put: map[string]string{"p": assumeRolePolicyDocument},
+			},
+		},
+		"EquivalentEscaped": {
+			args: args{
+				desired: []v1beta1.InlinePolicy{{Name: "p", Document: aws.String(assumeRolePolicyDocument)}},
+				current: map[string]string{"p": aws.StringValue(escapedPolicyJSON())},
+			},
+			want: want{
+				put: map[string]string{},
+			},
+		},
+		"Changed": {
+			args: args{
+				desired: []v1beta1.InlinePolicy{{Name: "p", Document: aws.String(assumeRolePolicyDocument2)}},
+				current: map[string]string{"p": assumeRolePolicyDocument},
+			},
+			want: want{
+				put: map[string]string{"p": assumeRolePolicyDocument2},
+			},
+		},
+		"StructuredEquivalent": {
+			args: args{
+				desired: []v1beta1.InlinePolicy{{Name: "p", PolicyDocument: assumeRolePolicy}},
+				current: map[string]string{"p": assumeRolePolicyDocument},
+			},
+			want: want{
+				put: map[string]string{},
+			},
+		},
+		"StructuredChanged": {
+			args: args{
+				desired: []v1beta1.InlinePolicy{{Name: "p", PolicyDocument: assumeRolePolicy}},
+				current: map[string]string{"p": assumeRolePolicyDocument2},
+			},
+			want: want{
+				put: map[string]string{"p": assumeRolePolicyJSON},
+			},
+		},
+		"Removed": {
+			args: args{
+				desired: []v1beta1.InlinePolicy{{Name: "p", Document: aws.String(assumeRolePolicyDocument)}},
+				current: map[string]string{"p": assumeRolePolicyDocument, "b": assumeRolePolicyDocument, "a": assumeRolePolicyDocument},
+			},
+			want: want{
+				put:    map[string]string{},
+				remove: []string{"a", "b"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, remove := DiffInlinePolicies(tc.args.desired, tc.args.current)
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
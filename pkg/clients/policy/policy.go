@@ -0,0 +1,302 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/common/v1alpha1"
+)
+
+// accountRootARN matches the ARN of the root user of an account, which IAM
+// returns in place of the account IDs given as principals.
+var accountRootARN = regexp.MustCompile(`^arn:[a-z-]+:iam::(\d{12}):root$`)
+
+// Marshal returns the JSON of the given structured policy document. The
+// references in the document are expected to be resolved.
+func Marshal(doc *v1alpha1.PolicyDocument) string {
+	m := map[string]interface{}{"Version": doc.Version}
+	if doc.ID != nil {
+		m["Id"] = aws.ToString(doc.ID)
+	}
+	statements := make([]interface{}, len(doc.Statements))
+	for i, s := range doc.Statements {
+		statements[i] = marshalStatement(s)
+	}
+	m["Statement"] = statements
+	// Marshalling a map of strings and slices cannot fail.
+	b, _ := json.Marshal(m)
+	return string(b)
+}
+
+func marshalStatement(s v1alpha1.PolicyStatement) map[string]interface{} { // nolint:gocyclo
+	m := map[string]interface{}{"Effect": s.Effect}
+	if s.SID != nil {
+		m["Sid"] = aws.ToString(s.SID)
+	}
+	if s.Principal != nil {
+		m["Principal"] = marshalPrincipal(*s.Principal)
+	}
+	if s.NotPrincipal != nil {
+		m["NotPrincipal"] = marshalPrincipal(*s.NotPrincipal)
+	}
+	if len(s.Action) != 0 {
+		m["Action"] = oneOrMany(s.Action)
+	}
+	if len(s.NotAction) != 0 {
+		m["NotAction"] = oneOrMany(s.NotAction)
+	}
+	resources := append([]string{}, s.Resource...)
+	for _, ref := range s.ResourceRefs {
+		if ref.ARN != nil {
+			resources = append(resources, aws.ToString(ref.ARN)+ref.Suffix)
+		}
+	}
+	if len(resources) != 0 {
+		m["Resource"] = oneOrMany(resources)
+	}
+	if len(s.NotResource) != 0 {
+		m["NotResource"] = oneOrMany(s.NotResource)
+	}
+	if len(s.Condition) != 0 {
+		conditions := map[string]map[string]interface{}{}
+		for _, c := range s.Condition {
+			if conditions[c.OperatorKey] == nil {
+				conditions[c.OperatorKey] = map[string]interface{}{}
+			}
+			for _, p := range c.Conditions {
+				conditions[c.OperatorKey][p.Key] = oneOrMany(p.Values)
+			}
+		}
+		m["Condition"] = conditions
+	}
+	return m
+}
+
+func marshalPrincipal(p v1alpha1.PolicyPrincipal) interface{} {
+	if p.AllowAnon {
+		return "*"
+	}
+	m := map[string]interface{}{}
+	var ids []string
+	for _, a := range p.AWSPrincipals {
+		switch {
+		case a.AWSAccountID != nil:
+			ids = append(ids, *a.AWSAccountID)
+		case a.IAMRoleARN != nil:
+			ids = append(ids, *a.IAMRoleARN)
+		case a.IAMUserARN != nil:
+			ids = append(ids, *a.IAMUserARN)
+		}
+	}
+	if len(ids) != 0 {
+		m["AWS"] = oneOrMany(ids)
+	}
+	if len(p.Federated) != 0 {
+		m["Federated"] = oneOrMany(p.Federated)
+	}
+	if len(p.Service) != 0 {
+		m["Service"] = oneOrMany(p.Service)
+	}
+	return m
+}
+
+func oneOrMany(l []string) interface{} {
+	if len(l) == 1 {
+		return l[0]
+	}
+	return l
+}
+
+// AreEquivalent returns whether the given JSON policies grant the same
+// permissions. Single values and lists with one element, the forms of the
+// principals, the order of the values and of the statements do not count as
+// differences. URL encoded policies, as returned by IAM, are decoded first.
+// It returns false if any of the policies is malformed.
+func AreEquivalent(a, b string) bool {
+	na, err := normalize(a)
+	if err != nil {
+		return false
+	}
+	nb, err := normalize(b)
+	if err != nil {
+		return false
+	}
+	return cmp.Equal(na, nb)
+}
+
+func normalize(raw string) (map[string]interface{}, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(raw, "{") {
+		u, err := url.QueryUnescape(raw)
+		if err != nil {
+			return nil, err
+		}
+		raw = u
+	}
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		return nil, err
+	}
+	s, ok := doc["Statement"]
+	if !ok {
+		return doc, nil
+	}
+	statements, ok := s.([]interface{})
+	if !ok {
+		statements = []interface{}{s}
+	}
+	normalized := make([]interface{}, len(statements))
+	keys := make([]string, len(statements))
+	for i, st := range statements {
+		normalized[i] = normalizeStatement(st)
+		// Marshalling decoded JSON cannot fail.
+		b, _ := json.Marshal(normalized[i])
+		keys[i] = string(b)
+	}
+	// The order of the statements doesn't matter, so they're sorted by their
+	// normalized JSON.
+	sort.Sort(byKey{keys: keys, statements: normalized})
+	doc["Statement"] = normalized
+	return doc, nil
+}
+
+type byKey struct {
+	keys       []string
+	statements []interface{}
+}
+
+func (b byKey) Len() int           { return len(b.keys) }
+func (b byKey) Less(i, j int) bool { return b.keys[i] < b.keys[j] }
+func (b byKey) Swap(i, j int) {
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+	b.statements[i], b.statements[j] = b.statements[j], b.statements[i]
+}
+
+func normalizeStatement(v interface{}) interface{} {
+	st, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	out := make(map[string]interface{}, len(st))
+	for k, val := range st {
+		switch k {
+		case "Action", "NotAction", "Resource", "NotResource":
+			out[k] = stringSet(val, nil)
+		case "Principal", "NotPrincipal":
+			out[k] = normalizePrincipal(val)
+		case "Condition":
+			out[k] = normalizeCondition(val)
+		default:
+			out[k] = val
+		}
+	}
+	return out
+}
+
+func normalizePrincipal(v interface{}) interface{} {
+	if s, ok := v.(string); ok && s == "*" {
+		return map[string]interface{}{"AWS": []string{"*"}}
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return stringSet(v, nil)
+	}
+	out := make(map[string]interface{}, len(m))
+	for k, val := range m {
+		if k == "AWS" {
+			out[k] = stringSet(val, func(s string) string {
+				if match := accountRootARN.FindStringSubmatch(s); match != nil {
+					return match[1]
+				}
+				return s
+			})
+			continue
+		}
+		out[k] = stringSet(val, nil)
+	}
+	return out
+}
+
+func normalizeCondition(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	out := make(map[string]interface{}, len(m))
+	for op, keys := range m {
+		km, ok := keys.(map[string]interface{})
+		if !ok {
+			out[op] = keys
+			continue
+		}
+		nk := make(map[string]interface{}, len(km))
+		for k, val := range km {
+			nk[k] = stringSet(val, nil)
+		}
+		out[op] = nk
+	}
+	return out
+}
+
+// stringSet returns the given single value or list as a sorted list of unique
+// strings. Booleans and numbers are converted to strings since the policy
+// language accepts both forms.
+func stringSet(v interface{}, fn func(string) string) []string {
+	var l []interface{}
+	switch val := v.(type) {
+	case []interface{}:
+		l = val
+	default:
+		l = []interface{}{val}
+	}
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(l))
+	for _, e := range l {
+		var s string
+		switch val := e.(type) {
+		case string:
+			s = val
+		case bool:
+			s = strconv.FormatBool(val)
+		case float64:
+			s = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			// Marshalling decoded JSON cannot fail.
+			b, _ := json.Marshal(val)
+			s = string(b)
+		}
+		if fn != nil {
+			s = fn(s)
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/common/v1alpha1"
+)
+
+func TestMarshal(t *testing.T) {
+	cases := map[string]struct {
+		doc  *v1alpha1.PolicyDocument
+		want string
+	}{
+		"SingleValues": {
+			doc: &v1alpha1.PolicyDocument{
+				Version: "2012-10-17",
+				Statements: []v1alpha1.PolicyStatement{{
+					Effect:    "Allow",
+					Principal: &v1alpha1.PolicyPrincipal{Service: []string{"ec2.amazonaws.com"}},
+					Action:    []string{"sts:AssumeRole"},
+				}},
+			},
+			want: `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"}}],"Version":"2012-10-17"}`,
+		},
+		"Full": {
+			doc: &v1alpha1.PolicyDocument{
+				Version: "2012-10-17",
+				ID:      aws.String("id"),
+				Statements: []v1alpha1.PolicyStatement{{
+					SID:    aws.String("s1"),
+					Effect: "Deny",
+					Principal: &v1alpha1.PolicyPrincipal{AWSPrincipals: []v1alpha1.AWSPrincipal{
+						{AWSAccountID: aws.String("123456789012")},
+						{IAMRoleARN: aws.String("arn:aws:iam::123456789012:role/r")},
+					}},
+					Action:   []string{"s3:GetObject", "s3:PutObject"},
+					Resource: []string{"arn:aws:s3:::a"},
+					ResourceRefs: []v1alpha1.PolicyResourceReference{
+						{ARN: aws.String("arn:aws:s3:::b"), Suffix: "/*"},
+					},
+					Condition: []v1alpha1.PolicyCondition{{
+						OperatorKey: "Bool",
+						Conditions:  []v1alpha1.PolicyConditionPair{{Key: "aws:SecureTransport", Values: []string{"false"}}},
+					}},
+				}, {
+					Effect:    "Allow",
+					Principal: &v1alpha1.PolicyPrincipal{AllowAnon: true},
+					NotAction: []string{"s3:*"},
+				}},
+			},
+			want: `{"Id":"id","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Condition":{"Bool":{"aws:SecureTransport":"false"}},"Effect":"Deny","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:role/r"]},"Resource":["arn:aws:s3:::a","arn:aws:s3:::b/*"],"Sid":"s1"},{"Effect":"Allow","NotAction":"s3:*","Principal":"*"}],"Version":"2012-10-17"}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Marshal(tc.doc)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreEquivalent(t *testing.T) {
+	cases := map[string]struct {
+		a    string
+		b    string
+		want bool
+	}{
+		"SingleAndListValues": {
+			a:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::a/*"]}]}`,
+			want: true,
+		},
+		"ValueOrder": {
+			a:    `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"]}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"]}]}`,
+			want: true,
+		},
+		"StatementOrder": {
+			a:    `{"Statement":[{"Effect":"Allow","Action":"a"},{"Effect":"Deny","Action":"b"}]}`,
+			b:    `{"Statement":[{"Effect":"Deny","Action":"b"},{"Effect":"Allow","Action":"a"}]}`,
+			want: true,
+		},
+		"AnonymousPrincipal": {
+			a:    `{"Statement":[{"Effect":"Allow","Principal":"*"}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"*"}}]}`,
+			want: true,
+		},
+		"AccountRootPrincipal": {
+			a:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012"]}}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"}}]}`,
+			want: true,
+		},
+		"ConditionValues": {
+			a:    `{"Statement":[{"Effect":"Allow","Condition":{"Bool":{"aws:SecureTransport":true},"NumericLessThan":{"s3:max-keys":10}}}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Condition":{"NumericLessThan":{"s3:max-keys":["10"]},"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			want: true,
+		},
+		"URLEncoded": {
+			a:    `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"}]}`,
+			b:    `%7B%22Statement%22%3A%5B%7B%22Effect%22%3A%22Allow%22%2C%22Action%22%3A%22sts%3AAssumeRole%22%7D%5D%7D`,
+			want: true,
+		},
+		"KeyOrder": {
+			a:    `{"testone": "one", "testtwo": "two"}`,
+			b:    `{"testtwo": "two", "testone": "one"}`,
+			want: true,
+		},
+		"StatementKeyOrder": {
+			a:    `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":"*"}],"Version":"2012-10-17"}`,
+			b:    `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":"*"}],"Version":"2012-10-17"}`,
+			want: true,
+		},
+		"PrincipalOrder": {
+			a:    `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:userARN","111122223334","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`,
+			b:    `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["111122223334","arn:aws:iam::111122223333:userARN","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`,
+			want: true,
+		},
+		"NumericPrincipals": {
+			// The sorting of the values must not panic with unexpected
+			// value types.
+			a:    `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"foo","bar"]}],"Version":"2012-10-17"}`,
+			b:    `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"bar","foo"]}],"Version":"2012-10-17"}`,
+			want: true,
+		},
+		"DifferentFields": {
+			a:    `{"testone": "one", "testtwo": "two"}`,
+			b:    `{"testthree": "three", "testone": "one"}`,
+			want: false,
+		},
+		"DifferentActions": {
+			a:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Action":"s3:PutObject"}]}`,
+			want: false,
+		},
+		"DifferentConditionValues": {
+			a:    `{"Statement":[{"Effect":"Allow","Condition":{"StringEquals":{"aws:SourceAccount":"1"}}}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Condition":{"StringEquals":{"aws:SourceAccount":"2"}}}]}`,
+			want: false,
+		},
+		"Malformed": {
+			a:    `{"Statement":`,
+			b:    `{"Statement":`,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AreEquivalent(tc.a, tc.b)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/common/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+const (
+	errResolveReferences = "cannot resolve references"
+	errUpdateManaged     = "cannot update managed resource"
+)
+
+// DocumentsFn returns the structured policy documents of a managed resource.
+type DocumentsFn func(mg resource.Managed) []*v1alpha1.PolicyDocument
+
+// NewReferenceResolver returns a managed.ReferenceResolver that resolves the
+// references in the policy documents returned by fn in addition to the ones
+// that are resolved by the ResolveReferences method of the managed resource.
+// The policy documents are resolved here rather than in the API packages
+// because IAM resources cannot import the API packages that import them.
+func NewReferenceResolver(c client.Client, fn DocumentsFn) managed.ReferenceResolver {
+	return managed.ReferenceResolverFn(func(ctx context.Context, mg resource.Managed) error {
+		existing := mg.DeepCopyObject()
+		if rr, ok := mg.(interface {
+			ResolveReferences(context.Context, client.Reader) error
+		}); ok {
+			if err := rr.ResolveReferences(ctx, c); err != nil {
+				return errors.Wrap(err, errResolveReferences)
+			}
+		}
+		r := reference.NewAPIResolver(c, mg)
+		for _, doc := range fn(mg) {
+			if err := ResolveReferences(ctx, r, doc); err != nil {
+				return errors.Wrap(err, errResolveReferences)
+			}
+		}
+		if cmp.Equal(existing, mg) {
+			return nil
+		}
+		return errors.Wrap(c.Update(ctx, mg), errUpdateManaged)
+	})
+}
+
+// ResolveReferences resolves the principal and resource references in the
+// given policy document.
+func ResolveReferences(ctx context.Context, r *reference.APIResolver, doc *v1alpha1.PolicyDocument) error {
+	if doc == nil {
+		return nil
+	}
+	for i := range doc.Statements {
+		s := &doc.Statements[i]
+		if err := resolvePrincipal(ctx, r, s.Principal, fmt.Sprintf("statements[%d].principal", i)); err != nil {
+			return err
+		}
+		if err := resolvePrincipal(ctx, r, s.NotPrincipal, fmt.Sprintf("statements[%d].notPrincipal", i)); err != nil {
+			return err
+		}
+		for j := range s.ResourceRefs {
+			if err := resolveResource(ctx, r, &s.ResourceRefs[j]); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("statements[%d].resourceRefs[%d]", i, j))
+			}
+		}
+	}
+	return nil
+}
+
+func resolvePrincipal(ctx context.Context, r *reference.APIResolver, p *v1alpha1.PolicyPrincipal, path string) error {
+	if p == nil {
+		return nil
+	}
+	for i := range p.AWSPrincipals {
+		a := &p.AWSPrincipals[i]
+		if a.IAMUserARNRef != nil || a.IAMUserARNSelector != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.IAMUserARN),
+				Reference:    a.IAMUserARNRef,
+				Selector:     a.IAMUserARNSelector,
+				To:           reference.To{Managed: &iamv1alpha1.IAMUser{}, List: &iamv1alpha1.IAMUserList{}},
+				Extract:      iamv1alpha1.IAMUserARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("%s.awsPrincipals[%d].iamUserArn", path, i))
+			}
+			a.IAMUserARN = reference.ToPtrValue(rsp.ResolvedValue)
+			a.IAMUserARNRef = rsp.ResolvedReference
+		}
+		if a.IAMRoleARNRef != nil || a.IAMRoleARNSelector != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.IAMRoleARN),
+				Reference:    a.IAMRoleARNRef,
+				Selector:     a.IAMRoleARNSelector,
+				To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+				Extract:      iamv1beta1.IAMRoleARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("%s.awsPrincipals[%d].iamRoleArn", path, i))
+			}
+			a.IAMRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+			a.IAMRoleARNRef = rsp.ResolvedReference
+		}
+	}
+	return nil
+}
+
+func resolveResource(ctx context.Context, r *reference.APIResolver, ref *v1alpha1.PolicyResourceReference) error {
+	req := reference.ResolutionRequest{CurrentValue: reference.FromPtrValue(ref.ARN)}
+	switch {
+	case ref.BucketRef != nil:
+		req.Reference = ref.BucketRef
+		req.To = reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}}
+		req.Extract = s3v1beta1.BucketARN()
+	case ref.KeyRef != nil:
+		req.Reference = ref.KeyRef
+		req.To = reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}}
+		req.Extract = keyARN()
+	case ref.RoleRef != nil:
+		req.Reference = ref.RoleRef
+		req.To = reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}}
+		req.Extract = iamv1beta1.IAMRoleARN()
+	default:
+		return nil
+	}
+	rsp, err := r.Resolve(ctx, req)
+	if err != nil {
+		return err
+	}
+	ref.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+	return nil
+}
+
+// keyARN returns the status.atProvider.arn of a KMS Key.
+func keyARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		k, ok := mg.(*kmsv1alpha1.Key)
+		if !ok || k.Status.AtProvider.ARN == nil {
+			return ""
+		}
+		return *k.Status.AtProvider.ARN
+	}
+}
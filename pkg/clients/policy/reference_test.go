@@ -0,0 +1,236 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/common/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+const (
+	iamUserARN  = "arn:aws:iam::123456789012:user/u"
+	iamRoleARN  = "arn:aws:iam::123456789012:role/r"
+	s3BucketARN = "arn:aws:s3:::b"
+	kmsKeyARN   = "arn:aws:kms:us-east-1:123456789012:key/k"
+)
+
+var errBoom = errors.New("boom")
+
+// mockGet fills the ARNs of the referenced resources.
+func mockGet(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	switch o := obj.(type) {
+	case *iamv1alpha1.IAMUser:
+		o.Status.AtProvider.ARN = iamUserARN
+	case *iamv1beta1.IAMRole:
+		o.Status.AtProvider.ARN = iamRoleARN
+	case *s3v1beta1.Bucket:
+		o.Status.AtProvider.ARN = s3BucketARN
+	case *kmsv1alpha1.Key:
+		o.Status.AtProvider.ARN = aws.String(kmsKeyARN)
+	}
+	return nil
+}
+
+func referencingDocument() *v1alpha1.PolicyDocument {
+	return &v1alpha1.PolicyDocument{
+		Version: "2012-10-17",
+		Statements: []v1alpha1.PolicyStatement{{
+			Effect: "Allow",
+			Principal: &v1alpha1.PolicyPrincipal{AWSPrincipals: []v1alpha1.AWSPrincipal{
+				{IAMUserARNRef: &xpv1.Reference{Name: "u"}},
+				{IAMRoleARNRef: &xpv1.Reference{Name: "r"}},
+			}},
+			Action: []string{"s3:GetObject", "kms:Decrypt"},
+			ResourceRefs: []v1alpha1.PolicyResourceReference{
+				{BucketRef: &xpv1.Reference{Name: "b"}, Suffix: "/*"},
+				{KeyRef: &xpv1.Reference{Name: "k"}},
+				{RoleRef: &xpv1.Reference{Name: "r"}},
+			},
+		}},
+	}
+}
+
+func resolvedDocument() *v1alpha1.PolicyDocument {
+	doc := referencingDocument()
+	s := &doc.Statements[0]
+	s.Principal.AWSPrincipals[0].IAMUserARN = aws.String(iamUserARN)
+	s.Principal.AWSPrincipals[1].IAMRoleARN = aws.String(iamRoleARN)
+	s.ResourceRefs[0].ARN = aws.String(s3BucketARN)
+	s.ResourceRefs[1].ARN = aws.String(kmsKeyARN)
+	s.ResourceRefs[2].ARN = aws.String(iamRoleARN)
+	return doc
+}
+
+func TestResolveReferences(t *testing.T) {
+	type want struct {
+		doc *v1alpha1.PolicyDocument
+		err error
+	}
+
+	cases := map[string]struct {
+		client client.Reader
+		doc    *v1alpha1.PolicyDocument
+		want   want
+	}{
+		"NilDocument": {
+			client: &test.MockClient{},
+		},
+		"ResolveAll": {
+			client: &test.MockClient{MockGet: mockGet},
+			doc:    referencingDocument(),
+			want: want{
+				doc: resolvedDocument(),
+			},
+		},
+		"PrincipalError": {
+			client: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			doc: &v1alpha1.PolicyDocument{Statements: []v1alpha1.PolicyStatement{{
+				NotPrincipal: &v1alpha1.PolicyPrincipal{AWSPrincipals: []v1alpha1.AWSPrincipal{
+					{IAMRoleARNRef: &xpv1.Reference{Name: "r"}},
+				}},
+			}}},
+			want: want{
+				doc: &v1alpha1.PolicyDocument{Statements: []v1alpha1.PolicyStatement{{
+					NotPrincipal: &v1alpha1.PolicyPrincipal{AWSPrincipals: []v1alpha1.AWSPrincipal{
+						{IAMRoleARNRef: &xpv1.Reference{Name: "r"}},
+					}},
+				}}},
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "statements[0].notPrincipal.awsPrincipals[0].iamRoleArn"),
+			},
+		},
+		"ResourceError": {
+			client: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			doc: &v1alpha1.PolicyDocument{Statements: []v1alpha1.PolicyStatement{{
+				ResourceRefs: []v1alpha1.PolicyResourceReference{{BucketRef: &xpv1.Reference{Name: "b"}}},
+			}}},
+			want: want{
+				doc: &v1alpha1.PolicyDocument{Statements: []v1alpha1.PolicyStatement{{
+					ResourceRefs: []v1alpha1.PolicyResourceReference{{BucketRef: &xpv1.Reference{Name: "b"}}},
+				}}},
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "statements[0].resourceRefs[0]"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := reference.NewAPIResolver(tc.client, &iamv1beta1.IAMRole{})
+			err := ResolveReferences(context.Background(), r, tc.doc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.doc, tc.doc); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewReferenceResolver(t *testing.T) {
+	documents := func(mg resource.Managed) []*v1alpha1.PolicyDocument {
+		return []*v1alpha1.PolicyDocument{mg.(*iamv1beta1.IAMRole).Spec.ForProvider.TrustPolicyDocument}
+	}
+	role := func(doc *v1alpha1.PolicyDocument) *iamv1beta1.IAMRole {
+		cr := &iamv1beta1.IAMRole{}
+		cr.Spec.ForProvider.TrustPolicyDocument = doc
+		return cr
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client client.Client
+		cr     resource.Managed
+		want   want
+	}{
+		"NothingToResolve": {
+			client: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			cr: role(&v1alpha1.PolicyDocument{Version: "2012-10-17"}),
+			want: want{
+				cr: role(&v1alpha1.PolicyDocument{Version: "2012-10-17"}),
+			},
+		},
+		"ResolvedAndUpdated": {
+			client: &test.MockClient{
+				MockGet:    mockGet,
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			cr: role(referencingDocument()),
+			want: want{
+				cr: role(resolvedDocument()),
+			},
+		},
+		"ResolveError": {
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: role(&v1alpha1.PolicyDocument{Statements: []v1alpha1.PolicyStatement{{
+				ResourceRefs: []v1alpha1.PolicyResourceReference{{KeyRef: &xpv1.Reference{Name: "k"}}},
+			}}}),
+			want: want{
+				cr: role(&v1alpha1.PolicyDocument{Statements: []v1alpha1.PolicyStatement{{
+					ResourceRefs: []v1alpha1.PolicyResourceReference{{KeyRef: &xpv1.Reference{Name: "k"}}},
+				}}}),
+				err: errors.Wrap(errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "statements[0].resourceRefs[0]"), errResolveReferences),
+			},
+		},
+		"UpdateError": {
+			client: &test.MockClient{
+				MockGet:    mockGet,
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			cr: role(referencingDocument()),
+			want: want{
+				cr:  role(resolvedDocument()),
+				err: errors.Wrap(errBoom, errUpdateManaged),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewReferenceResolver(tc.client, documents).ResolveReferences(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
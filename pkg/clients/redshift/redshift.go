@@ -156,13 +156,14 @@ func IsUpToDate(p v1alpha1.ClusterParameters, cl redshifttypes.Cluster) (bool, e
 	return updated && found, nil
 }
 
-// initializeModifyandDeleteParameters fills the four v1alpha1.ClusterParameters
+// initializeModifyandDeleteParameters fills the v1alpha1.ClusterParameters
 // fields that aren't available in redshift.Cluster and are for Modify or Delete input.
 func initializeModifyandDeleteParameters(orig *v1alpha1.ClusterParameters, new *v1alpha1.ClusterParameters) *v1alpha1.ClusterParameters {
 	new.FinalClusterSnapshotIdentifier = orig.FinalClusterSnapshotIdentifier
 	new.FinalClusterSnapshotRetentionPeriod = orig.FinalClusterSnapshotRetentionPeriod
 	new.NewClusterIdentifier = orig.NewClusterIdentifier
 	new.SkipFinalClusterSnapshot = orig.SkipFinalClusterSnapshot
+	new.MasterPasswordSecretRef = orig.MasterPasswordSecretRef
 	return new
 }
 
@@ -290,7 +291,8 @@ func GenerateModifyClusterInput(p *v1alpha1.ClusterParameters, cl redshifttypes.
 	if patch.ManualSnapshotRetentionPeriod != nil {
 		o.ManualSnapshotRetentionPeriod = p.ManualSnapshotRetentionPeriod
 	}
-	if patch.NewMasterUserPassword != nil {
+	// The password in the referenced secret is set by the controller.
+	if patch.NewMasterUserPassword != nil && p.MasterPasswordSecretRef == nil {
 		o.MasterUserPassword = p.NewMasterUserPassword
 	}
 	// When a rename operation is requested, no other modifications are allowed in the same request
@@ -316,6 +318,18 @@ func GenerateModifyClusterInput(p *v1alpha1.ClusterParameters, cl redshifttypes.
 	return o
 }
 
+// SetMasterUserPassword sets the master user password in the given modify
+// input unless the input is for a resize, public accessibility or enhanced
+// VPC routing change, which AWS requires to be made in a request of its own.
+// It returns whether the password is set.
+func SetMasterUserPassword(o *redshift.ModifyClusterInput, pw string) bool {
+	if o.NodeType != nil || o.ElasticIp != nil || o.PubliclyAccessible != nil || o.EnhancedVpcRouting != nil {
+		return false
+	}
+	o.MasterUserPassword = aws.String(pw)
+	return true
+}
+
 // GenerateDeleteClusterInput from RedshiftSpec
 func GenerateDeleteClusterInput(p *v1alpha1.ClusterParameters, cid *string) *redshift.DeleteClusterInput {
 	return &redshift.DeleteClusterInput{
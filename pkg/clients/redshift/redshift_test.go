@@ -29,6 +29,8 @@ import (
 	"github.com/aws/smithy-go"
 	"github.com/google/go-cmp/cmp"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
 )
 
@@ -454,6 +456,27 @@ func TestGenerateModifyClusterInput(t *testing.T) {
 				NewClusterIdentifier: aws.String("YouAreinMatrixNeo"),
 			},
 		},
+		"NewMasterUserPassword": {
+			args: args{
+				in: &v1alpha1.ClusterParameters{
+					NewMasterUserPassword: aws.String("pw"),
+				},
+				cl: *cluster(),
+			},
+			want: &redshift.ModifyClusterInput{
+				MasterUserPassword: aws.String("pw"),
+			},
+		},
+		"NewMasterUserPasswordWithSecretRef": {
+			args: args{
+				in: &v1alpha1.ClusterParameters{
+					MasterPasswordSecretRef: &xpv1.SecretKeySelector{Key: "password"},
+					NewMasterUserPassword:   aws.String("pw"),
+				},
+				cl: *cluster(),
+			},
+			want: &redshift.ModifyClusterInput{},
+		},
 		"EverythingElse": {
 			args: args{
 				in: &v1alpha1.ClusterParameters{
@@ -486,6 +509,49 @@ func TestGenerateModifyClusterInput(t *testing.T) {
 	}
 }
 
+func TestSetMasterUserPassword(t *testing.T) {
+	type want struct {
+		in  *redshift.ModifyClusterInput
+		set bool
+	}
+	cases := map[string]struct {
+		in   *redshift.ModifyClusterInput
+		want want
+	}{
+		"Modify": {
+			in: &redshift.ModifyClusterInput{Encrypted: aws.Bool(true)},
+			want: want{
+				in:  &redshift.ModifyClusterInput{Encrypted: aws.Bool(true), MasterUserPassword: aws.String("pw")},
+				set: true,
+			},
+		},
+		"Resize": {
+			in: &redshift.ModifyClusterInput{NodeType: aws.String("dc1.large")},
+			want: want{
+				in: &redshift.ModifyClusterInput{NodeType: aws.String("dc1.large")},
+			},
+		},
+		"EnhancedVPCRouting": {
+			in: &redshift.ModifyClusterInput{EnhancedVpcRouting: aws.Bool(true)},
+			want: want{
+				in: &redshift.ModifyClusterInput{EnhancedVpcRouting: aws.Bool(true)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			set := SetMasterUserPassword(tc.in, "pw")
+			if diff := cmp.Diff(tc.want.set, set); diff != "" {
+				t.Errorf("SetMasterUserPassword(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, tc.in, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("SetMasterUserPassword(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDeleteClusterInput(t *testing.T) {
 	cases := map[string]struct {
 		in  *v1alpha1.ClusterParameters
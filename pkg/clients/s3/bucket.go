@@ -50,6 +50,10 @@ var (
 	TaggingNotFoundErrCode = "NoSuchTagSet"
 	// WebsiteNotFoundErrCode is the error code sent by AWS when the website config does not exist
 	WebsiteNotFoundErrCode = "NoSuchWebsiteConfiguration"
+	// ObjectLockNotFoundErrCode is the error code sent by AWS when the object lock config does not exist
+	ObjectLockNotFoundErrCode = "ObjectLockConfigurationNotFoundError"
+	// OwnershipControlsNotFoundErrCode is the error code sent by AWS when the ownership controls do not exist
+	OwnershipControlsNotFoundErrCode = "OwnershipControlsNotFoundError"
 
 	// MethodNotAllowed is the error code sent by AWS when the request method for an object is not allowed
 	MethodNotAllowed = "MethodNotAllowed"
@@ -97,6 +101,8 @@ type BucketClient interface {
 
 	PutBucketAnalyticsConfiguration(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
 	GetBucketAnalyticsConfiguration(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	ListBucketAnalyticsConfigurations(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	DeleteBucketAnalyticsConfiguration(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
 
 	PutBucketLifecycleConfiguration(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	GetBucketLifecycleConfiguration(ctx context.Context, input *s3.GetBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
@@ -110,6 +116,25 @@ type BucketClient interface {
 	GetPublicAccessBlock(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
 	PutPublicAccessBlock(ctx context.Context, input *s3.PutPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
 	DeletePublicAccessBlock(ctx context.Context, input *s3.DeletePublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)
+
+	GetObjectLockConfiguration(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+	PutObjectLockConfiguration(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
+
+	GetBucketOwnershipControls(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)
+	PutBucketOwnershipControls(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)
+	DeleteBucketOwnershipControls(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)
+
+	ListBucketIntelligentTieringConfigurations(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	PutBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
+
+	ListBucketInventoryConfigurations(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	PutBucketInventoryConfiguration(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+	DeleteBucketInventoryConfiguration(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	ListBucketMetricsConfigurations(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)
+	PutBucketMetricsConfiguration(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)
+	DeleteBucketMetricsConfiguration(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -196,6 +221,18 @@ func WebsiteConfigurationNotFound(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == WebsiteNotFoundErrCode
 }
 
+// ObjectLockConfigurationNotFound is parses the aws Error and validates if the object lock configuration does not exist
+func ObjectLockConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ObjectLockNotFoundErrCode
+}
+
+// OwnershipControlsNotFound is parses the aws Error and validates if the ownership controls do not exist
+func OwnershipControlsNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == OwnershipControlsNotFoundErrCode
+}
+
 // MethodNotSupported is parses the aws Error and validates if the method is allowed for a request
 func MethodNotSupported(err error) bool {
 	var awsErr smithy.APIError
@@ -208,6 +245,24 @@ func ArgumentNotSupported(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == UnsupportedArgument
 }
 
+// ObjectOwnershipBucketOwnerEnforced is the object ownership that disables the
+// ACLs of a bucket.
+const ObjectOwnershipBucketOwnerEnforced s3types.ObjectOwnership = "BucketOwnerEnforced"
+
+// IsACLDisabled returns true if the ownership controls of the bucket disable
+// its ACLs, in which case AWS rejects the requests that set an ACL.
+func IsACLDisabled(p v1beta1.BucketParameters) bool {
+	if p.OwnershipControls == nil {
+		return false
+	}
+	for _, r := range p.OwnershipControls.Rules {
+		if s3types.ObjectOwnership(r.ObjectOwnership) == ObjectOwnershipBucketOwnerEnforced {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateBucketACL creates the ACLInput, sends the request to put an ACL based on the bucket
 func UpdateBucketACL(ctx context.Context, client BucketClient, bucket *v1beta1.Bucket) error {
 	config := &s3.PutBucketAclInput{
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // nolint:gosec // S3 uses MD5 for the integrity of the uploads and ETags.
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+)
+
+// ContentSHA256MetadataKey is the user metadata key of the objects that keeps
+// the SHA-256 checksum of the content uploaded by the BucketObject controller.
+const ContentSHA256MetadataKey = "crossplane-content-sha256"
+
+// BucketObjectClient is the external client used for BucketObject Custom Resource
+type BucketObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+}
+
+// NewBucketObjectClient returns a new client given an aws config
+func NewBucketObjectClient(cfg aws.Config) BucketObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// ContentMD5 returns the hex encoded MD5 digest of the given content.
+func ContentMD5(content []byte) string {
+	sum := md5.Sum(content) // nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
+// ContentSHA256 returns the hex encoded SHA-256 checksum of the given content.
+func ContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// GeneratePutObjectInput returns the input to upload the given content as the
+// object described by the parameters.
+func GeneratePutObjectInput(p v1alpha3.BucketObjectParameters, content []byte) *s3.PutObjectInput {
+	sum := md5.Sum(content) // nolint:gosec
+	in := &s3.PutObjectInput{
+		Bucket:           p.BucketName,
+		Key:              aws.String(p.Key),
+		Body:             bytes.NewReader(content),
+		ContentLength:    int64(len(content)),
+		ContentMD5:       aws.String(base64.StdEncoding.EncodeToString(sum[:])),
+		ContentType:      p.ContentType,
+		SSEKMSKeyId:      p.SSEKMSKeyID,
+		BucketKeyEnabled: aws.ToBool(p.BucketKeyEnabled),
+		Metadata:         map[string]string{ContentSHA256MetadataKey: ContentSHA256(content)},
+	}
+	if p.ServerSideEncryption != nil {
+		in.ServerSideEncryption = s3types.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	if p.ACL != nil {
+		in.ACL = s3types.ObjectCannedACL(*p.ACL)
+	}
+	if len(p.Tags) != 0 {
+		tagging := url.Values{}
+		for k, v := range p.Tags {
+			tagging.Set(k, v)
+		}
+		in.Tagging = aws.String(tagging.Encode())
+	}
+	return in
+}
+
+// GenerateBucketObjectObservation returns the observation of the given object.
+func GenerateBucketObjectObservation(o *s3.HeadObjectOutput) v1alpha3.BucketObjectObservation {
+	return v1alpha3.BucketObjectObservation{
+		ETag:      strings.Trim(aws.ToString(o.ETag), `"`),
+		VersionID: aws.ToString(o.VersionId),
+	}
+}
+
+// IsBucketObjectUpToDate returns whether the object and its tags match the
+// parameters and the given content.
+func IsBucketObjectUpToDate(p v1alpha3.BucketObjectParameters, content []byte, o *s3.HeadObjectOutput, tags []s3types.Tag) bool { // nolint:gocyclo
+	if o.Metadata[ContentSHA256MetadataKey] != ContentSHA256(content) {
+		return false
+	}
+	// The ETag of an object uploaded with a single PUT is the MD5 digest of
+	// its content unless it is encrypted with SSE-KMS. Checking it catches
+	// the objects that are overwritten with their metadata copied over.
+	if o.ServerSideEncryption != s3types.ServerSideEncryptionAwsKms &&
+		strings.Trim(aws.ToString(o.ETag), `"`) != ContentMD5(content) {
+		return false
+	}
+	if p.ContentType != nil && *p.ContentType != aws.ToString(o.ContentType) {
+		return false
+	}
+	if p.ServerSideEncryption != nil && *p.ServerSideEncryption != string(o.ServerSideEncryption) {
+		return false
+	}
+	// S3 always returns the ARN of the key while it can be given by its ID.
+	if p.SSEKMSKeyID != nil && *p.SSEKMSKeyID != aws.ToString(o.SSEKMSKeyId) &&
+		!strings.HasSuffix(aws.ToString(o.SSEKMSKeyId), ":key/"+*p.SSEKMSKeyID) {
+		return false
+	}
+	if p.BucketKeyEnabled != nil && *p.BucketKeyEnabled != o.BucketKeyEnabled {
+		return false
+	}
+	observed := make(map[string]string, len(tags))
+	for _, t := range tags {
+		observed[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return cmp.Equal(p.Tags, observed, cmpopts.EquateEmpty())
+}
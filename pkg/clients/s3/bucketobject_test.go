@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+)
+
+var (
+	objectContent = []byte("hello")
+	objectMD5     = "5d41402abc4b2a76b9719d911017c592"
+	objectSHA256  = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	objectKMSKey  = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	objectKMSARN  = "arn:aws:kms:us-east-1:123456789012:key/" + objectKMSKey
+)
+
+func TestGeneratePutObjectInput(t *testing.T) {
+	type args struct {
+		p       v1alpha3.BucketObjectParameters
+		content []byte
+	}
+
+	cases := map[string]struct {
+		args args
+		want *s3.PutObjectInput
+	}{
+		"Minimal": {
+			args: args{
+				p:       v1alpha3.BucketObjectParameters{BucketName: aws.String("bucket"), Key: "key"},
+				content: objectContent,
+			},
+			want: &s3.PutObjectInput{
+				Bucket:        aws.String("bucket"),
+				Key:           aws.String("key"),
+				ContentLength: 5,
+				ContentMD5:    aws.String("XUFAKrxLKna5cZ2REBfFkg=="),
+				Metadata:      map[string]string{ContentSHA256MetadataKey: objectSHA256},
+			},
+		},
+		"Full": {
+			args: args{
+				p: v1alpha3.BucketObjectParameters{
+					BucketName:           aws.String("bucket"),
+					Key:                  "key",
+					ContentType:          aws.String("text/plain"),
+					ServerSideEncryption: aws.String("aws:kms"),
+					SSEKMSKeyID:          aws.String(objectKMSKey),
+					BucketKeyEnabled:     aws.Bool(true),
+					ACL:                  aws.String("private"),
+					Tags:                 map[string]string{"b": "2", "a": "1 1"},
+				},
+				content: objectContent,
+			},
+			want: &s3.PutObjectInput{
+				Bucket:               aws.String("bucket"),
+				Key:                  aws.String("key"),
+				ContentLength:        5,
+				ContentMD5:           aws.String("XUFAKrxLKna5cZ2REBfFkg=="),
+				ContentType:          aws.String("text/plain"),
+				ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+				SSEKMSKeyId:          aws.String(objectKMSKey),
+				BucketKeyEnabled:     true,
+				ACL:                  s3types.ObjectCannedACLPrivate,
+				Tagging:              aws.String("a=1+1&b=2"),
+				Metadata:             map[string]string{ContentSHA256MetadataKey: objectSHA256},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutObjectInput(tc.args.p, tc.args.content)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(s3.PutObjectInput{}, "Body"), cmpopts.IgnoreUnexported(s3.PutObjectInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			body, err := ioutil.ReadAll(got.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.args.content, body); diff != "" {
+				t.Errorf("body: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBucketObjectUpToDate(t *testing.T) {
+	type args struct {
+		p    v1alpha3.BucketObjectParameters
+		o    *s3.HeadObjectOutput
+		tags []s3types.Tag
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha3.BucketObjectParameters{
+					ContentType: aws.String("text/plain"),
+					Tags:        map[string]string{"a": "1"},
+				},
+				o: &s3.HeadObjectOutput{
+					ETag:        aws.String(`"` + objectMD5 + `"`),
+					ContentType: aws.String("text/plain"),
+					Metadata:    map[string]string{ContentSHA256MetadataKey: objectSHA256},
+				},
+				tags: []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}},
+			},
+			want: true,
+		},
+		"ContentChanged": {
+			args: args{
+				o: &s3.HeadObjectOutput{
+					ETag:     aws.String(`"` + objectMD5 + `"`),
+					Metadata: map[string]string{ContentSHA256MetadataKey: "old"},
+				},
+			},
+			want: false,
+		},
+		"OverwrittenOutside": {
+			args: args{
+				o: &s3.HeadObjectOutput{
+					ETag:     aws.String(`"other"`),
+					Metadata: map[string]string{ContentSHA256MetadataKey: objectSHA256},
+				},
+			},
+			want: false,
+		},
+		"KMSEncryptedWithKeyID": {
+			args: args{
+				p: v1alpha3.BucketObjectParameters{
+					ServerSideEncryption: aws.String("aws:kms"),
+					SSEKMSKeyID:          aws.String(objectKMSKey),
+				},
+				o: &s3.HeadObjectOutput{
+					ETag:                 aws.String(`"not-md5"`),
+					ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+					SSEKMSKeyId:          aws.String(objectKMSARN),
+					Metadata:             map[string]string{ContentSHA256MetadataKey: objectSHA256},
+				},
+			},
+			want: true,
+		},
+		"EncryptionChanged": {
+			args: args{
+				p: v1alpha3.BucketObjectParameters{
+					ServerSideEncryption: aws.String("aws:kms"),
+				},
+				o: &s3.HeadObjectOutput{
+					ETag:                 aws.String(`"` + objectMD5 + `"`),
+					ServerSideEncryption: s3types.ServerSideEncryptionAes256,
+					Metadata:             map[string]string{ContentSHA256MetadataKey: objectSHA256},
+				},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				p: v1alpha3.BucketObjectParameters{
+					Tags: map[string]string{"a": "2"},
+				},
+				o: &s3.HeadObjectOutput{
+					ETag:     aws.String(`"` + objectMD5 + `"`),
+					Metadata: map[string]string{ContentSHA256MetadataKey: objectSHA256},
+				},
+				tags: []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBucketObjectUpToDate(tc.args.p, objectContent, tc.args.o, tc.args.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
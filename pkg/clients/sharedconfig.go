@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-ini/ini"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Keys of the shared configuration format.
+const (
+	sharedConfigAccessKeyID     = "aws_access_key_id"
+	sharedConfigSecretAccessKey = "aws_secret_access_key"
+	sharedConfigSessionToken    = "aws_session_token"
+	sharedConfigRoleARN         = "role_arn"
+	sharedConfigSourceProfile   = "source_profile"
+	sharedConfigExternalID      = "external_id"
+	sharedConfigRoleSessionName = "role_session_name"
+	sharedConfigDurationSeconds = "duration_seconds"
+	sharedConfigRegion          = "region"
+)
+
+// Keys of the shared configuration format that need a local environment,
+// such as a token cache on disk or an executable, which the provider doesn't
+// have.
+var unsupportedSharedConfigKeys = []string{
+	"credential_process",
+	"credential_source",
+	"web_identity_token_file",
+	"mfa_serial",
+	"sso_start_url",
+	"sso_region",
+	"sso_account_id",
+	"sso_role_name",
+	"sso_session",
+}
+
+// SharedProfile is a profile of the shared AWS configuration format whose
+// source_profile chain is resolved.
+type SharedProfile struct {
+	// Credentials are the static credentials of the profile at the end of
+	// the source_profile chain.
+	Credentials aws.Credentials
+
+	// AssumeRoleChain is the list of roles that need to be assumed in order
+	// with the static credentials to get the credentials of the profile.
+	AssumeRoleChain []v1beta1.AssumeRoleOptions
+
+	// Region of the profile, if given.
+	Region string
+}
+
+// ResolveSharedProfile parses the data in the format of ~/.aws/config or
+// ~/.aws/credentials and resolves the given profile the same way AWS CLI
+// does. Profile sections can be named either as "name" or "profile name", and
+// a profile with role_arn is resolved by assuming the role with the
+// credentials of its source_profile, which may in turn have a role_arn.
+// Example:
+// [profile base]
+// aws_access_key_id = <YOUR_ACCESS_KEY_ID>
+// aws_secret_access_key = <YOUR_SECRET_ACCESS_KEY>
+//
+// [profile target]
+// role_arn = arn:aws:iam::123456789012:role/crossplane
+// source_profile = base
+// external_id = <EXTERNAL_ID>
+// region = us-east-1
+func ResolveSharedProfile(data []byte, profile string) (SharedProfile, error) {
+	f, err := ini.InsensitiveLoad(data)
+	if err != nil {
+		return SharedProfile{}, errors.Wrap(err, "cannot parse credentials secret")
+	}
+	sp, err := resolveSharedProfile(f, profile, map[string]bool{})
+	if err != nil {
+		return SharedProfile{}, err
+	}
+	return sp, nil
+}
+
+func resolveSharedProfile(f *ini.File, name string, visited map[string]bool) (SharedProfile, error) {
+	if visited[strings.ToLower(name)] {
+		return SharedProfile{}, errors.Errorf("source_profile chain of %s profile is circular", name)
+	}
+	visited[strings.ToLower(name)] = true
+
+	s, err := sharedProfileSection(f, name)
+	if err != nil {
+		return SharedProfile{}, err
+	}
+	for _, k := range unsupportedSharedConfigKeys {
+		if s.HasKey(k) {
+			return SharedProfile{}, errors.Errorf("%s in %s profile is not supported", k, name)
+		}
+	}
+
+	roleARN := s.Key(sharedConfigRoleARN).String()
+	if roleARN == "" {
+		return staticSharedProfile(s), nil
+	}
+
+	aro := v1beta1.AssumeRoleOptions{RoleARN: roleARN}
+	if v := s.Key(sharedConfigExternalID).String(); v != "" {
+		aro.ExternalID = String(v)
+	}
+	if v := s.Key(sharedConfigRoleSessionName).String(); v != "" {
+		aro.SessionName = String(v)
+	}
+	if s.HasKey(sharedConfigDurationSeconds) {
+		d, err := s.Key(sharedConfigDurationSeconds).Int()
+		if err != nil {
+			return SharedProfile{}, errors.Wrapf(err, "cannot parse %s in %s profile", sharedConfigDurationSeconds, name)
+		}
+		aro.Duration = &metav1.Duration{Duration: time.Duration(d) * time.Second}
+	}
+
+	var base SharedProfile
+	switch src := s.Key(sharedConfigSourceProfile).String(); {
+	case src == "":
+		return SharedProfile{}, errors.Errorf("%s profile has role_arn but no source_profile", name)
+	case strings.EqualFold(src, name):
+		// A profile can refer to itself to assume its role with its own
+		// static credentials.
+		base = staticSharedProfile(s)
+	default:
+		base, err = resolveSharedProfile(f, src, visited)
+	}
+	if err != nil {
+		return SharedProfile{}, err
+	}
+	base.AssumeRoleChain = append(base.AssumeRoleChain, aro)
+	if r := s.Key(sharedConfigRegion).String(); r != "" {
+		base.Region = r
+	}
+	return base, nil
+}
+
+func staticSharedProfile(s *ini.Section) SharedProfile {
+	return SharedProfile{
+		Credentials: aws.Credentials{
+			AccessKeyID:     s.Key(sharedConfigAccessKeyID).String(),
+			SecretAccessKey: s.Key(sharedConfigSecretAccessKey).String(),
+			SessionToken:    s.Key(sharedConfigSessionToken).String(),
+		},
+		Region: s.Key(sharedConfigRegion).String(),
+	}
+}
+
+// sharedProfileSection returns the section of the given profile, which is
+// named "profile <name>" in ~/.aws/config and "<name>" in ~/.aws/credentials.
+func sharedProfileSection(f *ini.File, name string) (*ini.Section, error) {
+	if s, err := f.GetSection(fmt.Sprintf("profile %s", name)); err == nil {
+		return s, nil
+	}
+	s, err := f.GetSection(name)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot get %s profile in credentials secret", name))
+	}
+	return s, nil
+}
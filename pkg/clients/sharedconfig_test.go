@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestResolveSharedProfile(t *testing.T) {
+	type args struct {
+		data    string
+		profile string
+	}
+	type want struct {
+		sp  SharedProfile
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DefaultProfile": {
+			args: args{
+				data:    "[default]\naws_access_key_id = id\naws_secret_access_key = secret\nregion = us-west-2",
+				profile: DefaultSection,
+			},
+			want: want{
+				sp: SharedProfile{
+					Credentials: aws.Credentials{AccessKeyID: "id", SecretAccessKey: "secret"},
+					Region:      "us-west-2",
+				},
+			},
+		},
+		"ConfigFileProfileSection": {
+			args: args{
+				data:    "[profile dev]\naws_access_key_id = id\naws_secret_access_key = secret\naws_session_token = token",
+				profile: "dev",
+			},
+			want: want{
+				sp: SharedProfile{
+					Credentials: aws.Credentials{AccessKeyID: "id", SecretAccessKey: "secret", SessionToken: "token"},
+				},
+			},
+		},
+		"SourceProfileChain": {
+			args: args{
+				data: `
+[base]
+aws_access_key_id = id
+aws_secret_access_key = secret
+
+[profile hub]
+role_arn = arn:aws:iam::111111111111:role/hub
+source_profile = base
+
+[profile target]
+role_arn = arn:aws:iam::222222222222:role/target
+source_profile = hub
+external_id = ext
+role_session_name = crossplane
+duration_seconds = 3600
+region = eu-central-1
+`,
+				profile: "target",
+			},
+			want: want{
+				sp: SharedProfile{
+					Credentials: aws.Credentials{AccessKeyID: "id", SecretAccessKey: "secret"},
+					AssumeRoleChain: []v1beta1.AssumeRoleOptions{
+						{RoleARN: "arn:aws:iam::111111111111:role/hub"},
+						{
+							RoleARN:     "arn:aws:iam::222222222222:role/target",
+							ExternalID:  aws.String("ext"),
+							SessionName: aws.String("crossplane"),
+							Duration:    &metav1.Duration{Duration: time.Hour},
+						},
+					},
+					Region: "eu-central-1",
+				},
+			},
+		},
+		"SelfSourceProfile": {
+			args: args{
+				data:    "[default]\naws_access_key_id = id\naws_secret_access_key = secret\nrole_arn = arn:aws:iam::111111111111:role/foo\nsource_profile = default",
+				profile: DefaultSection,
+			},
+			want: want{
+				sp: SharedProfile{
+					Credentials:     aws.Credentials{AccessKeyID: "id", SecretAccessKey: "secret"},
+					AssumeRoleChain: []v1beta1.AssumeRoleOptions{{RoleARN: "arn:aws:iam::111111111111:role/foo"}},
+				},
+			},
+		},
+		"CircularSourceProfile": {
+			args: args{
+				data:    "[a]\nrole_arn = arn:aws:iam::111111111111:role/a\nsource_profile = b\n[b]\nrole_arn = arn:aws:iam::111111111111:role/b\nsource_profile = a",
+				profile: "a",
+			},
+			want: want{
+				err: errors.New("source_profile chain of a profile is circular"),
+			},
+		},
+		"RoleWithoutSourceProfile": {
+			args: args{
+				data:    "[a]\nrole_arn = arn:aws:iam::111111111111:role/a",
+				profile: "a",
+			},
+			want: want{
+				err: errors.New("a profile has role_arn but no source_profile"),
+			},
+		},
+		"UnsupportedKey": {
+			args: args{
+				data:    "[a]\nsso_start_url = https://example.awsapps.com/start",
+				profile: "a",
+			},
+			want: want{
+				err: errors.New("sso_start_url in a profile is not supported"),
+			},
+		},
+		"MissingProfile": {
+			args: args{
+				data:    "[a]\naws_access_key_id = id",
+				profile: "b",
+			},
+			want: want{
+				err: errors.Wrap(errors.New("section 'b' does not exist"), "cannot get b profile in credentials secret"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sp, err := ResolveSharedProfile([]byte(tc.args.data), tc.args.profile)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.sp, sp); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
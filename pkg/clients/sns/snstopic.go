@@ -27,6 +27,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 // TopicAttributes refers to AWS SNS Topic Attributes List
@@ -92,7 +93,9 @@ func LateInitializeTopicAttr(in *v1alpha1.SNSTopicParameters, attrs map[string]s
 	in.DisplayName = awsclients.LateInitializeStringPtr(in.DisplayName, aws.String(attrs[string(TopicDisplayName)]))
 	in.DeliveryPolicy = awsclients.LateInitializeStringPtr(in.DeliveryPolicy, aws.String(attrs[string(TopicDeliveryPolicy)]))
 	in.KMSMasterKeyID = awsclients.LateInitializeStringPtr(in.KMSMasterKeyID, aws.String(attrs[string(TopicKmsMasterKeyID)]))
-	in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
+	if in.PolicyDocument == nil {
+		in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
+	}
 
 }
 
@@ -106,9 +109,10 @@ func GetChangedAttributes(p v1alpha1.SNSTopicParameters, attrs map[string]string
 	topicAttrs := getTopicAttributes(p)
 	changedAttrs := make(map[string]string)
 	for k, v := range topicAttrs {
-		if v != attrs[k] {
-			changedAttrs[k] = v
+		if v == attrs[k] || (k == string(TopicPolicy) && isPolicyUpToDate(v, attrs[k])) {
+			continue
 		}
+		changedAttrs[k] = v
 	}
 
 	return changedAttrs
@@ -142,7 +146,20 @@ func IsSNSTopicUpToDate(p v1alpha1.SNSTopicParameters, attr map[string]string) b
 	return aws.ToString(p.DeliveryPolicy) == attr[string(TopicDeliveryPolicy)] &&
 		aws.ToString(p.DisplayName) == attr[string(TopicDisplayName)] &&
 		aws.ToString(p.KMSMasterKeyID) == attr[string(TopicKmsMasterKeyID)] &&
-		aws.ToString(p.Policy) == attr[string(TopicPolicy)]
+		isPolicyUpToDate(topicPolicy(p), attr[string(TopicPolicy)])
+}
+
+func isPolicyUpToDate(desired, current string) bool {
+	return desired == current || awspolicy.AreEquivalent(desired, current)
+}
+
+// topicPolicy returns the policy of the topic, which is generated from the
+// structured policy document if it is given.
+func topicPolicy(p v1alpha1.SNSTopicParameters) string {
+	if p.PolicyDocument != nil {
+		return awspolicy.Marshal(p.PolicyDocument)
+	}
+	return aws.ToString(p.Policy)
 }
 
 func getTopicAttributes(p v1alpha1.SNSTopicParameters) map[string]string {
@@ -152,7 +169,7 @@ func getTopicAttributes(p v1alpha1.SNSTopicParameters) map[string]string {
 	topicAttr[string(TopicDeliveryPolicy)] = aws.ToString(p.DeliveryPolicy)
 	topicAttr[string(TopicDisplayName)] = aws.ToString(p.DisplayName)
 	topicAttr[string(TopicKmsMasterKeyID)] = aws.ToString(p.KMSMasterKeyID)
-	topicAttr[string(TopicPolicy)] = aws.ToString(p.Policy)
+	topicAttr[string(TopicPolicy)] = topicPolicy(p)
 
 	return topicAttr
 }
@@ -28,6 +28,7 @@ import (
 	awssnstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/google/go-cmp/cmp"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
 )
 
@@ -44,6 +45,18 @@ var (
 	tagValue1         = "value-1"
 	tagKey2           = "name-2"
 	tagValue2         = "value-2"
+
+	topicPolicyDocument = &commonv1alpha1.PolicyDocument{
+		Version: "2012-10-17",
+		Statements: []commonv1alpha1.PolicyStatement{{
+			Effect:    "Allow",
+			Principal: &commonv1alpha1.PolicyPrincipal{Service: []string{"s3.amazonaws.com"}},
+			Action:    []string{"sns:Publish"},
+			Resource:  []string{topicArn},
+		}},
+	}
+	topicPolicyJSON        = `{"Statement":[{"Action":"sns:Publish","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Resource":"sometopicArn"}],"Version":"2012-10-17"}`
+	topicPolicyReformatted = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"Service": ["s3.amazonaws.com"]}, "Action": ["sns:Publish"], "Resource": "sometopicArn"}]}`
 )
 
 // Topic Attribute Modifier
@@ -80,6 +93,12 @@ func withTopicSubs(confirmed, pending, deleted string) topicAttrModifier {
 	}
 }
 
+func withAttrPolicy(s string) topicAttrModifier {
+	return func(attr *map[string]string) {
+		(*attr)[string(TopicPolicy)] = s
+	}
+}
+
 func withAttrDisplayName(s *string) topicAttrModifier {
 	return func(attr *map[string]string) {
 		(*attr)[string(TopicDisplayName)] = *s
@@ -214,6 +233,34 @@ func TestGetChangedAttributes(t *testing.T) {
 			want: topicAttributes(
 				withAttrDisplayName(&topicDisplayName),
 			),
+		}, "PolicyDocumentEquivalent": {
+			args: args{
+				p: v1alpha1.SNSTopicParameters{
+					Name:           topicName,
+					DisplayName:    &topicDisplayName,
+					PolicyDocument: topicPolicyDocument,
+				},
+				attr: topicAttributes(
+					withAttrDisplayName(&topicDisplayName),
+					withAttrPolicy(topicPolicyReformatted),
+				),
+			},
+			want: topicAttributes(),
+		},
+		"PolicyDocumentChanged": {
+			args: args{
+				p: v1alpha1.SNSTopicParameters{
+					Name:           topicName,
+					DisplayName:    &topicDisplayName,
+					PolicyDocument: topicPolicyDocument,
+				},
+				attr: topicAttributes(
+					withAttrDisplayName(&topicDisplayName),
+				),
+			},
+			want: topicAttributes(
+				withAttrPolicy(topicPolicyJSON),
+			),
 		},
 	}
 
@@ -302,6 +349,31 @@ func TestIsSNSTopicUpToDate(t *testing.T) {
 				p: v1alpha1.SNSTopicParameters{},
 			},
 			want: false,
+		}, "EquivalentPolicyDocument": {
+			args: args{
+				attr: topicAttributes(
+					withAttrDisplayName(&topicDisplayName),
+					withAttrPolicy(topicPolicyReformatted),
+				),
+				p: v1alpha1.SNSTopicParameters{
+					DisplayName:    &topicDisplayName,
+					PolicyDocument: topicPolicyDocument,
+				},
+			},
+			want: true,
+		},
+		"DifferentPolicyDocument": {
+			args: args{
+				attr: topicAttributes(
+					withAttrDisplayName(&topicDisplayName),
+					withAttrPolicy(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"sns:Publish","Resource":"sometopicArn"}]}`),
+				),
+				p: v1alpha1.SNSTopicParameters{
+					DisplayName:    &topicDisplayName,
+					PolicyDocument: topicPolicyDocument,
+				},
+			},
+			want: false,
 		},
 	}
 
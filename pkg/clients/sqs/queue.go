@@ -36,6 +36,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
@@ -74,6 +75,15 @@ func GenerateCreateAttributes(p *v1beta1.QueueParameters) map[string]string {
 	return m
 }
 
+// queuePolicy returns the policy of the queue, which is generated from the
+// structured policy document if it is given.
+func queuePolicy(p *v1beta1.QueueParameters) *string {
+	if p.PolicyDocument != nil {
+		return aws.String(awspolicy.Marshal(p.PolicyDocument))
+	}
+	return p.Policy
+}
+
 // GenerateQueueAttributes returns a map of queue attributes
 func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { // nolint:gocyclo
 	m := map[string]string{}
@@ -86,8 +96,8 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //
 	if p.MessageRetentionPeriod != nil {
 		m[v1beta1.AttributeMessageRetentionPeriod] = strconv.FormatInt(aws.ToInt64(p.MessageRetentionPeriod), 10)
 	}
-	if p.Policy != nil {
-		m[v1beta1.AttributePolicy] = aws.ToString(p.Policy)
+	if policy := queuePolicy(p); policy != nil {
+		m[v1beta1.AttributePolicy] = aws.ToString(policy)
 	}
 	if p.ReceiveMessageWaitTimeSeconds != nil {
 		m[v1beta1.AttributeReceiveMessageWaitTimeSeconds] = strconv.FormatInt(aws.ToInt64(p.ReceiveMessageWaitTimeSeconds), 10)
@@ -205,7 +215,8 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	if !cmp.Equal(aws.ToString(p.KMSMasterKeyID), attributes[v1beta1.AttributeKmsMasterKeyID]) {
 		return false
 	}
-	if !cmp.Equal(aws.ToString(p.Policy), attributes[v1beta1.AttributePolicy]) {
+	if policy := aws.ToString(queuePolicy(&p)); policy != attributes[v1beta1.AttributePolicy] &&
+		!awspolicy.AreEquivalent(policy, attributes[v1beta1.AttributePolicy]) {
 		return false
 	}
 	if attributes[v1beta1.AttributeContentBasedDeduplication] != "" && strconv.FormatBool(aws.ToBool(p.ContentBasedDeduplication)) != attributes[v1beta1.AttributeContentBasedDeduplication] {
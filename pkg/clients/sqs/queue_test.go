@@ -26,6 +26,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 )
 
@@ -158,6 +159,24 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"EquivalentPolicyDocument": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					PolicyDocument: &commonv1alpha1.PolicyDocument{
+						Version: "2012-10-17",
+						Statements: []commonv1alpha1.PolicyStatement{{
+							Effect:   "Allow",
+							Action:   []string{"sqs:SendMessage"},
+							Resource: []string{"*"},
+						}},
+					},
+				},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Statement":{"Action":["sqs:SendMessage"],"Effect":"Allow","Resource":"*"},"Version":"2012-10-17"}`,
+				},
+			},
+			want: true,
+		},
 		"Tags": {
 			args: args{
 				p: v1beta1.QueueParameters{
@@ -23,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsacmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
@@ -41,6 +42,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acm"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
 )
 
 const (
@@ -58,6 +60,10 @@ const (
 	errRemoveTagsFailed     = "failed to remove tags for Certificate"
 	errRenewalFailed        = "failed to renew Certificate"
 	errIneligibleForRenewal = "ineligible to renew Certificate"
+
+	errGetValidationRecord    = "cannot get DNS validation record of Certificate"
+	errUpsertValidationRecord = "cannot create DNS validation record of Certificate"
+	errDeleteValidationRecord = "cannot delete DNS validation record of Certificate"
 )
 
 // SetupCertificate adds a controller that reconciles Certificates.
@@ -72,18 +78,19 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&v1alpha1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient, newRecordClientFn: resourcerecordset.NewClient}),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
 type connector struct {
-	client      client.Client
-	newClientFn func(aws.Config) acm.Client
+	client            client.Client
+	newClientFn       func(aws.Config) acm.Client
+	newRecordClientFn func(aws.Config) resourcerecordset.Client
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -95,12 +102,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.client}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.client, records: c.newRecordClientFn(*cfg)}, nil
 }
 
 type external struct {
-	client acm.Client
-	kube   client.Client
+	client  acm.Client
+	kube    client.Client
+	records resourcerecordset.Client
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -135,12 +143,22 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
 		}
 	}
-	if certificate.Status == awsacmtypes.CertificateStatusIssued {
+	switch certificate.Status { // nolint:exhaustive
+	case awsacmtypes.CertificateStatusIssued:
 		cr.SetConditions(xpv1.Available())
+	case awsacmtypes.CertificateStatusPendingValidation:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
 	}
 
 	cr.Status.AtProvider = acm.GenerateCertificateStatus(certificate)
 
+	recordsUpToDate, err := e.areValidationRecordsUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetValidationRecord)
+	}
+
 	tags, err := e.client.ListTagsForCertificate(ctx, &awsacm.ListTagsForCertificateInput{
 		CertificateArn: aws.String(meta.GetExternalName(cr)),
 	})
@@ -149,7 +167,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	return managed.ExternalObservation{
-		ResourceUpToDate: acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags),
+		ResourceUpToDate: acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags) && recordsUpToDate,
 		ResourceExists:   true,
 	}, nil
 }
@@ -177,6 +195,15 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	if acm.IsDNSValidationManaged(cr.Spec.ForProvider) {
+		for _, r := range cr.Status.AtProvider.DomainValidationRecords {
+			params := acm.GenerateValidationRecordSetParameters(aws.ToString(cr.Spec.ForProvider.HostedZoneID), r)
+			if _, err := e.records.ChangeResourceRecordSets(ctx, resourcerecordset.GenerateChangeResourceRecordSetsInput(r.Name, params, route53types.ChangeActionUpsert)); err != nil {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpsertValidationRecord)
+			}
+		}
+	}
+
 	// Update Certificate tags
 	if len(cr.Spec.ForProvider.Tags) > 0 {
 
@@ -252,9 +279,61 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if err := e.deleteValidationRecords(ctx, cr); err != nil {
+		return errors.Wrap(err, errDeleteValidationRecord)
+	}
+
 	_, err := e.client.DeleteCertificate(ctx, &awsacm.DeleteCertificateInput{
 		CertificateArn: aws.String(meta.GetExternalName(cr)),
 	})
 
 	return awsclient.Wrap(resource.Ignore(acm.IsErrorNotFound, err), errDelete)
 }
+
+// areValidationRecordsUpToDate returns whether the DNS validation records of
+// the certificate exist in the hosted zone with the values given by ACM.
+func (e *external) areValidationRecordsUpToDate(ctx context.Context, cr *v1alpha1.Certificate) (bool, error) {
+	if !acm.IsDNSValidationManaged(cr.Spec.ForProvider) {
+		return true, nil
+	}
+	for _, r := range cr.Status.AtProvider.DomainValidationRecords {
+		params := acm.GenerateValidationRecordSetParameters(aws.ToString(cr.Spec.ForProvider.HostedZoneID), r)
+		rrset, err := resourcerecordset.GetResourceRecordSet(ctx, r.Name, params, e.records)
+		if resourcerecordset.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		upToDate, err := resourcerecordset.IsUpToDate(params, *rrset)
+		if err != nil || !upToDate {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// deleteValidationRecords deletes the DNS validation records of the
+// certificate that still exist in the hosted zone if it is requested.
+func (e *external) deleteValidationRecords(ctx context.Context, cr *v1alpha1.Certificate) error {
+	if !acm.IsDNSValidationManaged(cr.Spec.ForProvider) || !aws.ToBool(cr.Spec.ForProvider.DeleteValidationRecords) {
+		return nil
+	}
+	for _, r := range cr.Status.AtProvider.DomainValidationRecords {
+		params := acm.GenerateValidationRecordSetParameters(aws.ToString(cr.Spec.ForProvider.HostedZoneID), r)
+		rrset, err := resourcerecordset.GetResourceRecordSet(ctx, r.Name, params, e.records)
+		if resourcerecordset.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// Route53 deletes a record only if the given one matches it exactly.
+		input := resourcerecordset.GenerateChangeResourceRecordSetsInput(r.Name, params, route53types.ChangeActionDelete)
+		input.ChangeBatch.Changes[0].ResourceRecordSet = rrset
+		if _, err := e.records.ChangeResourceRecordSets(ctx, input); err != nil {
+			return err
+		}
+	}
+	return nil
+}
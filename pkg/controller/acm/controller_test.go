@@ -23,6 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsacmtype "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
@@ -36,6 +38,8 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	acm "github.com/crossplane/provider-aws/pkg/clients/acm"
 	"github.com/crossplane/provider-aws/pkg/clients/acm/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
+	rrsfake "github.com/crossplane/provider-aws/pkg/clients/resourcerecordset/fake"
 )
 
 var (
@@ -43,13 +47,17 @@ var (
 	unexpectedItem resource.Managed
 	domainName     = "some.site"
 	certificateArn = "somearn"
+	hostedZoneID   = "Z123"
+	recordName     = "_xyz.some.site."
+	recordValue    = "_xxx.zzz.acm-validations.aws."
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	acm acm.Client
-	cr  resource.Managed
+	acm     acm.Client
+	records resourcerecordset.Client
+	cr      resource.Managed
 }
 
 type certificateModifier func(*v1alpha1.Certificate)
@@ -100,6 +108,66 @@ func withStatus(status awsacmtype.CertificateStatus) certificateModifier {
 	}
 }
 
+func withDNSValidation() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Spec.ForProvider.ValidationMethod = validationMethodDNS()
+		r.Spec.ForProvider.HostedZoneID = aws.String(hostedZoneID)
+	}
+}
+
+func withDeleteValidationRecords() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Spec.ForProvider.DeleteValidationRecords = aws.Bool(true)
+	}
+}
+
+func withValidationRecord() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Status.AtProvider.DomainValidationRecords = []v1alpha1.DomainValidationRecord{{
+			DomainName: domainName,
+			Name:       recordName,
+			Type:       string(awsacmtype.RecordTypeCname),
+			Value:      recordValue,
+		}}
+	}
+}
+
+func validationMethodDNS() *awsacmtype.ValidationMethod {
+	m := awsacmtype.ValidationMethodDns
+	return &m
+}
+
+// pendingCertificate returns a certificate that is waiting for its DNS
+// validation record.
+func pendingCertificate() *awsacmtype.CertificateDetail {
+	return &awsacmtype.CertificateDetail{
+		CertificateArn: aws.String(certificateArn),
+		DomainName:     aws.String(domainName),
+		Options:        &awsacmtype.CertificateOptions{CertificateTransparencyLoggingPreference: awsacmtype.CertificateTransparencyLoggingPreferenceDisabled},
+		Status:         awsacmtype.CertificateStatusPendingValidation,
+		DomainValidationOptions: []awsacmtype.DomainValidation{{
+			DomainName:       aws.String(domainName),
+			ValidationDomain: aws.String(domainName),
+			ValidationMethod: awsacmtype.ValidationMethodDns,
+			ResourceRecord: &awsacmtype.ResourceRecord{
+				Name:  aws.String(recordName),
+				Type:  awsacmtype.RecordTypeCname,
+				Value: aws.String(recordValue),
+			},
+		}},
+	}
+}
+
+// observedPendingCertificate returns the state of the certificate after the
+// pending certificate is observed.
+func observedPendingCertificate() *v1alpha1.Certificate {
+	cr := certificate(withDomainName(), withCertificateArn(), withDNSValidation(), withValidationRecord(),
+		withStatus(awsacmtype.CertificateStatusPendingValidation),
+		withConditions(xpv1.Creating()))
+	cr.Spec.ForProvider.DomainValidationOptions = []*v1alpha1.DomainValidationOption{{DomainName: domainName, ValidationDomain: domainName}}
+	return cr
+}
+
 func certificate(m ...certificateModifier) *v1alpha1.Certificate {
 	cr := &v1alpha1.Certificate{}
 	meta.SetExternalName(cr, certificateArn)
@@ -149,6 +217,63 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ValidationRecordMissing": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(ctx context.Context, input *awsacm.DescribeCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{Certificate: pendingCertificate()}, nil
+					},
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: func(ctx context.Context, input *route53.ListResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+						return &route53.ListResourceRecordSetsOutput{}, nil
+					},
+				},
+				cr: certificate(withDomainName(), withDNSValidation()),
+			},
+			want: want{
+				cr: observedPendingCertificate(),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ValidationRecordExists": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(ctx context.Context, input *awsacm.DescribeCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{Certificate: pendingCertificate()}, nil
+					},
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: func(ctx context.Context, input *route53.ListResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+						return &route53.ListResourceRecordSetsOutput{
+							ResourceRecordSets: []route53types.ResourceRecordSet{{
+								Name:            aws.String(recordName),
+								Type:            route53types.RRTypeCname,
+								TTL:             aws.Int64(300),
+								ResourceRecords: []route53types.ResourceRecord{{Value: aws.String(recordValue)}},
+							}},
+						}, nil
+					},
+				},
+				cr: certificate(withDomainName(), withDNSValidation()),
+			},
+			want: want{
+				cr: observedPendingCertificate(),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -190,7 +315,8 @@ func TestObserve(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &external{
-				client: tc.acm,
+				client:  tc.acm,
+				records: tc.records,
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
@@ -328,6 +454,39 @@ func TestUpdate(t *testing.T) {
 				cr: certificate(),
 			},
 		},
+		"UpsertValidationRecord": {
+			args: args{
+				acm: &fake.MockCertificateClient{},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						c := input.ChangeBatch.Changes[0]
+						if c.Action != route53types.ChangeActionUpsert || aws.ToString(c.ResourceRecordSet.Name) != recordName {
+							return nil, errBoom
+						}
+						return &route53.ChangeResourceRecordSetsOutput{}, nil
+					},
+				},
+				cr: certificate(withDNSValidation(), withValidationRecord()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withValidationRecord()),
+			},
+		},
+		"UpsertValidationRecordError": {
+			args: args{
+				acm: &fake.MockCertificateClient{},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withDNSValidation(), withValidationRecord()),
+			},
+			want: want{
+				cr:  certificate(withDNSValidation(), withValidationRecord()),
+				err: awsclient.Wrap(errBoom, errUpsertValidationRecord),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -399,7 +558,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acm}
+			e := &external{client: tc.acm, records: tc.records}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -440,6 +599,60 @@ func TestDelete(t *testing.T) {
 					withConditions(xpv1.Deleting())),
 			},
 		},
+		"DeleteValidationRecord": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(ctx context.Context, input *awsacm.DeleteCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return &awsacm.DeleteCertificateOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: func(ctx context.Context, input *route53.ListResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+						return &route53.ListResourceRecordSetsOutput{
+							ResourceRecordSets: []route53types.ResourceRecordSet{{
+								Name:            aws.String(recordName),
+								Type:            route53types.RRTypeCname,
+								TTL:             aws.Int64(300),
+								ResourceRecords: []route53types.ResourceRecord{{Value: aws.String(recordValue)}},
+							}},
+						}, nil
+					},
+					MockChangeResourceRecordSets: func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						if input.ChangeBatch.Changes[0].Action != route53types.ChangeActionDelete {
+							return nil, errBoom
+						}
+						return &route53.ChangeResourceRecordSetsOutput{}, nil
+					},
+				},
+				cr: certificate(withDNSValidation(), withDeleteValidationRecords(), withValidationRecord()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withDeleteValidationRecords(), withValidationRecord(),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"KeepValidationRecord": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(ctx context.Context, input *awsacm.DeleteCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return &awsacm.DeleteCertificateOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: func(ctx context.Context, input *route53.ListResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+					MockChangeResourceRecordSets: func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withDNSValidation(), withValidationRecord()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withValidationRecord(),
+					withConditions(xpv1.Deleting())),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -480,7 +693,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acm}
+			e := &external{client: tc.acm, records: tc.records}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importedcertificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	awsacmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/acm"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an ImportedCertificate resource"
+	errDescribe         = "cannot describe Certificate"
+	errGetCertificate   = "cannot get Certificate"
+	errImport           = "cannot import Certificate"
+	errDelete           = "cannot delete Certificate"
+	errGetSecret        = "cannot get certificate secret"
+	errMaterial         = "cannot read certificate material from secret"
+	errListTags         = "cannot list tags of Certificate"
+	errAddTags          = "cannot add tags to Certificate"
+	errRemoveTags       = "cannot remove tags from Certificate"
+)
+
+// SetupImportedCertificate adds a controller that reconciles
+// ImportedCertificates.
+func SetupImportedCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ImportedCertificateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.ImportedCertificate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ImportedCertificateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithPollInterval(poll),
+			managed.WithInitializers(awsclient.NewDefaultTagger(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(aws.Config) acm.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ImportedCertificate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client acm.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ImportedCertificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.DescribeCertificate(ctx, &awsacm.DescribeCertificateInput{
+		CertificateArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(acm.IsErrorNotFound, err), errDescribe)
+	}
+	if rsp.Certificate == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.AtProvider = acm.GenerateImportedCertificateObservation(*rsp.Certificate)
+	if rsp.Certificate.Status == awsacmtypes.CertificateStatusIssued {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	m, err := e.getCertificateMaterial(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	current, err := e.client.GetCertificate(ctx, &awsacm.GetCertificateInput{
+		CertificateArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetCertificate)
+	}
+	tags, err := e.client.ListTagsForCertificate(ctx, &awsacm.ListTagsForCertificateInput{
+		CertificateArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := acm.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: acm.IsCertificateMaterialUpToDate(m, *current) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ImportedCertificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	m, err := e.getCertificateMaterial(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	rsp, err := e.client.ImportCertificate(ctx, acm.GenerateImportCertificateInput("", m, cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errImport)
+	}
+	meta.SetExternalName(cr, aws.ToString(rsp.CertificateArn))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ImportedCertificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	arn := aws.String(meta.GetExternalName(cr))
+
+	m, err := e.getCertificateMaterial(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	current, err := e.client.GetCertificate(ctx, &awsacm.GetCertificateInput{CertificateArn: arn})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetCertificate)
+	}
+	if !acm.IsCertificateMaterialUpToDate(m, *current) {
+		if _, err := e.client.ImportCertificate(ctx, acm.GenerateImportCertificateInput(meta.GetExternalName(cr), m, cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errImport)
+		}
+	}
+
+	tags, err := e.client.ListTagsForCertificate(ctx, &awsacm.ListTagsForCertificateInput{CertificateArn: arn})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := acm.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsFromCertificate(ctx, &awsacm.RemoveTagsFromCertificateInput{CertificateArn: arn, Tags: remove}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsToCertificate(ctx, &awsacm.AddTagsToCertificateInput{CertificateArn: arn, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ImportedCertificate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteCertificate(ctx, &awsacm.DeleteCertificateInput{
+		CertificateArn: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(acm.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) getCertificateMaterial(ctx context.Context, cr *v1alpha1.ImportedCertificate) (acm.CertificateMaterial, error) {
+	ref := cr.Spec.ForProvider.CertificateSecretRef
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return acm.CertificateMaterial{}, errors.Wrap(err, errGetSecret)
+	}
+	m, err := acm.GetCertificateMaterial(s.Data)
+	return m, errors.Wrap(err, errMaterial)
+}
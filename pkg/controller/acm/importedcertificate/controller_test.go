@@ -0,0 +1,445 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importedcertificate
+
+import (
+	"context"
+	"encoding/pem"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	awsacmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/acm"
+	"github.com/crossplane/provider-aws/pkg/clients/acm/fake"
+)
+
+var (
+	certificateArn = "arn:aws:acm:us-east-1:123456789012:certificate/abc"
+	domainName     = "dev.crossplane.io"
+	privateKey     = []byte("key")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	acm  acm.Client
+	kube client.Client
+	cr   resource.Managed
+}
+
+type certificateModifier func(*v1alpha1.ImportedCertificate)
+
+func withExternalName(s string) certificateModifier {
+	return func(r *v1alpha1.ImportedCertificate) { meta.SetExternalName(r, s) }
+}
+
+func withConditions(c ...xpv1.Condition) certificateModifier {
+	return func(r *v1alpha1.ImportedCertificate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ImportedCertificateObservation) certificateModifier {
+	return func(r *v1alpha1.ImportedCertificate) { r.Status.AtProvider = o }
+}
+
+func certificate(m ...certificateModifier) *v1alpha1.ImportedCertificate {
+	cr := &v1alpha1.ImportedCertificate{}
+	cr.Spec.ForProvider.CertificateSecretRef = xpv1.SecretReference{Name: "tls", Namespace: "default"}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func pemCert(s string) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(s)})
+}
+
+// tlsSecret returns a Get function that serves a TLS secret with the given
+// certificate.
+func tlsSecret(cert string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		s := corev1.Secret{Data: map[string][]byte{
+			corev1.TLSCertKey:       pemCert(cert),
+			corev1.TLSPrivateKeyKey: privateKey,
+		}}
+		s.DeepCopyInto(obj.(*corev1.Secret))
+		return nil
+	}
+}
+
+func getCertificate(cert string) func(context.Context, *awsacm.GetCertificateInput, []func(*awsacm.Options)) (*awsacm.GetCertificateOutput, error) {
+	return func(context.Context, *awsacm.GetCertificateInput, []func(*awsacm.Options)) (*awsacm.GetCertificateOutput, error) {
+		return &awsacm.GetCertificateOutput{Certificate: aws.String(string(pemCert(cert)))}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotImported": {
+			args: args{
+				cr: certificate(),
+			},
+			want: want{
+				cr: certificate(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{MockGet: tlsSecret("leaf")},
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(context.Context, *awsacm.DescribeCertificateInput, []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{Certificate: &awsacmtypes.CertificateDetail{
+							CertificateArn: aws.String(certificateArn),
+							DomainName:     aws.String(domainName),
+							Status:         awsacmtypes.CertificateStatusIssued,
+						}}, nil
+					},
+					MockGetCertificate: getCertificate("leaf"),
+					MockListTagsForCertificate: func(context.Context, *awsacm.ListTagsForCertificateInput, []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn),
+					withObservation(v1alpha1.ImportedCertificateObservation{
+						CertificateARN: certificateArn,
+						DomainName:     domainName,
+						Status:         awsacmtypes.CertificateStatusIssued,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecretChanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: tlsSecret("renewed")},
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(context.Context, *awsacm.DescribeCertificateInput, []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{Certificate: &awsacmtypes.CertificateDetail{
+							CertificateArn: aws.String(certificateArn),
+							Status:         awsacmtypes.CertificateStatusExpired,
+						}}, nil
+					},
+					MockGetCertificate: getCertificate("leaf"),
+					MockListTagsForCertificate: func(context.Context, *awsacm.ListTagsForCertificateInput, []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn),
+					withObservation(v1alpha1.ImportedCertificateObservation{
+						CertificateARN: certificateArn,
+						Status:         awsacmtypes.CertificateStatusExpired,
+					}),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(context.Context, *awsacm.DescribeCertificateInput, []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return nil, &awsacmtypes.ResourceNotFoundException{}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(context.Context, *awsacm.DescribeCertificateInput, []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr:  certificate(withExternalName(certificateArn)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acm, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{MockGet: tlsSecret("leaf")},
+				acm: &fake.MockCertificateClient{
+					MockImportCertificate: func(_ context.Context, input *awsacm.ImportCertificateInput, _ []func(*awsacm.Options)) (*awsacm.ImportCertificateOutput, error) {
+						if input.CertificateArn != nil {
+							return nil, errBoom
+						}
+						return &awsacm.ImportCertificateOutput{CertificateArn: aws.String(certificateArn)}, nil
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:     certificate(withExternalName(certificateArn), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"SecretError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   certificate(),
+			},
+			want: want{
+				cr:  certificate(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"ImportError": {
+			args: args{
+				kube: &test.MockClient{MockGet: tlsSecret("leaf")},
+				acm: &fake.MockCertificateClient{
+					MockImportCertificate: func(context.Context, *awsacm.ImportCertificateInput, []func(*awsacm.Options)) (*awsacm.ImportCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:  certificate(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errImport),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acm, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Reimport": {
+			args: args{
+				kube: &test.MockClient{MockGet: tlsSecret("renewed")},
+				acm: &fake.MockCertificateClient{
+					MockGetCertificate: getCertificate("leaf"),
+					MockImportCertificate: func(_ context.Context, input *awsacm.ImportCertificateInput, _ []func(*awsacm.Options)) (*awsacm.ImportCertificateOutput, error) {
+						if aws.ToString(input.CertificateArn) != certificateArn {
+							return nil, errBoom
+						}
+						return &awsacm.ImportCertificateOutput{CertificateArn: aws.String(certificateArn)}, nil
+					},
+					MockListTagsForCertificate: func(context.Context, *awsacm.ListTagsForCertificateInput, []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn)),
+			},
+		},
+		"UpdateTags": {
+			args: args{
+				kube: &test.MockClient{MockGet: tlsSecret("leaf")},
+				acm: &fake.MockCertificateClient{
+					MockGetCertificate: getCertificate("leaf"),
+					MockListTagsForCertificate: func(context.Context, *awsacm.ListTagsForCertificateInput, []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{Tags: []awsacmtypes.Tag{{Key: aws.String("old"), Value: aws.String("v")}}}, nil
+					},
+					MockRemoveTagsFromCertificate: func(context.Context, *awsacm.RemoveTagsFromCertificateInput, []func(*awsacm.Options)) (*awsacm.RemoveTagsFromCertificateOutput, error) {
+						return &awsacm.RemoveTagsFromCertificateOutput{}, nil
+					},
+					MockAddTagsToCertificate: func(context.Context, *awsacm.AddTagsToCertificateInput, []func(*awsacm.Options)) (*awsacm.AddTagsToCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withExternalName(certificateArn), func(r *v1alpha1.ImportedCertificate) {
+					r.Spec.ForProvider.Tags = []v1alpha1.Tag{{Key: "new", Value: "v"}}
+				}),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), func(r *v1alpha1.ImportedCertificate) {
+					r.Spec.ForProvider.Tags = []v1alpha1.Tag{{Key: "new", Value: "v"}}
+				}),
+				err: awsclient.Wrap(errBoom, errAddTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acm, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(context.Context, *awsacm.DeleteCertificateInput, []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return &awsacm.DeleteCertificateOutput{}, nil
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(context.Context, *awsacm.DeleteCertificateInput, []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return nil, &awsacmtypes.ResourceNotFoundException{}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(context.Context, *awsacm.DeleteCertificateInput, []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr:  certificate(withExternalName(certificateArn), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acm, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -56,6 +56,13 @@ const (
 	errListTagsFailed       = "failed to list tags for ACMPCA"
 	errRemoveTagsFailed     = "failed to remove tags for ACMPCA"
 	errCertificateAuthority = "failed to update the ACMPCA resource"
+
+	errGetCACertificate = "cannot get the CA certificate of ACMPCA"
+	errGetCsr           = "cannot get the certificate signing request of ACMPCA"
+	errGetIssuer        = "cannot get the issuing ACMPCA"
+	errIssueCertificate = "cannot issue the CA certificate of ACMPCA"
+	errGetCertificate   = "cannot get the issued CA certificate of ACMPCA"
+	errImportCert       = "cannot import the CA certificate of ACMPCA"
 )
 
 // SetupCertificateAuthority adds a controller that reconciles ACMPCA.
@@ -71,12 +78,11 @@ func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger, rl workqueue.
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityGroupVersionKind),
 			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
-			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 
 			// TODO: implement tag initializer
 
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -140,7 +146,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		cr.SetConditions(xpv1.Available())
 	}
 
+	activationCertificateARN := cr.Status.AtProvider.ActivationCertificateARN
 	cr.Status.AtProvider = acmpca.GenerateCertificateAuthorityExternalStatus(certificateAuthority)
+	cr.Status.AtProvider.ActivationCertificateARN = activationCertificateARN
 
 	tags, err := e.client.ListTags(ctx, &awsacmpca.ListTagsInput{
 		CertificateAuthorityArn: aws.String(meta.GetExternalName(cr)),
@@ -150,9 +158,22 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(acmpca.IsErrorNotFound, err), errListTagsFailed)
 	}
 
+	var conn managed.ConnectionDetails
+	if acmpca.HasCACertificate(cr.Status.AtProvider.Status) {
+		cert, err := e.client.GetCertificateAuthorityCertificate(ctx, &awsacmpca.GetCertificateAuthorityCertificateInput{
+			CertificateAuthorityArn: aws.String(meta.GetExternalName(cr)),
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetCACertificate)
+		}
+		conn = acmpca.GetConnectionDetails(cert)
+	}
+
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags),
+		ResourceExists: true,
+		ResourceUpToDate: !acmpca.IsActivationPending(cr.Spec.ForProvider, cr.Status.AtProvider.Status) &&
+			acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags),
+		ConnectionDetails: conn,
 	}, nil
 }
 
@@ -179,6 +200,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	// The rest of the configuration is updated once the CA is activated.
+	if acmpca.IsActivationPending(cr.Spec.ForProvider, cr.Status.AtProvider.Status) {
+		return managed.ExternalUpdate{}, e.activate(ctx, cr)
+	}
+
 	// Update the Certificate Authority tags
 	if len(cr.Spec.ForProvider.Tags) > 0 {
 		tags := make([]awsacmpcatypes.Tag, len(cr.Spec.ForProvider.Tags))
@@ -220,6 +246,72 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errCertificateAuthority)
 }
 
+// activate issues the CA certificate from the CSR of the certificate authority
+// and imports it. The certificate is issued asynchronously, so its ARN is kept
+// in the status and it is imported in a later reconciliation if it is not
+// ready yet.
+func (e *external) activate(ctx context.Context, cr *v1alpha1.CertificateAuthority) error {
+	arn := meta.GetExternalName(cr)
+	issuer, err := acmpca.GetIssuerARN(cr.Spec.ForProvider, arn)
+	if err != nil {
+		return err
+	}
+
+	if cr.Status.AtProvider.ActivationCertificateARN == "" {
+		csr, err := e.client.GetCertificateAuthorityCsr(ctx, &awsacmpca.GetCertificateAuthorityCsrInput{
+			CertificateAuthorityArn: aws.String(arn),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, errGetCsr)
+		}
+		alg, err := e.getSigningAlgorithm(ctx, cr, issuer)
+		if err != nil {
+			return err
+		}
+		out, err := e.client.IssueCertificate(ctx, acmpca.GenerateIssueCertificateInput(cr.Spec.ForProvider, string(cr.GetUID()), issuer, alg, []byte(aws.ToString(csr.Csr))))
+		if err != nil {
+			return awsclient.Wrap(err, errIssueCertificate)
+		}
+		cr.Status.AtProvider.ActivationCertificateARN = aws.ToString(out.CertificateArn)
+	}
+
+	cert, err := e.client.GetCertificate(ctx, &awsacmpca.GetCertificateInput{
+		CertificateArn:          aws.String(cr.Status.AtProvider.ActivationCertificateARN),
+		CertificateAuthorityArn: aws.String(issuer),
+	})
+	if acmpca.IsErrorRequestInProgress(err) {
+		return nil
+	}
+	if err != nil {
+		return awsclient.Wrap(err, errGetCertificate)
+	}
+
+	_, err = e.client.ImportCertificateAuthorityCertificate(ctx, acmpca.GenerateImportCertificateAuthorityCertificateInput(cr.Spec.ForProvider, arn, cert))
+	return awsclient.Wrap(err, errImportCert)
+}
+
+// getSigningAlgorithm returns the algorithm the CA certificate is signed with.
+// A ROOT CA signs its own certificate with its own algorithm while the
+// algorithm of the parent is used for SUBORDINATE CAs unless one is given.
+func (e *external) getSigningAlgorithm(ctx context.Context, cr *v1alpha1.CertificateAuthority, issuer string) (awsacmpcatypes.SigningAlgorithm, error) {
+	if cr.Spec.ForProvider.Activation.SigningAlgorithm != nil {
+		return *cr.Spec.ForProvider.Activation.SigningAlgorithm, nil
+	}
+	if issuer == meta.GetExternalName(cr) {
+		return cr.Spec.ForProvider.CertificateAuthorityConfiguration.SigningAlgorithm, nil
+	}
+	out, err := e.client.DescribeCertificateAuthority(ctx, &awsacmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(issuer),
+	})
+	if err != nil {
+		return "", awsclient.Wrap(err, errGetIssuer)
+	}
+	if out.CertificateAuthority == nil || out.CertificateAuthority.CertificateAuthorityConfiguration == nil {
+		return "", errors.New(errGetIssuer)
+	}
+	return out.CertificateAuthority.CertificateAuthorityConfiguration.SigningAlgorithm, nil
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1alpha1.CertificateAuthority)
 	if !ok {
@@ -56,6 +56,11 @@ var (
 	state                      = "someState"
 	surname                    = "someSurname"
 	title                      = "someTitle"
+	parentArn                  = "someparentarn"
+	activationCertificateArn   = "someactivationcertificatearn"
+	csr                        = "somecsr"
+	caCertificate              = "somecertificate"
+	caCertificateChain         = "somecertificatechain"
 
 	errBoom = errors.New("boom")
 )
@@ -102,6 +107,25 @@ func withCertificateAuthorityStatus() certificateAuthorityModifier {
 	}
 }
 
+func withActivation(parent *string) certificateAuthorityModifier {
+	return func(r *v1alpha1.CertificateAuthority) {
+		r.Spec.ForProvider.Activation = &v1alpha1.Activation{
+			Validity:                      v1alpha1.Validity{Type: awsacmpcatypes.ValidityPeriodTypeYears, Value: 10},
+			ParentCertificateAuthorityARN: parent,
+		}
+		if parent != nil {
+			r.Spec.ForProvider.Type = awsacmpcatypes.CertificateAuthorityTypeSubordinate
+		}
+		r.Status.AtProvider.Status = string(awsacmpcatypes.CertificateAuthorityStatusPendingCertificate)
+	}
+}
+
+func withActivationCertificateArn() certificateAuthorityModifier {
+	return func(r *v1alpha1.CertificateAuthority) {
+		r.Status.AtProvider.ActivationCertificateARN = activationCertificateArn
+	}
+}
+
 func certificateAuthority(m ...certificateAuthorityModifier) *v1alpha1.CertificateAuthority {
 	cr := &v1alpha1.CertificateAuthority{}
 	meta.SetExternalName(cr, certificateAuthorityArn)
@@ -165,11 +189,51 @@ func TestObserve(t *testing.T) {
 							Tags:      []awsacmpcatypes.Tag{{}},
 						}, nil
 					},
+					MockGetCertificateAuthorityCertificate: func(ctx context.Context, input *awsacmpca.GetCertificateAuthorityCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateAuthorityCertificateOutput, error) {
+						return &awsacmpca.GetCertificateAuthorityCertificateOutput{
+							Certificate: aws.String(caCertificate),
+						}, nil
+					},
 				},
 				cr: certificateAuthority(),
 			},
 			want: want{
 				cr: certificateAuthority(withCertificateAuthorityType(), withCertificateAuthorityStatus(), withCertificateAuthorityAtProviderStatus("ACTIVE"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretCertificateKey: []byte(caCertificate),
+					},
+				},
+			},
+		},
+		"PendingActivation": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockDescribeCertificateAuthority: func(ctx context.Context, input *awsacmpca.DescribeCertificateAuthorityInput, opts []func(*awsacmpca.Options)) (*awsacmpca.DescribeCertificateAuthorityOutput, error) {
+						return &awsacmpca.DescribeCertificateAuthorityOutput{
+							CertificateAuthority: &awsacmpcatypes.CertificateAuthority{
+								Arn:    aws.String(certificateAuthorityArn),
+								Type:   awsacmpcatypes.CertificateAuthorityTypeRoot,
+								Status: awsacmpcatypes.CertificateAuthorityStatusPendingCertificate,
+								RevocationConfiguration: &awsacmpcatypes.RevocationConfiguration{
+									CrlConfiguration: &awsacmpcatypes.CrlConfiguration{},
+								},
+								CertificateAuthorityConfiguration: &awsacmpcatypes.CertificateAuthorityConfiguration{
+									Subject: &awsacmpcatypes.ASN1Subject{},
+								},
+							},
+						}, nil
+					},
+					MockListTags: func(ctx context.Context, input *awsacmpca.ListTagsInput, opts []func(*awsacmpca.Options)) (*awsacmpca.ListTagsOutput, error) {
+						return &awsacmpca.ListTagsOutput{}, nil
+					},
+				},
+				cr: certificateAuthority(withActivation(nil), withActivationCertificateArn()),
+			},
+			want: want{
+				cr: certificateAuthority(withActivation(nil), withActivationCertificateArn(),
+					withCertificateAuthorityType(), withCertificateAuthorityAtProviderStatus("PENDING_CERTIFICATE")),
 				result: managed.ExternalObservation{
 					ResourceExists: true,
 				},
@@ -362,6 +426,108 @@ func TestUpdate(t *testing.T) {
 				cr: certificateAuthority(withCertificateAuthorityStatus()),
 			},
 		},
+		"ActivateRoot": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsr: func(ctx context.Context, input *awsacmpca.GetCertificateAuthorityCsrInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateAuthorityCsrOutput, error) {
+						return &awsacmpca.GetCertificateAuthorityCsrOutput{Csr: aws.String(csr)}, nil
+					},
+					MockIssueCertificate: func(ctx context.Context, input *awsacmpca.IssueCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.IssueCertificateOutput, error) {
+						if aws.ToString(input.CertificateAuthorityArn) != certificateAuthorityArn || aws.ToString(input.TemplateArn) != acmpca.RootCACertificateTemplateARN {
+							return nil, errBoom
+						}
+						return &awsacmpca.IssueCertificateOutput{CertificateArn: aws.String(activationCertificateArn)}, nil
+					},
+					MockGetCertificate: func(ctx context.Context, input *awsacmpca.GetCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateOutput, error) {
+						return &awsacmpca.GetCertificateOutput{Certificate: aws.String(caCertificate), CertificateChain: aws.String(caCertificateChain)}, nil
+					},
+					MockImportCertificateAuthorityCertificate: func(ctx context.Context, input *awsacmpca.ImportCertificateAuthorityCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.ImportCertificateAuthorityCertificateOutput, error) {
+						if string(input.Certificate) != caCertificate || input.CertificateChain != nil {
+							return nil, errBoom
+						}
+						return &awsacmpca.ImportCertificateAuthorityCertificateOutput{}, nil
+					},
+				},
+				cr: certificateAuthority(withActivation(nil)),
+			},
+			want: want{
+				cr: certificateAuthority(withActivation(nil), withActivationCertificateArn()),
+			},
+		},
+		"ActivationCertificateInProgress": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsr: func(ctx context.Context, input *awsacmpca.GetCertificateAuthorityCsrInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateAuthorityCsrOutput, error) {
+						return &awsacmpca.GetCertificateAuthorityCsrOutput{Csr: aws.String(csr)}, nil
+					},
+					MockIssueCertificate: func(ctx context.Context, input *awsacmpca.IssueCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.IssueCertificateOutput, error) {
+						return &awsacmpca.IssueCertificateOutput{CertificateArn: aws.String(activationCertificateArn)}, nil
+					},
+					MockGetCertificate: func(ctx context.Context, input *awsacmpca.GetCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateOutput, error) {
+						return nil, &awsacmpcatypes.RequestInProgressException{}
+					},
+				},
+				cr: certificateAuthority(withActivation(nil)),
+			},
+			want: want{
+				cr: certificateAuthority(withActivation(nil), withActivationCertificateArn()),
+			},
+		},
+		"ActivateSubordinate": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsr: func(ctx context.Context, input *awsacmpca.GetCertificateAuthorityCsrInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateAuthorityCsrOutput, error) {
+						return &awsacmpca.GetCertificateAuthorityCsrOutput{Csr: aws.String(csr)}, nil
+					},
+					MockDescribeCertificateAuthority: func(ctx context.Context, input *awsacmpca.DescribeCertificateAuthorityInput, opts []func(*awsacmpca.Options)) (*awsacmpca.DescribeCertificateAuthorityOutput, error) {
+						return &awsacmpca.DescribeCertificateAuthorityOutput{
+							CertificateAuthority: &awsacmpcatypes.CertificateAuthority{
+								CertificateAuthorityConfiguration: &awsacmpcatypes.CertificateAuthorityConfiguration{
+									SigningAlgorithm: awsacmpcatypes.SigningAlgorithmSha256withrsa,
+								},
+							},
+						}, nil
+					},
+					MockIssueCertificate: func(ctx context.Context, input *awsacmpca.IssueCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.IssueCertificateOutput, error) {
+						if aws.ToString(input.CertificateAuthorityArn) != parentArn || input.SigningAlgorithm != awsacmpcatypes.SigningAlgorithmSha256withrsa ||
+							aws.ToString(input.TemplateArn) != acmpca.SubordinateCACertificateTemplateARN {
+							return nil, errBoom
+						}
+						return &awsacmpca.IssueCertificateOutput{CertificateArn: aws.String(activationCertificateArn)}, nil
+					},
+					MockGetCertificate: func(ctx context.Context, input *awsacmpca.GetCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateOutput, error) {
+						return &awsacmpca.GetCertificateOutput{Certificate: aws.String(caCertificate), CertificateChain: aws.String(caCertificateChain)}, nil
+					},
+					MockImportCertificateAuthorityCertificate: func(ctx context.Context, input *awsacmpca.ImportCertificateAuthorityCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.ImportCertificateAuthorityCertificateOutput, error) {
+						if string(input.CertificateChain) != caCertificateChain {
+							return nil, errBoom
+						}
+						return &awsacmpca.ImportCertificateAuthorityCertificateOutput{}, nil
+					},
+				},
+				cr: certificateAuthority(withActivation(aws.String(parentArn))),
+			},
+			want: want{
+				cr: certificateAuthority(withActivation(aws.String(parentArn)), withActivationCertificateArn()),
+			},
+		},
+		"ImportError": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificate: func(ctx context.Context, input *awsacmpca.GetCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateOutput, error) {
+						return &awsacmpca.GetCertificateOutput{Certificate: aws.String(caCertificate)}, nil
+					},
+					MockImportCertificateAuthorityCertificate: func(ctx context.Context, input *awsacmpca.ImportCertificateAuthorityCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.ImportCertificateAuthorityCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificateAuthority(withActivation(nil), withActivationCertificateArn()),
+			},
+			want: want{
+				cr:  certificateAuthority(withActivation(nil), withActivationCertificateArn()),
+				err: awsclient.Wrap(errBoom, errImportCert),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
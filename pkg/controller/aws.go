@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
+	"github.com/crossplane/provider-aws/pkg/controller/acm/importedcertificate"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
@@ -58,6 +59,7 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/address"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/natgateway"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
@@ -86,6 +88,7 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgrouppolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroupusermembership"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/iaminstanceprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iampolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrole"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrolepolicyattachment"
@@ -93,6 +96,7 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserpolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/openidconnectprovider"
 	kafkacluster "github.com/crossplane/provider-aws/pkg/controller/kafka/cluster"
+	kafkaconfiguration "github.com/crossplane/provider-aws/pkg/controller/kafka/configuration"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/alias"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
 	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
@@ -111,6 +115,7 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverrule"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketobject"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
@@ -143,11 +148,13 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		nodegroup.SetupNodeGroup,
 		s3.SetupBucket,
 		bucketpolicy.SetupBucketPolicy,
+		bucketobject.SetupBucketObject,
 		iamaccesskey.SetupIAMAccessKey,
 		iamuser.SetupIAMUser,
 		iamgroup.SetupIAMGroup,
 		iampolicy.SetupIAMPolicy,
 		iamrole.SetupIAMRole,
+		iaminstanceprofile.SetupIAMInstanceProfile,
 		iamgroupusermembership.SetupIAMGroupUserMembership,
 		iamuserpolicyattachment.SetupIAMUserPolicyAttachment,
 		iamgrouppolicyattachment.SetupIAMGroupPolicyAttachment,
@@ -162,6 +169,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		certificateauthority.SetupCertificateAuthority,
 		certificateauthoritypermission.SetupCertificateAuthorityPermission,
 		acm.SetupCertificate,
+		importedcertificate.SetupImportedCertificate,
 		resourcerecordset.SetupResourceRecordSet,
 		hostedzone.SetupHostedZone,
 		secret.SetupSecret,
@@ -209,7 +217,9 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		resolverendpoint.SetupResolverEndpoint,
 		resolverrule.SetupResolverRule,
 		vpcpeeringconnection.SetupVPCPeeringConnection,
+		launchtemplate.SetupLaunchTemplate,
 		kafkacluster.SetupCluster,
+		kafkaconfiguration.SetupConfiguration,
 		efsmounttarget.SetupMountTarget,
 		transferserver.SetupServer,
 		transferuser.SetupUser,
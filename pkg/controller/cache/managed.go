@@ -43,6 +43,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
 )
 
 // Error strings.
@@ -55,6 +56,7 @@ const (
 	errCreateReplicationGroup   = "cannot create ElastiCache replication group"
 	errModifyReplicationGroup   = "cannot modify ElastiCache replication group"
 	errDeleteReplicationGroup   = "cannot delete ElastiCache replication group"
+	errUpdateAuthToken          = "cannot update ElastiCache replication group auth token"
 )
 
 // SetupReplicationGroup adds a controller that reconciles ReplicationGroups.
@@ -70,7 +72,7 @@ func SetupReplicationGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}, awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -131,8 +133,32 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateReplicationGroupCR)
 		}
 	}
+	token, tokenChanged, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.AuthTokenSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	strategy, hash := cr.Status.AtProvider.AuthTokenUpdateStrategy, cr.Status.AtProvider.AuthTokenUpdateHash
 	cr.Status.AtProvider = elasticache.GenerateObservation(rg)
 
+	// The connection secret keeps the old token until the rotation to the
+	// new one is completed.
+	if tokenChanged && strategy == string(awselasticachetypes.AuthTokenUpdateStrategyTypeSet) &&
+		hash == elasticache.AuthTokenHash(token) && elasticache.IsAuthTokenUpdateCompleted(cr.Status.AtProvider) {
+		tokenChanged = false
+	}
+	if !tokenChanged {
+		strategy, hash = "", ""
+	}
+	cr.Status.AtProvider.AuthTokenUpdateStrategy = strategy
+	cr.Status.AtProvider.AuthTokenUpdateHash = hash
+	conn := elasticache.ConnectionEndpoint(rg)
+	if token != "" && !tokenChanged {
+		if conn == nil {
+			conn = managed.ConnectionDetails{}
+		}
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(token)
+	}
+
 	switch cr.Status.AtProvider.Status {
 	case v1beta1.StatusAvailable:
 		cr.Status.SetConditions(xpv1.Available())
@@ -146,8 +172,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList),
-		ConnectionDetails: elasticache.ConnectionEndpoint(rg),
+		ResourceUpToDate:  !tokenChanged && !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList),
+		ConnectionDetails: conn,
 	}, nil
 }
 
@@ -165,9 +191,15 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// is required.
 	var token *string
 	if aws.ToBool(cr.Spec.ForProvider.AuthEnabled) {
-		t, err := password.Generate()
+		t, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.AuthTokenSecretRef, cr.Spec.WriteConnectionSecretToReference)
 		if err != nil {
-			return managed.ExternalCreation{}, awsclient.Wrap(err, errGenerateAuthToken)
+			return managed.ExternalCreation{}, err
+		}
+		if t == "" {
+			t, err = password.Generate()
+			if err != nil {
+				return managed.ExternalCreation{}, awsclient.Wrap(err, errGenerateAuthToken)
+			}
 		}
 		token = &t
 	}
@@ -195,10 +227,38 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status != v1beta1.StatusAvailable {
 		return managed.ExternalUpdate{}, nil
 	}
-	_, err := e.client.ModifyReplicationGroup(ctx, elasticache.NewModifyReplicationGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	token, tokenChanged, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.AuthTokenSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The rest of the modifications are made after the auth token rotation
+	// is completed.
+	if tokenChanged {
+		return managed.ExternalUpdate{}, e.updateAuthToken(ctx, cr, token)
+	}
+	_, err = e.client.ModifyReplicationGroup(ctx, elasticache.NewModifyReplicationGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyReplicationGroup)
 }
 
+// updateAuthToken makes the next step of the auth token rotation once the
+// previous one is completed. The hash of the token is stored with the step so
+// that the rotation starts over if the token changes in the meantime.
+func (e *external) updateAuthToken(ctx context.Context, cr *v1beta1.ReplicationGroup, token string) error {
+	if !elasticache.IsAuthTokenUpdateCompleted(cr.Status.AtProvider) {
+		return nil
+	}
+	strategy := elasticache.NextAuthTokenUpdateStrategy(cr.Status.AtProvider, token)
+	if strategy == "" {
+		return nil
+	}
+	if _, err := e.client.ModifyReplicationGroup(ctx, elasticache.NewAuthTokenModifyReplicationGroupInput(meta.GetExternalName(cr), token, strategy)); err != nil {
+		return awsclient.Wrap(err, errUpdateAuthToken)
+	}
+	cr.Status.AtProvider.AuthTokenUpdateStrategy = string(strategy)
+	cr.Status.AtProvider.AuthTokenUpdateHash = elasticache.AuthTokenHash(token)
+	return nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.ReplicationGroup)
 	if !ok {
@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,6 +38,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	ec "github.com/crossplane/provider-aws/pkg/clients/elasticache"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
 )
 
@@ -62,6 +64,10 @@ var (
 	errorBoom = errors.New("boom")
 
 	objectMeta = metav1.ObjectMeta{Name: name}
+
+	oldToken   = "oldToken"
+	newToken   = "newToken"
+	otherToken = "otherToken"
 )
 
 type testCase struct {
@@ -117,6 +123,46 @@ func withTags(tagMaps ...map[string]string) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.Tags = tagList }
 }
 
+func withAuthTokenSecretRef() replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Spec.ForProvider.AuthTokenSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "token", Namespace: "default"},
+			Key:             "token",
+		}
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "conn", Namespace: "default"}
+	}
+}
+
+func withAuthTokenUpdate(s types.AuthTokenUpdateStrategyType, token string) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Status.AtProvider.AuthTokenUpdateStrategy = string(s)
+		r.Status.AtProvider.AuthTokenUpdateHash = ec.AuthTokenHash(token)
+	}
+}
+
+func withAuthTokenStatus(s types.AuthTokenUpdateStatus) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Status.AtProvider.PendingModifiedValues.AuthTokenStatus = string(s)
+	}
+}
+
+// tokenSecrets returns a kube client that serves the desired auth token in the
+// token secret and the published one in the connection secret.
+func tokenSecrets(desired, published string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			switch key.Name {
+			case "token":
+				s.Data = map[string][]byte{"token": []byte(desired)}
+			case "conn":
+				s.Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(published)}
+			}
+			return nil
+		},
+	}
+}
+
 func replicationGroup(rm ...replicationGroupModifier) *v1beta1.ReplicationGroup {
 	r := &v1beta1.ReplicationGroup{
 		ObjectMeta: objectMeta,
@@ -472,6 +518,184 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestObserveAuthToken(t *testing.T) {
+	describe := func(status string, pending types.AuthTokenUpdateStatus) *fake.MockClient {
+		return &fake.MockClient{
+			MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+				return &elasticache.DescribeReplicationGroupsOutput{
+					ReplicationGroups: []types.ReplicationGroup{{
+						Status:                aws.String(status),
+						PendingModifiedValues: &types.ReplicationGroupPendingModifiedValues{AuthTokenStatus: pending},
+					}},
+				}, nil
+			},
+		}
+	}
+	type want struct {
+		strategy string
+		hash     string
+		token    string
+	}
+
+	cases := []struct {
+		name string
+		e    managed.ExternalClient
+		r    *v1beta1.ReplicationGroup
+		want want
+	}{
+		{
+			name: "TokenNotChanged",
+			e:    &external{client: describe(v1beta1.StatusAvailable, ""), kube: tokenSecrets(newToken, newToken)},
+			r:    replicationGroup(withAuthTokenSecretRef()),
+			want: want{token: newToken},
+		},
+		{
+			name: "RotateInProgress",
+			e:    &external{client: describe(v1beta1.StatusModifying, types.AuthTokenUpdateStatusRotating), kube: tokenSecrets(newToken, oldToken)},
+			r:    replicationGroup(withAuthTokenSecretRef(), withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeRotate, newToken)),
+			want: want{strategy: string(types.AuthTokenUpdateStrategyTypeRotate), hash: ec.AuthTokenHash(newToken)},
+		},
+		{
+			name: "SetInProgress",
+			e:    &external{client: describe(v1beta1.StatusModifying, types.AuthTokenUpdateStatusSetting), kube: tokenSecrets(newToken, oldToken)},
+			r:    replicationGroup(withAuthTokenSecretRef(), withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeSet, newToken)),
+			want: want{strategy: string(types.AuthTokenUpdateStrategyTypeSet), hash: ec.AuthTokenHash(newToken)},
+		},
+		{
+			name: "RotationCompleted",
+			e:    &external{client: describe(v1beta1.StatusAvailable, ""), kube: tokenSecrets(newToken, oldToken)},
+			r:    replicationGroup(withAuthTokenSecretRef(), withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeSet, newToken)),
+			want: want{token: newToken},
+		},
+		{
+			name: "TokenChangedDuringRotation",
+			e:    &external{client: describe(v1beta1.StatusAvailable, ""), kube: tokenSecrets(newToken, oldToken)},
+			r:    replicationGroup(withAuthTokenSecretRef(), withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeSet, otherToken)),
+			want: want{strategy: string(types.AuthTokenUpdateStrategyTypeSet), hash: ec.AuthTokenHash(otherToken)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			observation, err := tc.e.Observe(ctx, tc.r)
+			if err != nil {
+				t.Errorf("tc.e.Observe(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.token, string(observation.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+				t.Errorf("token: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.strategy, tc.r.Status.AtProvider.AuthTokenUpdateStrategy); diff != "" {
+				t.Errorf("strategy: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.hash, tc.r.Status.AtProvider.AuthTokenUpdateHash); diff != "" {
+				t.Errorf("hash: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateAuthToken(t *testing.T) {
+	type want struct {
+		strategy string
+		modify   *elasticache.ModifyReplicationGroupInput
+	}
+
+	cases := []struct {
+		name string
+		r    *v1beta1.ReplicationGroup
+		want want
+	}{
+		{
+			name: "Rotate",
+			r:    replicationGroup(withAuthTokenSecretRef(), withProviderStatus(v1beta1.StatusAvailable)),
+			want: want{
+				strategy: string(types.AuthTokenUpdateStrategyTypeRotate),
+				modify: &elasticache.ModifyReplicationGroupInput{
+					ReplicationGroupId:      aws.String(name),
+					ApplyImmediately:        true,
+					AuthToken:               aws.String(newToken),
+					AuthTokenUpdateStrategy: types.AuthTokenUpdateStrategyTypeRotate,
+				},
+			},
+		},
+		{
+			name: "Set",
+			r: replicationGroup(withAuthTokenSecretRef(), withProviderStatus(v1beta1.StatusAvailable),
+				withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeRotate, newToken)),
+			want: want{
+				strategy: string(types.AuthTokenUpdateStrategyTypeSet),
+				modify: &elasticache.ModifyReplicationGroupInput{
+					ReplicationGroupId:      aws.String(name),
+					ApplyImmediately:        true,
+					AuthToken:               aws.String(newToken),
+					AuthTokenUpdateStrategy: types.AuthTokenUpdateStrategyTypeSet,
+				},
+			},
+		},
+		{
+			name: "RestartRotateAfterTokenChanged",
+			r: replicationGroup(withAuthTokenSecretRef(), withProviderStatus(v1beta1.StatusAvailable),
+				withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeRotate, otherToken)),
+			want: want{
+				strategy: string(types.AuthTokenUpdateStrategyTypeRotate),
+				modify: &elasticache.ModifyReplicationGroupInput{
+					ReplicationGroupId:      aws.String(name),
+					ApplyImmediately:        true,
+					AuthToken:               aws.String(newToken),
+					AuthTokenUpdateStrategy: types.AuthTokenUpdateStrategyTypeRotate,
+				},
+			},
+		},
+		{
+			name: "WaitForRotate",
+			r: replicationGroup(withAuthTokenSecretRef(), withProviderStatus(v1beta1.StatusAvailable),
+				withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeRotate, newToken), withAuthTokenStatus(types.AuthTokenUpdateStatusRotating)),
+			want: want{
+				strategy: string(types.AuthTokenUpdateStrategyTypeRotate),
+			},
+		},
+		{
+			name: "WaitForSet",
+			r: replicationGroup(withAuthTokenSecretRef(), withProviderStatus(v1beta1.StatusAvailable),
+				withAuthTokenUpdate(types.AuthTokenUpdateStrategyTypeSet, newToken)),
+			want: want{
+				strategy: string(types.AuthTokenUpdateStrategyTypeSet),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var modify *elasticache.ModifyReplicationGroupInput
+			e := &external{
+				client: &fake.MockClient{
+					MockModifyReplicationGroup: func(ctx context.Context, in *elasticache.ModifyReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+						modify = in
+						return &elasticache.ModifyReplicationGroupOutput{}, nil
+					},
+				},
+				kube: tokenSecrets(newToken, oldToken),
+			}
+			update, err := e.Update(ctx, tc.r)
+			if err != nil {
+				t.Errorf("e.Update(...): unexpected error: %s", err)
+			}
+			if len(update.ConnectionDetails) != 0 {
+				t.Errorf("e.Update(...): connection details must not be published before the rotation is completed")
+			}
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreUnexported(elasticache.ModifyReplicationGroupInput{})); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.strategy, tc.r.Status.AtProvider.AuthTokenUpdateStrategy); diff != "" {
+				t.Errorf("strategy: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(ec.AuthTokenHash(newToken), tc.r.Status.AtProvider.AuthTokenUpdateHash); diff != "" {
+				t.Errorf("hash: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	cases := []testCase{
 		{
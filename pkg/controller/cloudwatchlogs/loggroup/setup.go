@@ -64,7 +64,7 @@ func SetupLogGroup(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLim
 		For(&svcapitypes.LogGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.LogGroupGroupVersionKind),
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclients.NewDefaultTagger(mgr.GetClient())),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -17,18 +17,25 @@ limitations under the License.
 package config
 
 import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
@@ -48,7 +55,30 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		}).
 		For(&v1beta1.ProviderConfig{}).
 		Watches(&source.Kind{Type: &v1beta1.ProviderConfigUsage{}}, &resource.EnqueueRequestForProviderConfig{}).
-		Complete(providerconfig.NewReconciler(mgr, of,
-			providerconfig.WithLogger(l.WithValues("controller", name)),
-			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+		Complete(&evictingReconciler{
+			client: mgr.GetClient(),
+			Reconciler: providerconfig.NewReconciler(mgr, of,
+				providerconfig.WithLogger(l.WithValues("controller", name)),
+				providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+		})
+}
+
+// evictingReconciler evicts the cached AWS configurations of the
+// ProviderConfigs that are deleted or replaced before passing the request to
+// the usage accounting reconciler.
+type evictingReconciler struct {
+	reconcile.Reconciler
+	client client.Client
+}
+
+func (r *evictingReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	pc := &v1beta1.ProviderConfig{}
+	err := r.client.Get(ctx, req.NamespacedName, pc)
+	switch {
+	case kerrors.IsNotFound(err), err == nil && meta.WasDeleted(pc):
+		awsclients.EvictProviderConfig(req.Name, "")
+	case err == nil:
+		awsclients.EvictProviderConfig(req.Name, pc.GetUID())
+	}
+	return r.Reconciler.Reconcile(ctx, req)
 }
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
@@ -44,20 +45,33 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errDescribeTimeToLive                  = "cannot describe time to live of the table"
+	errUpdateTimeToLive                    = "cannot update time to live of the table"
+	errDescribeContinuousBackups           = "cannot describe continuous backups of the table"
+	errUpdateContinuousBackups             = "cannot update continuous backups of the table"
+	errDescribeContributorInsights         = "cannot describe contributor insights of the table"
+	errUpdateContributorInsights           = "cannot update contributor insights of the table"
+	errDescribeKinesisStreamingDestination = "cannot describe Kinesis streaming destinations of the table"
+	errEnableKinesisStreamingDestination   = "cannot enable Kinesis streaming destination of the table"
+	errDisableKinesisStreamingDestination  = "cannot disable Kinesis streaming destination of the table"
+)
+
 // SetupTable adds a controller that reconciles Table.
 func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(svcapitypes.TableGroupKind)
 	opts := []option{
 		func(e *external) {
+			h := &hooks{client: e.client}
+			e.client = &tableClient{DynamoDBAPI: e.client}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = h.postObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 			e.postDelete = postDelete
 			e.lateInitialize = lateInitialize
 			e.isUpToDate = isUpToDate
-			u := &updateClient{client: e.client}
-			e.preUpdate = u.preUpdate
+			e.preUpdate = h.preUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -72,7 +86,8 @@ func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 			managed.WithInitializers(
 				managed.NewNameAsExternalName(mgr.GetClient()),
 				managed.NewDefaultProviderConfig(mgr.GetClient()),
-				&tagger{kube: mgr.GetClient()}),
+				&tagger{kube: mgr.GetClient()},
+				aws.NewDefaultTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -105,7 +120,7 @@ func postDelete(_ context.Context, _ *svcapitypes.Table, _ *svcsdk.DeleteTableOu
 	return err
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *hooks) postObserve(ctx context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -127,9 +142,43 @@ func postObserve(_ context.Context, cr *svcapitypes.Table, resp *svcsdk.Describe
 		"latestStreamLabel": []byte(aws.StringValue(resp.Table.LatestStreamLabel)),
 	}
 
+	// The settings that are managed via their dedicated API calls can be
+	// observed and updated only when the table is active.
+	if aws.StringValue(resp.Table.TableStatus) != string(svcapitypes.TableStatus_SDK_ACTIVE) {
+		return obs, nil
+	}
+	st, err := e.observeSettings(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.CustomTableObservation = generateSettingsObservation(st)
+	obs.ResourceUpToDate = obs.ResourceUpToDate && isSettingsUpToDate(cr.Spec.ForProvider, st)
 	return obs, nil
 }
 
+func generateSettingsObservation(st *settings) svcapitypes.CustomTableObservation {
+	o := svcapitypes.CustomTableObservation{
+		ContributorInsightsStatus: st.contributorInsights,
+	}
+	if st.timeToLive != nil {
+		o.TimeToLive = &svcapitypes.TimeToLiveDescription{
+			AttributeName:    st.timeToLive.AttributeName,
+			TimeToLiveStatus: st.timeToLive.TimeToLiveStatus,
+		}
+	}
+	if st.pointInTimeRecovery != nil {
+		o.PointInTimeRecoveryStatus = st.pointInTimeRecovery.PointInTimeRecoveryStatus
+	}
+	for _, d := range st.kinesisDestinations {
+		o.KinesisStreamingDestinations = append(o.KinesisStreamingDestinations, svcapitypes.KinesisStreamingDestinationObservation{
+			StreamARN:                    d.StreamArn,
+			DestinationStatus:            d.DestinationStatus,
+			DestinationStatusDescription: d.DestinationStatusDescription,
+		})
+	}
+	return o
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -366,11 +415,11 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 	return true, nil
 }
 
-type updateClient struct {
+type hooks struct {
 	client svcsdkapi.DynamoDBAPI
 }
 
-func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
+func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
 	filtered := &svcsdk.UpdateTableInput{
 		TableName:            aws.String(meta.GetExternalName(cr)),
 		AttributeDefinitions: u.AttributeDefinitions,
@@ -427,12 +476,229 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		}
 	case len(gsiUpdates) != 0:
 		filtered.SetGlobalSecondaryIndexUpdates(gsiUpdates)
+	default:
+		// The settings that have their own API calls are
+		// updated only after the table itself is up to date since most of
+		// them cannot be changed while the table is being updated.
+		if err := e.updateSettings(ctx, cr); err != nil {
+			return err
+		}
 	}
 
 	*u = *filtered
 	return nil
 }
 
+// tableClient skips the UpdateTable calls that have nothing to update since
+// DynamoDB rejects them. This is the case when only the settings that are
+// managed via their dedicated API calls are out of date.
+type tableClient struct {
+	svcsdkapi.DynamoDBAPI
+}
+
+func (c *tableClient) UpdateTableWithContext(ctx context.Context, in *svcsdk.UpdateTableInput, opts ...request.Option) (*svcsdk.UpdateTableOutput, error) {
+	if isEmptyUpdate(in) {
+		return &svcsdk.UpdateTableOutput{}, nil
+	}
+	return c.DynamoDBAPI.UpdateTableWithContext(ctx, in, opts...)
+}
+
+func isEmptyUpdate(in *svcsdk.UpdateTableInput) bool {
+	return in.BillingMode == nil &&
+		in.ProvisionedThroughput == nil &&
+		in.StreamSpecification == nil &&
+		in.SSESpecification == nil &&
+		len(in.GlobalSecondaryIndexUpdates) == 0 &&
+		len(in.ReplicaUpdates) == 0
+}
+
+// settings is the observed state of the table settings that are managed via
+// their dedicated API calls.
+type settings struct {
+	timeToLive          *svcsdk.TimeToLiveDescription
+	pointInTimeRecovery *svcsdk.PointInTimeRecoveryDescription
+	contributorInsights *string
+	kinesisDestinations []*svcsdk.KinesisDataStreamDestination
+}
+
+func (e *hooks) observeSettings(ctx context.Context, cr *svcapitypes.Table) (*settings, error) {
+	name := aws.String(meta.GetExternalName(cr))
+	st := &settings{}
+	ttl, err := e.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: name})
+	if err != nil {
+		return nil, aws.Wrap(err, errDescribeTimeToLive)
+	}
+	st.timeToLive = ttl.TimeToLiveDescription
+	backups, err := e.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: name})
+	if err != nil {
+		return nil, aws.Wrap(err, errDescribeContinuousBackups)
+	}
+	if backups.ContinuousBackupsDescription != nil {
+		st.pointInTimeRecovery = backups.ContinuousBackupsDescription.PointInTimeRecoveryDescription
+	}
+	insights, err := e.client.DescribeContributorInsightsWithContext(ctx, &svcsdk.DescribeContributorInsightsInput{TableName: name})
+	if err != nil {
+		return nil, aws.Wrap(err, errDescribeContributorInsights)
+	}
+	st.contributorInsights = insights.ContributorInsightsStatus
+	kinesis, err := e.client.DescribeKinesisStreamingDestinationWithContext(ctx, &svcsdk.DescribeKinesisStreamingDestinationInput{TableName: name})
+	if err != nil {
+		return nil, aws.Wrap(err, errDescribeKinesisStreamingDestination)
+	}
+	st.kinesisDestinations = kinesis.KinesisDataStreamDestinations
+	return st, nil
+}
+
+func (e *hooks) updateSettings(ctx context.Context, cr *svcapitypes.Table) error { // nolint:gocyclo
+	st, err := e.observeSettings(ctx, cr)
+	if err != nil {
+		return err
+	}
+	p := cr.Spec.ForProvider
+	name := aws.String(meta.GetExternalName(cr))
+	if !isTimeToLiveUpToDate(p.TimeToLive, st.timeToLive) {
+		spec := &svcsdk.TimeToLiveSpecification{
+			AttributeName: aws.String(p.TimeToLive.AttributeName),
+			Enabled:       aws.Bool(p.TimeToLive.Enabled),
+		}
+		// Time to live has to be disabled first in order to
+		// change the attribute that stores the expiry time. It will be
+		// enabled with the new attribute in the next reconciliation.
+		if st.timeToLive != nil && aws.StringValue(st.timeToLive.TimeToLiveStatus) == svcsdk.TimeToLiveStatusEnabled {
+			spec = &svcsdk.TimeToLiveSpecification{
+				AttributeName: st.timeToLive.AttributeName,
+				Enabled:       aws.Bool(false),
+			}
+		}
+		if _, err := e.client.UpdateTimeToLiveWithContext(ctx, &svcsdk.UpdateTimeToLiveInput{
+			TableName:               name,
+			TimeToLiveSpecification: spec,
+		}); err != nil {
+			return aws.Wrap(err, errUpdateTimeToLive)
+		}
+	}
+	if !isPointInTimeRecoveryUpToDate(p.PointInTimeRecoveryEnabled, st.pointInTimeRecovery) {
+		if _, err := e.client.UpdateContinuousBackupsWithContext(ctx, &svcsdk.UpdateContinuousBackupsInput{
+			TableName: name,
+			PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{
+				PointInTimeRecoveryEnabled: p.PointInTimeRecoveryEnabled,
+			},
+		}); err != nil {
+			return aws.Wrap(err, errUpdateContinuousBackups)
+		}
+	}
+	if !isContributorInsightsUpToDate(p.ContributorInsightsEnabled, st.contributorInsights) {
+		action := svcsdk.ContributorInsightsActionDisable
+		if aws.BoolValue(p.ContributorInsightsEnabled) {
+			action = svcsdk.ContributorInsightsActionEnable
+		}
+		if _, err := e.client.UpdateContributorInsightsWithContext(ctx, &svcsdk.UpdateContributorInsightsInput{
+			TableName:                 name,
+			ContributorInsightsAction: aws.String(action),
+		}); err != nil {
+			return aws.Wrap(err, errUpdateContributorInsights)
+		}
+	}
+	enable, disable := diffKinesisStreamingDestinations(p.KinesisStreamingDestinations, st.kinesisDestinations)
+	for _, arn := range enable {
+		if _, err := e.client.EnableKinesisStreamingDestinationWithContext(ctx, &svcsdk.EnableKinesisStreamingDestinationInput{
+			TableName: name,
+			StreamArn: aws.String(arn),
+		}); err != nil {
+			return aws.Wrap(err, errEnableKinesisStreamingDestination)
+		}
+	}
+	for _, arn := range disable {
+		if _, err := e.client.DisableKinesisStreamingDestinationWithContext(ctx, &svcsdk.DisableKinesisStreamingDestinationInput{
+			TableName: name,
+			StreamArn: aws.String(arn),
+		}); err != nil {
+			return aws.Wrap(err, errDisableKinesisStreamingDestination)
+		}
+	}
+	return nil
+}
+
+func isSettingsUpToDate(p svcapitypes.TableParameters, st *settings) bool {
+	enable, disable := diffKinesisStreamingDestinations(p.KinesisStreamingDestinations, st.kinesisDestinations)
+	return isTimeToLiveUpToDate(p.TimeToLive, st.timeToLive) &&
+		isPointInTimeRecoveryUpToDate(p.PointInTimeRecoveryEnabled, st.pointInTimeRecovery) &&
+		isContributorInsightsUpToDate(p.ContributorInsightsEnabled, st.contributorInsights) &&
+		len(enable) == 0 && len(disable) == 0
+}
+
+func isTimeToLiveUpToDate(spec *svcapitypes.TimeToLive, obs *svcsdk.TimeToLiveDescription) bool {
+	if spec == nil {
+		return true
+	}
+	if obs == nil {
+		return !spec.Enabled
+	}
+	switch aws.StringValue(obs.TimeToLiveStatus) {
+	case svcsdk.TimeToLiveStatusEnabling, svcsdk.TimeToLiveStatusDisabling:
+		// Time to live cannot be updated until the ongoing change is
+		// completed.
+		return true
+	case svcsdk.TimeToLiveStatusEnabled:
+		return spec.Enabled && spec.AttributeName == aws.StringValue(obs.AttributeName)
+	}
+	return !spec.Enabled
+}
+
+func isPointInTimeRecoveryUpToDate(enabled *bool, obs *svcsdk.PointInTimeRecoveryDescription) bool {
+	if enabled == nil {
+		return true
+	}
+	current := obs != nil && aws.StringValue(obs.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled
+	return aws.BoolValue(enabled) == current
+}
+
+func isContributorInsightsUpToDate(enabled *bool, status *string) bool {
+	if enabled == nil {
+		return true
+	}
+	switch aws.StringValue(status) {
+	case svcsdk.ContributorInsightsStatusEnabling, svcsdk.ContributorInsightsStatusDisabling:
+		return true
+	case svcsdk.ContributorInsightsStatusEnabled:
+		return aws.BoolValue(enabled)
+	}
+	return !aws.BoolValue(enabled)
+}
+
+// diffKinesisStreamingDestinations returns the ARNs of the streams that need
+// to be enabled and disabled as destinations of the table. The destinations
+// that are in transition are left as they are until they settle. Nothing is
+// changed if no destinations are given.
+func diffKinesisStreamingDestinations(spec []svcapitypes.KinesisStreamingDestination, obs []*svcsdk.KinesisDataStreamDestination) (enable, disable []string) {
+	if spec == nil {
+		return nil, nil
+	}
+	desired := map[string]bool{}
+	for _, d := range spec {
+		desired[d.StreamARN] = true
+	}
+	existing := map[string]string{}
+	for _, d := range obs {
+		existing[aws.StringValue(d.StreamArn)] = aws.StringValue(d.DestinationStatus)
+	}
+	for _, d := range spec {
+		switch existing[d.StreamARN] {
+		case svcsdk.DestinationStatusActive, svcsdk.DestinationStatusEnabling, svcsdk.DestinationStatusDisabling:
+			continue
+		}
+		enable = append(enable, d.StreamARN)
+	}
+	for arn, status := range existing {
+		if !desired[arn] && status == svcsdk.DestinationStatusActive {
+			disable = append(disable, arn)
+		}
+	}
+	sort.Strings(enable)
+	sort.Strings(disable)
+	return enable, disable
+}
+
 func diffGlobalSecondaryIndexes(spec []*svcsdk.GlobalSecondaryIndexDescription, obs []*svcsdk.GlobalSecondaryIndexDescription) []*svcsdk.GlobalSecondaryIndexUpdate { //nolint:gocyclo
 	// Linter is disabled because there isn't an easy good way to reduce the cyclo
 	// complexity here.
@@ -17,12 +17,18 @@ limitations under the License.
 package table
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 )
@@ -618,3 +624,388 @@ func TestDiffGlobalSecondaryIndexes(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTimeToLiveUpToDate(t *testing.T) {
+	type args struct {
+		spec *svcapitypes.TimeToLive
+		obs  *svcsdk.TimeToLiveDescription
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				obs: &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: true,
+		},
+		"ShouldBeEnabled": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			},
+			want: false,
+		},
+		"DifferentAttribute": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("ttl"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: false,
+		},
+		"ShouldBeDisabled": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expiry"},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: false,
+		},
+		"Enabling": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expiry"},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabling)},
+			},
+			want: true,
+		},
+		"UpToDate": {
+			args: args{
+				spec: &svcapitypes.TimeToLive{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isTimeToLiveUpToDate(tc.args.spec, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isTimeToLiveUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSettingsUpToDate(t *testing.T) {
+	type args struct {
+		p  svcapitypes.TableParameters
+		st *settings
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				st: &settings{},
+			},
+			want: true,
+		},
+		"PointInTimeRecoveryDisabled": {
+			args: args{
+				p: svcapitypes.TableParameters{CustomTableParameters: svcapitypes.CustomTableParameters{
+					PointInTimeRecoveryEnabled: aws.Bool(true),
+				}},
+				st: &settings{
+					pointInTimeRecovery: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusDisabled)},
+				},
+			},
+			want: false,
+		},
+		"ContributorInsightsEnabled": {
+			args: args{
+				p: svcapitypes.TableParameters{CustomTableParameters: svcapitypes.CustomTableParameters{
+					ContributorInsightsEnabled: aws.Bool(false),
+				}},
+				st: &settings{
+					contributorInsights: aws.String(svcsdk.ContributorInsightsStatusEnabled),
+				},
+			},
+			want: false,
+		},
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.TableParameters{CustomTableParameters: svcapitypes.CustomTableParameters{
+					PointInTimeRecoveryEnabled:   aws.Bool(true),
+					ContributorInsightsEnabled:   aws.Bool(true),
+					KinesisStreamingDestinations: []svcapitypes.KinesisStreamingDestination{{StreamARN: "arn"}},
+				}},
+				st: &settings{
+					pointInTimeRecovery: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled)},
+					contributorInsights: aws.String(svcsdk.ContributorInsightsStatusEnabled),
+					kinesisDestinations: []*svcsdk.KinesisDataStreamDestination{
+						{StreamArn: aws.String("arn"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+					},
+				},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isSettingsUpToDate(tc.args.p, tc.args.st)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isSettingsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffKinesisStreamingDestinations(t *testing.T) {
+	type args struct {
+		spec []svcapitypes.KinesisStreamingDestination
+		obs  []*svcsdk.KinesisDataStreamDestination
+	}
+	type want struct {
+		enable  []string
+		disable []string
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotManaged": {
+			args: args{
+				obs: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("one"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+				},
+			},
+		},
+		"NoOp": {
+			args: args{
+				spec: []svcapitypes.KinesisStreamingDestination{{StreamARN: "one"}},
+				obs: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("one"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+					{StreamArn: aws.String("old"), DestinationStatus: aws.String(svcsdk.DestinationStatusDisabled)},
+				},
+			},
+		},
+		"EnableAndDisable": {
+			args: args{
+				spec: []svcapitypes.KinesisStreamingDestination{{StreamARN: "new"}, {StreamARN: "failed"}},
+				obs: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("failed"), DestinationStatus: aws.String(svcsdk.DestinationStatusEnableFailed)},
+					{StreamArn: aws.String("old"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+				},
+			},
+			want: want{
+				enable:  []string{"failed", "new"},
+				disable: []string{"old"},
+			},
+		},
+		"InTransition": {
+			args: args{
+				spec: []svcapitypes.KinesisStreamingDestination{{StreamARN: "disabling"}},
+				obs: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("disabling"), DestinationStatus: aws.String(svcsdk.DestinationStatusDisabling)},
+					{StreamArn: aws.String("enabling"), DestinationStatus: aws.String(svcsdk.DestinationStatusEnabling)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			enable, disable := diffKinesisStreamingDestinations(tc.args.spec, tc.args.obs)
+			if diff := cmp.Diff(tc.want.enable, enable); diff != "" {
+				t.Errorf("diffKinesisStreamingDestinations(...): -want enable, +got enable:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disable, disable); diff != "" {
+				t.Errorf("diffKinesisStreamingDestinations(...): -want disable, +got disable:\n%s", diff)
+			}
+		})
+	}
+}
+
+// mockSettingsClient serves the observed settings of a table and records the
+// calls that update them. The calls that are not implemented panic.
+type mockSettingsClient struct {
+	svcsdkapi.DynamoDBAPI
+
+	timeToLive          *svcsdk.TimeToLiveDescription
+	pointInTimeRecovery *svcsdk.PointInTimeRecoveryDescription
+	contributorInsights *string
+	kinesisDestinations []*svcsdk.KinesisDataStreamDestination
+	calls               []string
+}
+
+func (m *mockSettingsClient) DescribeTimeToLiveWithContext(_ context.Context, _ *svcsdk.DescribeTimeToLiveInput, _ ...request.Option) (*svcsdk.DescribeTimeToLiveOutput, error) {
+	return &svcsdk.DescribeTimeToLiveOutput{TimeToLiveDescription: m.timeToLive}, nil
+}
+
+func (m *mockSettingsClient) DescribeContinuousBackupsWithContext(_ context.Context, _ *svcsdk.DescribeContinuousBackupsInput, _ ...request.Option) (*svcsdk.DescribeContinuousBackupsOutput, error) {
+	return &svcsdk.DescribeContinuousBackupsOutput{
+		ContinuousBackupsDescription: &svcsdk.ContinuousBackupsDescription{PointInTimeRecoveryDescription: m.pointInTimeRecovery},
+	}, nil
+}
+
+func (m *mockSettingsClient) DescribeContributorInsightsWithContext(_ context.Context, _ *svcsdk.DescribeContributorInsightsInput, _ ...request.Option) (*svcsdk.DescribeContributorInsightsOutput, error) {
+	return &svcsdk.DescribeContributorInsightsOutput{ContributorInsightsStatus: m.contributorInsights}, nil
+}
+
+func (m *mockSettingsClient) UpdateTimeToLiveWithContext(_ context.Context, in *svcsdk.UpdateTimeToLiveInput, _ ...request.Option) (*svcsdk.UpdateTimeToLiveOutput, error) {
+	m.calls = append(m.calls, "UpdateTimeToLive:"+aws.StringValue(in.TimeToLiveSpecification.AttributeName))
+	return &svcsdk.UpdateTimeToLiveOutput{}, nil
+}
+
+func (m *mockSettingsClient) DescribeKinesisStreamingDestinationWithContext(_ context.Context, _ *svcsdk.DescribeKinesisStreamingDestinationInput, _ ...request.Option) (*svcsdk.DescribeKinesisStreamingDestinationOutput, error) {
+	return &svcsdk.DescribeKinesisStreamingDestinationOutput{KinesisDataStreamDestinations: m.kinesisDestinations}, nil
+}
+
+func (m *mockSettingsClient) EnableKinesisStreamingDestinationWithContext(_ context.Context, in *svcsdk.EnableKinesisStreamingDestinationInput, _ ...request.Option) (*svcsdk.EnableKinesisStreamingDestinationOutput, error) {
+	m.calls = append(m.calls, "EnableKinesisStreamingDestination:"+aws.StringValue(in.StreamArn))
+	return &svcsdk.EnableKinesisStreamingDestinationOutput{}, nil
+}
+
+func (m *mockSettingsClient) DisableKinesisStreamingDestinationWithContext(_ context.Context, in *svcsdk.DisableKinesisStreamingDestinationInput, _ ...request.Option) (*svcsdk.DisableKinesisStreamingDestinationOutput, error) {
+	m.calls = append(m.calls, "DisableKinesisStreamingDestination:"+aws.StringValue(in.StreamArn))
+	return &svcsdk.DisableKinesisStreamingDestinationOutput{}, nil
+}
+
+func TestUpdateSettings(t *testing.T) {
+	type args struct {
+		p      svcapitypes.CustomTableParameters
+		client *mockSettingsClient
+	}
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"KinesisStreamingDestinationsOmitted": {
+			args: args{
+				client: &mockSettingsClient{
+					kinesisDestinations: []*svcsdk.KinesisDataStreamDestination{
+						{StreamArn: aws.String("arn"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+					},
+				},
+			},
+		},
+		"KinesisStreamingDestinationsChanged": {
+			args: args{
+				p: svcapitypes.CustomTableParameters{
+					KinesisStreamingDestinations: []svcapitypes.KinesisStreamingDestination{{StreamARN: "new"}},
+				},
+				client: &mockSettingsClient{
+					kinesisDestinations: []*svcsdk.KinesisDataStreamDestination{
+						{StreamArn: aws.String("old"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+					},
+				},
+			},
+			want: []string{
+				"EnableKinesisStreamingDestination:new",
+				"DisableKinesisStreamingDestination:old",
+			},
+		},
+		"TimeToLiveNotDescribed": {
+			args: args{
+				p: svcapitypes.CustomTableParameters{
+					TimeToLive: &svcapitypes.TimeToLive{AttributeName: "expiry", Enabled: true},
+				},
+				client: &mockSettingsClient{},
+			},
+			want: []string{"UpdateTimeToLive:expiry"},
+		},
+		"TimeToLiveAttributeChanged": {
+			args: args{
+				p: svcapitypes.CustomTableParameters{
+					TimeToLive: &svcapitypes.TimeToLive{AttributeName: "expiry", Enabled: true},
+				},
+				client: &mockSettingsClient{
+					timeToLive: &svcsdk.TimeToLiveDescription{
+						AttributeName:    aws.String("ttl"),
+						TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+					},
+				},
+			},
+			want: []string{"UpdateTimeToLive:ttl"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Table{}
+			meta.SetExternalName(cr, "table")
+			cr.Spec.ForProvider.CustomTableParameters = tc.args.p
+			e := &hooks{client: tc.args.client}
+			if err := e.updateSettings(context.Background(), cr); err != nil {
+				t.Fatalf("updateSettings(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.args.client.calls); diff != "" {
+				t.Errorf("updateSettings(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserveSettings(t *testing.T) {
+	active := &svcsdk.DescribeTableOutput{Table: &svcsdk.TableDescription{TableStatus: aws.String(svcsdk.TableStatusActive)}}
+	type want struct {
+		upToDate bool
+		obs      svcapitypes.CustomTableObservation
+	}
+	cases := map[string]struct {
+		p      svcapitypes.CustomTableParameters
+		client *mockSettingsClient
+		want   want
+	}{
+		"SettingsNotGiven": {
+			client: &mockSettingsClient{
+				timeToLive: &svcsdk.TimeToLiveDescription{
+					AttributeName:    aws.String("expiry"),
+					TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+				},
+				pointInTimeRecovery: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled)},
+				contributorInsights: aws.String(svcsdk.ContributorInsightsStatusDisabled),
+				kinesisDestinations: []*svcsdk.KinesisDataStreamDestination{
+					{StreamArn: aws.String("arn"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+				},
+			},
+			want: want{
+				upToDate: true,
+				obs: svcapitypes.CustomTableObservation{
+					TimeToLive: &svcapitypes.TimeToLiveDescription{
+						AttributeName:    aws.String("expiry"),
+						TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+					},
+					PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled),
+					ContributorInsightsStatus: aws.String(svcsdk.ContributorInsightsStatusDisabled),
+					KinesisStreamingDestinations: []svcapitypes.KinesisStreamingDestinationObservation{
+						{StreamARN: aws.String("arn"), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+					},
+				},
+			},
+		},
+		"PointInTimeRecoveryChanged": {
+			p: svcapitypes.CustomTableParameters{PointInTimeRecoveryEnabled: aws.Bool(true)},
+			client: &mockSettingsClient{
+				pointInTimeRecovery: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusDisabled)},
+			},
+			want: want{
+				obs: svcapitypes.CustomTableObservation{
+					PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusDisabled),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Table{}
+			meta.SetExternalName(cr, "table")
+			cr.Spec.ForProvider.CustomTableParameters = tc.p
+			e := &hooks{client: tc.client}
+			obs, err := e.postObserve(context.Background(), cr, active, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil)
+			if err != nil {
+				t.Fatalf("postObserve(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, obs.ResourceUpToDate); diff != "" {
+				t.Errorf("postObserve(...): -want upToDate, +got upToDate:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider.CustomTableObservation); diff != "" {
+				t.Errorf("postObserve(...): -want observation, +got observation:\n%s", diff)
+			}
+		})
+	}
+}
@@ -53,8 +53,17 @@ const (
 	errCreate                   = "failed to create the Instance resource"
 	errUpdate                   = "failed to update Instance resource"
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
+	errNotFound                 = "Instance does not exist"
 	errCreateTags               = "failed to create tags for the Instance resource"
+	errDeleteTags               = "failed to delete tags of the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
+	errStart                    = "failed to start the Instance resource"
+	errStop                     = "failed to stop the Instance resource"
+
+	msgStopping          = "Instance is stopping"
+	msgStoppingForModify = "Instance is stopping to modify attributes that can be changed only when it is stopped"
+	msgStopped           = "Instance is stopped"
+	msgStarting          = "Instance is starting"
 )
 
 // SetupInstance adds a controller that reconciles Instances.
@@ -71,7 +80,7 @@ func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}, awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -97,6 +106,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 type external struct {
 	kube   client.Client
 	client ec2.InstanceClient
+
+	// observed and attributes are the results of the describe calls made
+	// in Observe, which are reused by Update in the same reconcile.
+	observed   *types.Instance
+	attributes *awsec2.DescribeInstanceAttributeOutput
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -111,82 +125,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
-	response, err := e.client.DescribeInstances(ctx,
-		&awsec2.DescribeInstancesInput{
-			InstanceIds: []string{meta.GetExternalName(cr)},
-		})
-
+	observed, o, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(ec2.IsInstanceNotFoundErr, err)
+	}
 	// deleted instances that have not yet been cleaned up from the cluster return a
 	// 200 OK with a nil response.Reservations slice
-	if err == nil && len(response.Reservations) == 0 {
+	if observed == nil {
 		return managed.ExternalObservation{}, nil
 	}
-
-	if err != nil {
-		return managed.ExternalObservation{},
-			awsclient.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDescribe)
-	}
-
-	// in a successful response, there should be one and only one object
-	if len(response.Reservations[0].Instances) != 1 {
-		return managed.ExternalObservation{}, errors.New(errMultipleItems)
-	}
-
-	observed := response.Reservations[0].Instances[0]
+	e.observed, e.attributes = observed, o
 
 	// update the CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
 
-	o := awsec2.DescribeInstanceAttributeOutput{}
-
-	for _, input := range []types.InstanceAttributeName{
-		types.InstanceAttributeNameDisableApiTermination,
-		types.InstanceAttributeNameEbsOptimized,
-		types.InstanceAttributeNameInstanceInitiatedShutdownBehavior,
-		types.InstanceAttributeNameInstanceType,
-		types.InstanceAttributeNameKernel,
-		types.InstanceAttributeNameRamdisk,
-		types.InstanceAttributeNameUserData,
-	} {
-		r, err := e.client.DescribeInstanceAttribute(ctx, &awsec2.DescribeInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Attribute:  input,
-		})
-
-		if err != nil {
-			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
-		}
-
-		if r.DisableApiTermination != nil {
-			o.DisableApiTermination = r.DisableApiTermination
-		}
-
-		if r.EbsOptimized != nil {
-			o.EbsOptimized = r.EbsOptimized
-		}
-
-		if r.InstanceInitiatedShutdownBehavior != nil {
-			o.InstanceInitiatedShutdownBehavior = r.InstanceInitiatedShutdownBehavior
-		}
-
-		if r.InstanceType != nil {
-			o.InstanceType = r.InstanceType
-		}
-
-		if r.KernelId != nil {
-			o.KernelId = r.KernelId
-		}
-
-		if r.RamdiskId != nil {
-			o.RamdiskId = r.RamdiskId
-		}
-
-		if r.UserData != nil {
-			o.UserData = r.UserData
-		}
-	}
-
-	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, &o)
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, observed, o)
 
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
@@ -194,7 +147,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}
 	}
 
-	observation := ec2.GenerateInstanceObservation(observed)
+	observation := ec2.GenerateInstanceObservation(*observed)
 	condition := ec2.GenerateInstanceCondition(observation)
 
 	switch condition {
@@ -204,6 +157,18 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		cr.SetConditions(xpv1.Available())
 	case ec2.Deleting:
 		cr.SetConditions(xpv1.Deleting())
+	case ec2.Stopping:
+		if ec2.IsInstanceStopRequired(cr.Spec.ForProvider, *observed, *o) {
+			cr.SetConditions(xpv1.Unavailable().WithMessage(msgStoppingForModify))
+		} else {
+			cr.SetConditions(xpv1.Unavailable().WithMessage(msgStopping))
+		}
+	case ec2.Stopped:
+		if ec2.DesiredInstanceState(cr.Spec.ForProvider) == types.InstanceStateNameStopped {
+			cr.SetConditions(xpv1.Available())
+		} else {
+			cr.SetConditions(xpv1.Unavailable().WithMessage(msgStopped))
+		}
 	case ec2.Deleted:
 		// Terminated instances remain visible on API calls for a time before
 		// being automatically deleted. Rather than having the delete command
@@ -218,7 +183,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o),
+		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, *observed, *o),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -256,82 +221,154 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	if cr.Spec.ForProvider.DisableAPITermination != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			DisableApiTermination: &types.AttributeBooleanValue{
-				Value: cr.Spec.ForProvider.DisableAPITermination,
-			},
+	observed, o := e.observed, e.attributes
+	if observed == nil {
+		var err error
+		if observed, o, err = e.describe(ctx, meta.GetExternalName(cr)); err != nil {
+			return managed.ExternalUpdate{}, err
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, errors.New(errNotFound)
+	}
 
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
-		}
+	var state types.InstanceStateName
+	if observed.State != nil {
+		state = observed.State.Name
+	}
+	switch state { // nolint:exhaustive
+	case types.InstanceStateNamePending, types.InstanceStateNameStopping,
+		types.InstanceStateNameShuttingDown, types.InstanceStateNameTerminated:
+		// The instance is either in transition or being terminated, we act
+		// once it settles.
+		return managed.ExternalUpdate{}, nil
 	}
 
-	if cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior != "" {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			InstanceInitiatedShutdownBehavior: &types.AttributeValue{
-				Value: aws.String(cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior),
-			},
+	// Some of the attributes can be changed only when the instance is
+	// stopped, so a running instance is stopped first and modified in one of
+	// the next reconciles.
+	if state == types.InstanceStateNameRunning && ec2.IsInstanceStopRequired(cr.Spec.ForProvider, *observed, *o) {
+		if _, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errStop)
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgStoppingForModify))
+		return managed.ExternalUpdate{}, nil
+	}
 
-		if err != nil {
+	mods, err := ec2.GenerateModifyInstanceAttributeInputs(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed, *o)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModifyInstanceAttributes)
+	}
+	for _, m := range mods {
+		if _, err := e.client.ModifyInstanceAttribute(ctx, m); err != nil {
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
 		}
 	}
 
-	if cr.Spec.ForProvider.KernelID != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Kernel: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.KernelID,
-			},
+	add, remove := ec2.DiffInstanceTags(cr.Spec.ForProvider.Tags, observed.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
-
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+	}
+	if len(add) != 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
 
-	if cr.Spec.ForProvider.RAMDiskID != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Ramdisk: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.RAMDiskID,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	return managed.ExternalUpdate{}, e.updateState(ctx, cr, state)
+}
+
+// describe returns the instance with the given ID and its attributes that
+// are not included in DescribeInstances output. It returns a nil instance if
+// the instance does not exist.
+func (e *external) describe(ctx context.Context, id string) (*types.Instance, *awsec2.DescribeInstanceAttributeOutput, error) {
+	response, err := e.client.DescribeInstances(ctx, &awsec2.DescribeInstancesInput{
+		InstanceIds: []string{id},
+	})
+	if err != nil {
+		return nil, nil, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.Reservations) == 0 {
+		return nil, nil, nil
+	}
+	// in a successful response, there should be one and only one object
+	if len(response.Reservations[0].Instances) != 1 {
+		return nil, nil, errors.New(errMultipleItems)
+	}
+	observed := response.Reservations[0].Instances[0]
 
+	o := &awsec2.DescribeInstanceAttributeOutput{}
+	for _, input := range []types.InstanceAttributeName{
+		types.InstanceAttributeNameDisableApiTermination,
+		types.InstanceAttributeNameEbsOptimized,
+		types.InstanceAttributeNameInstanceInitiatedShutdownBehavior,
+		types.InstanceAttributeNameInstanceType,
+		types.InstanceAttributeNameKernel,
+		types.InstanceAttributeNameRamdisk,
+		types.InstanceAttributeNameUserData,
+	} {
+		r, err := e.client.DescribeInstanceAttribute(ctx, &awsec2.DescribeInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Attribute:  input,
+		})
 		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+			return nil, nil, awsclient.Wrap(err, errDescribe)
+		}
+		if r.DisableApiTermination != nil {
+			o.DisableApiTermination = r.DisableApiTermination
+		}
+		if r.EbsOptimized != nil {
+			o.EbsOptimized = r.EbsOptimized
+		}
+		if r.InstanceInitiatedShutdownBehavior != nil {
+			o.InstanceInitiatedShutdownBehavior = r.InstanceInitiatedShutdownBehavior
+		}
+		if r.InstanceType != nil {
+			o.InstanceType = r.InstanceType
+		}
+		if r.KernelId != nil {
+			o.KernelId = r.KernelId
+		}
+		if r.RamdiskId != nil {
+			o.RamdiskId = r.RamdiskId
+		}
+		if r.UserData != nil {
+			o.UserData = r.UserData
 		}
 	}
+	return &observed, o, nil
+}
 
-	if cr.Spec.ForProvider.UserData != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			UserData: &types.BlobAttributeValue{
-				Value: []byte(*cr.Spec.ForProvider.UserData),
-			},
+// updateState starts or stops the instance if it is not in its desired state.
+func (e *external) updateState(ctx context.Context, cr *svcapitypes.Instance, observed types.InstanceStateName) error {
+	desired := ec2.DesiredInstanceState(cr.Spec.ForProvider)
+	switch {
+	case observed == types.InstanceStateNameStopped && desired == types.InstanceStateNameRunning:
+		if _, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+		}); err != nil {
+			return awsclient.Wrap(err, errStart)
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
-
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgStarting))
+	case observed == types.InstanceStateNameRunning && desired == types.InstanceStateNameStopped:
+		if _, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+		}); err != nil {
+			return awsclient.Wrap(err, errStop)
 		}
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgStopping))
 	}
-
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
-		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-	})
-
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -48,9 +48,11 @@ var (
 )
 
 type args struct {
-	instance ec2.InstanceClient
-	kube     client.Client
-	cr       *manualv1alpha1.Instance
+	instance   ec2.InstanceClient
+	kube       client.Client
+	cr         *manualv1alpha1.Instance
+	observed   *types.Instance
+	attributes *awsec2.DescribeInstanceAttributeOutput
 }
 
 type instanceModifier func(*manualv1alpha1.Instance)
@@ -152,6 +154,57 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"StoppedAsDesired": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceId: &instanceID,
+							InstanceType: &types.AttributeValue{
+								Value: aws.String(string(types.InstanceTypeM1Small)),
+							},
+						}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        aws.String("stopped"),
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        aws.String("stopped"),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"MultipleInstances": {
 			args: args{
 				kube: &test.MockClient{
@@ -357,7 +410,24 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func describeInstance(i types.Instance) func(context.Context, *awsec2.DescribeInstancesInput, []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+	return func(context.Context, *awsec2.DescribeInstancesInput, []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+		return &awsec2.DescribeInstancesOutput{
+			Reservations: []types.Reservation{{Instances: []types.Instance{i}}},
+		}, nil
+	}
+}
+
+func describeAttributes(o awsec2.DescribeInstanceAttributeOutput) func(context.Context, *awsec2.DescribeInstanceAttributeInput, []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+	return func(context.Context, *awsec2.DescribeInstanceAttributeInput, []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+		return &o, nil
+	}
+}
+
 func TestUpdate(t *testing.T) {
+	running := &types.InstanceState{Name: types.InstanceStateNameRunning}
+	stopped := &types.InstanceState{Name: types.InstanceStateNameStopped}
+
 	type want struct {
 		cr     *manualv1alpha1.Instance
 		result managed.ExternalUpdate
@@ -371,33 +441,218 @@ func TestUpdate(t *testing.T) {
 		"Successful": {
 			args: args{
 				instance: &fake.MockInstanceClient{
+					MockDescribeInstances:         describeInstance(types.Instance{State: running}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
+			},
+		},
+		"ModifyOnlyChangedAttributes": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describeInstance(types.Instance{State: running}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{
+						InstanceInitiatedShutdownBehavior: &types.AttributeValue{Value: aws.String("stop")},
+					}),
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if input.DisableApiTermination == nil {
+							return nil, errBoom
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DisableAPITermination:             aws.Bool(true),
+					InstanceInitiatedShutdownBehavior: "stop",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DisableAPITermination:             aws.Bool(true),
+					InstanceInitiatedShutdownBehavior: "stop",
+				})),
+			},
+		},
+		"UpdateTags": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: describeInstance(types.Instance{
+						State: running,
+						Tags: []types.Tag{
+							{Key: aws.String("foo"), Value: aws.String("baz")},
+							{Key: aws.String("stale"), Value: aws.String("tag")},
+							{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("stack")},
+						},
+					}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+					MockDeleteTags: func(ctx context.Context, input *awsec2.DeleteTagsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+						if len(input.Tags) != 1 || aws.ToString(input.Tags[0].Key) != "stale" {
+							return nil, errBoom
+						}
+						return &awsec2.DeleteTagsOutput{}, nil
+					},
 					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						if len(input.Tags) != 1 || aws.ToString(input.Tags[0].Value) != "bar" {
+							return nil, errBoom
+						}
 						return &awsec2.CreateTagsOutput{}, nil
 					},
-					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
-						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+				},
+				cr: instance(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: instance(withTags(map[string]string{"foo": "bar"})),
+			},
+		},
+		"ReuseObservation": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return nil, errBoom
 					},
 				},
-				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
+				cr:         instance(withSpec(manualv1alpha1.InstanceParameters{})),
+				observed:   &types.Instance{State: running},
+				attributes: &awsec2.DescribeInstanceAttributeOutput{},
 			},
 			want: want{
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
 			},
 		},
-		"ModifyFailed": {
+		"StopToModifyInstanceType": {
 			args: args{
 				instance: &fake.MockInstanceClient{
-					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
-						return &awsec2.CreateTagsOutput{}, errBoom
+					MockDescribeInstances:         describeInstance(types.Instance{State: running, InstanceType: types.InstanceTypeM5Large}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return &awsec2.StopInstancesOutput{}, nil
 					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"})),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"}),
+					withConditions(xpv1.Unavailable().WithMessage(msgStoppingForModify)),
+				),
+			},
+		},
+		"ModifyStoppedInstanceAndStart": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances:         describeInstance(types.Instance{State: stopped, InstanceType: types.InstanceTypeM5Large}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
 					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if input.InstanceType == nil || aws.ToString(input.InstanceType.Value) != "m5.xlarge" {
+							return nil, errBoom
+						}
 						return &awsec2.ModifyInstanceAttributeOutput{}, nil
 					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"})),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"}),
+					withConditions(xpv1.Unavailable().WithMessage(msgStarting)),
+				),
+			},
+		},
+		"StopToDesiredState": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances:         describeInstance(types.Instance{State: running}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{State: aws.String("stopped")})),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{State: aws.String("stopped")}),
+					withConditions(xpv1.Unavailable().WithMessage(msgStopping)),
+				),
+			},
+		},
+		"WaitWhileStopping": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances:         describeInstance(types.Instance{State: &types.InstanceState{Name: types.InstanceStateNameStopping}}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"})),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return nil, errBoom
+					},
 				},
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
 			},
 			want: want{
 				cr:  instance(withSpec(manualv1alpha1.InstanceParameters{})),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"StopFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances:         describeInstance(types.Instance{State: running, InstanceType: types.InstanceTypeM5Large}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"})),
+			},
+			want: want{
+				cr:  instance(withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.xlarge"})),
+				err: awsclient.Wrap(errBoom, errStop),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances:         describeInstance(types.Instance{State: running}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{DisableAPITermination: aws.Bool(true)})),
+			},
+			want: want{
+				cr:  instance(withSpec(manualv1alpha1.InstanceParameters{DisableAPITermination: aws.Bool(true)})),
+				err: awsclient.Wrap(errBoom, errModifyInstanceAttributes),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances:         describeInstance(types.Instance{State: running}),
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, errBoom
+					},
+				},
+				cr: instance(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr:  instance(withTags(map[string]string{"foo": "bar"})),
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
@@ -405,7 +660,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.instance}
+			e := &external{kube: tc.kube, client: tc.instance, observed: tc.observed, attributes: tc.attributes}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	latestVersion = "$Latest"
+
+	errDescribeVersions = "cannot describe the latest version of the launch template"
+	errCreateVersion    = "cannot create a new version of the launch template"
+	errCompareVersion   = "cannot compare the latest version of the launch template"
+)
+
+// SetupLaunchTemplate adds a controller that reconciles LaunchTemplate.
+func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(svcapitypes.LaunchTemplateGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.postCreate = postCreate
+			e.preDelete = preDelete
+			c := &custom{client: e.client}
+			e.isUpToDate = c.isUpToDate
+			e.update = c.update
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(limiter),
+		}).
+		For(&svcapitypes.LaunchTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.LaunchTemplateGroupVersionKind),
+			managed.WithInitializers(awsclients.NewDefaultTagger(mgr.GetClient())),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.DescribeLaunchTemplatesInput) error {
+	obj.LaunchTemplateIds = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, _ *svcsdk.DescribeLaunchTemplatesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Launch templates are usable as soon as they are created.
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.CreateLaunchTemplateOutput, _ managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.LaunchTemplate.LaunchTemplateId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.DeleteLaunchTemplateInput) (bool, error) {
+	// The API accepts either the ID or the name of the launch template but
+	// not both.
+	obj.LaunchTemplateId = awsclients.String(meta.GetExternalName(cr))
+	obj.LaunchTemplateName = nil
+	return false, nil
+}
+
+type custom struct {
+	client svcsdkapi.EC2API
+}
+
+// isUpToDate compares the desired launch template data with the data of the
+// latest version of the launch template. Only the fields given in the spec
+// are compared since AWS fills in defaults for the others.
+func (c *custom) isUpToDate(cr *svcapitypes.LaunchTemplate, _ *svcsdk.DescribeLaunchTemplatesOutput) (bool, error) {
+	resp, err := c.client.DescribeLaunchTemplateVersions(&svcsdk.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: awsclients.String(meta.GetExternalName(cr)),
+		Versions:         []*string{awsclients.String(latestVersion)},
+	})
+	if err != nil {
+		return false, awsclients.Wrap(err, errDescribeVersions)
+	}
+	if len(resp.LaunchTemplateVersions) == 0 {
+		return false, nil
+	}
+	desired := GenerateCreateLaunchTemplateInput(cr).LaunchTemplateData
+	upToDate, err := isSubset(desired, resp.LaunchTemplateVersions[0].LaunchTemplateData)
+	return upToDate, errors.Wrap(err, errCompareVersion)
+}
+
+// update creates a new version of the launch template with the desired launch
+// template data. Launch template versions are immutable, so the default
+// version of the launch template is left as is and the new version is
+// reported as status.atProvider.latestVersionNumber.
+func (c *custom) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.LaunchTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := c.client.CreateLaunchTemplateVersionWithContext(ctx, &svcsdk.CreateLaunchTemplateVersionInput{
+		LaunchTemplateId:   awsclients.String(meta.GetExternalName(cr)),
+		LaunchTemplateData: GenerateCreateLaunchTemplateInput(cr).LaunchTemplateData,
+		VersionDescription: cr.Spec.ForProvider.VersionDescription,
+	})
+	return managed.ExternalUpdate{}, awsclients.Wrap(err, errCreateVersion)
+}
+
+// isSubset reports whether every field set in desired has the same value in
+// observed. The request and response types of the launch template data share
+// their field names, so both are compared through their JSON representation.
+func isSubset(desired, observed interface{}) (bool, error) {
+	d, err := toJSONValue(desired)
+	if err != nil {
+		return false, err
+	}
+	o, err := toJSONValue(observed)
+	if err != nil {
+		return false, err
+	}
+	return isJSONSubset(d, o), nil
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	return res, json.Unmarshal(b, &res)
+}
+
+func isJSONSubset(desired, observed interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		o, ok := observed.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if !isJSONSubset(v, o[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		o, ok := observed.([]interface{})
+		if !ok || len(d) != len(o) {
+			return false
+		}
+		for i := range d {
+			if !isJSONSubset(d[i], o[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(desired, observed)
+	}
+}
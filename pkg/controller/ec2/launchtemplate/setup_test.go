@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	templateID  = "lt-0123456789"
+	imageID     = "ami-123"
+	otherImage  = "ami-456"
+	description = "second version"
+
+	errBoom = errors.New("boom")
+)
+
+type mockClient struct {
+	svcsdkapi.EC2API
+
+	versions []*svcsdk.LaunchTemplateVersion
+	err      error
+
+	describeInput *svcsdk.DescribeLaunchTemplateVersionsInput
+	createInput   *svcsdk.CreateLaunchTemplateVersionInput
+}
+
+func (m *mockClient) DescribeLaunchTemplateVersions(in *svcsdk.DescribeLaunchTemplateVersionsInput) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+	m.describeInput = in
+	return &svcsdk.DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: m.versions}, m.err
+}
+
+func (m *mockClient) CreateLaunchTemplateVersionWithContext(_ context.Context, in *svcsdk.CreateLaunchTemplateVersionInput, _ ...request.Option) (*svcsdk.CreateLaunchTemplateVersionOutput, error) {
+	m.createInput = in
+	return &svcsdk.CreateLaunchTemplateVersionOutput{}, m.err
+}
+
+func launchTemplate(data *svcapitypes.RequestLaunchTemplateData) *svcapitypes.LaunchTemplate {
+	cr := &svcapitypes.LaunchTemplate{}
+	meta.SetExternalName(cr, templateID)
+	cr.Spec.ForProvider.LaunchTemplateData = data
+	cr.Spec.ForProvider.VersionDescription = &description
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		client *mockClient
+		data   *svcapitypes.RequestLaunchTemplateData
+		want   want
+	}{
+		"UpToDate": {
+			client: &mockClient{versions: []*svcsdk.LaunchTemplateVersion{{
+				LaunchTemplateData: &svcsdk.ResponseLaunchTemplateData{
+					ImageId:      &imageID,
+					InstanceType: awsclients.String("t3.small"),
+					SecurityGroupIds: []*string{
+						awsclients.String("sg-1"),
+					},
+				},
+			}}},
+			data: &svcapitypes.RequestLaunchTemplateData{
+				ImageID:          &imageID,
+				SecurityGroupIDs: []*string{awsclients.String("sg-1")},
+			},
+			want: want{upToDate: true},
+		},
+		"FieldChanged": {
+			client: &mockClient{versions: []*svcsdk.LaunchTemplateVersion{{
+				LaunchTemplateData: &svcsdk.ResponseLaunchTemplateData{ImageId: &otherImage},
+			}}},
+			data: &svcapitypes.RequestLaunchTemplateData{ImageID: &imageID},
+			want: want{upToDate: false},
+		},
+		"ListChanged": {
+			client: &mockClient{versions: []*svcsdk.LaunchTemplateVersion{{
+				LaunchTemplateData: &svcsdk.ResponseLaunchTemplateData{
+					SecurityGroupIds: []*string{awsclients.String("sg-1"), awsclients.String("sg-2")},
+				},
+			}}},
+			data: &svcapitypes.RequestLaunchTemplateData{
+				SecurityGroupIDs: []*string{awsclients.String("sg-1")},
+			},
+			want: want{upToDate: false},
+		},
+		"NoVersion": {
+			client: &mockClient{},
+			data:   &svcapitypes.RequestLaunchTemplateData{ImageID: &imageID},
+			want:   want{upToDate: false},
+		},
+		"DescribeFailed": {
+			client: &mockClient{err: errBoom},
+			data:   &svcapitypes.RequestLaunchTemplateData{ImageID: &imageID},
+			want:   want{err: errors.Wrap(errBoom, errDescribeVersions)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{client: tc.client}
+			upToDate, err := c.isUpToDate(launchTemplate(tc.data), nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.err == nil {
+				want := &svcsdk.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: &templateID,
+					Versions:         []*string{awsclients.String(latestVersion)},
+				}
+				if diff := cmp.Diff(want, tc.client.describeInput); diff != "" {
+					t.Errorf("describe input: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *mockClient
+		want   error
+	}{
+		"CreatesVersion": {
+			client: &mockClient{},
+		},
+		"CreateFailed": {
+			client: &mockClient{err: errBoom},
+			want:   errors.Wrap(errBoom, errCreateVersion),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{client: tc.client}
+			_, err := c.update(context.Background(), launchTemplate(&svcapitypes.RequestLaunchTemplateData{ImageID: &imageID}))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			want := &svcsdk.CreateLaunchTemplateVersionInput{
+				LaunchTemplateId:   &templateID,
+				LaunchTemplateData: &svcsdk.RequestLaunchTemplateData{ImageId: &imageID},
+				VersionDescription: &description,
+			}
+			if diff := cmp.Diff(want, tc.client.createInput); diff != "" {
+				t.Errorf("create input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
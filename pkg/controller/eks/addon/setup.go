@@ -68,7 +68,7 @@ func SetupAddon(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.AddonGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}, awsclients.NewDefaultTagger(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -19,11 +19,14 @@ package eks
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,12 +57,26 @@ const (
 	errDescribeFailed      = "cannot describe EKS cluster"
 	errPatchCreationFailed = "cannot create a patch object"
 	errUpToDateFailed      = "cannot check whether object is up-to-date"
+	errDescribeUpdate      = "cannot describe EKS cluster update"
+	errUpdateFailedFmt     = "update %s of EKS cluster failed: %s"
+	errConnectionDetails   = "cannot generate connection details of EKS cluster"
+
+	// kubeconfigRefreshWindow is how long before its expiry the token in the
+	// published kubeconfig is replaced.
+	kubeconfigRefreshWindow = 5 * time.Minute
 )
 
 // SetupCluster adds a controller that reconciles Clusters.
 func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(v1beta1.ClusterGroupKind)
 
+	// Clusters need to be observed at least once in the refresh window so
+	// that the token in their kubeconfig is replaced before it expires.
+	if poll > kubeconfigRefreshWindow {
+		l.Info("Poll interval of EKS clusters is lowered to refresh the tokens in their kubeconfigs before they expire", "poll-interval", poll.String(), "refresh-window", kubeconfigRefreshWindow.String())
+		poll = kubeconfigRefreshWindow
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
@@ -68,8 +85,8 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 		For(&v1beta1.Cluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient}),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient, connections: newConnectionDetailsCache()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}, awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -80,6 +97,7 @@ type connector struct {
 	kube           client.Client
 	newClientFn    func(config aws.Config) eks.Client
 	newSTSClientFn func(config aws.Config) eks.STSClient
+	connections    *connectionDetailsCache
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -91,13 +109,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube, connections: c.connections}, nil
 }
 
 type external struct {
-	client eks.Client
-	sts    eks.STSClient
-	kube   client.Client
+	client      eks.Client
+	sts         eks.STSClient
+	kube        client.Client
+	connections *connectionDetailsCache
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -119,7 +138,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
+	lastUpdate := cr.Status.AtProvider.LastUpdate
 	cr.Status.AtProvider = eks.GenerateObservation(rsp.Cluster)
+	cr.Status.AtProvider.LastUpdate = lastUpdate
+	if lastUpdate != nil && lastUpdate.Status == v1beta1.ClusterUpdateStatusInProgress {
+		u, err := e.client.DescribeUpdate(ctx, &awseks.DescribeUpdateInput{Name: aws.String(meta.GetExternalName(cr)), UpdateId: aws.String(lastUpdate.ID)})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeUpdate)
+		}
+		cr.Status.AtProvider.LastUpdate = eks.GenerateClusterUpdate(u.Update, lastUpdate.Generation)
+	}
 	switch cr.Status.AtProvider.Status { //nolint:exhaustive
 	case v1beta1.ClusterStatusActive:
 		cr.Status.SetConditions(xpv1.Available())
@@ -135,13 +163,39 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	cd, err := e.connectionDetails(ctx, cr, rsp.Cluster)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errConnectionDetails)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts),
+		ConnectionDetails: cd,
 	}, nil
 }
 
+// connectionDetails returns the connection details of the cluster. The ones
+// that were generated before are returned as long as the token in their
+// kubeconfig is not about to expire so that the connection secret isn't
+// updated in every poll.
+func (e *external) connectionDetails(ctx context.Context, cr *v1beta1.Cluster, cluster *ekstypes.Cluster) (managed.ConnectionDetails, error) {
+	kc := cr.Spec.ForProvider.Kubeconfig
+	if e.connections == nil || (kc != nil && kc.Exec != nil) {
+		cd, _, err := eks.GetConnectionDetails(ctx, cluster, e.sts, kc)
+		return cd, err
+	}
+	if cd, ok := e.connections.Get(cr.GetUID(), cluster, time.Now()); ok {
+		return cd, nil
+	}
+	cd, expiry, err := eks.GetConnectionDetails(ctx, cluster, e.sts, kc)
+	if err != nil {
+		return nil, err
+	}
+	e.connections.Set(cr.GetUID(), cluster, cd, expiry)
+	return cd, nil
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.Cluster)
 	if !ok {
@@ -164,6 +218,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	case v1beta1.ClusterStatusUpdating, v1beta1.ClusterStatusCreating:
 		return managed.ExternalUpdate{}, nil
 	}
+	if u := cr.Status.AtProvider.LastUpdate; u != nil {
+		switch u.Status { //nolint:exhaustive
+		case v1beta1.ClusterUpdateStatusInProgress:
+			return managed.ExternalUpdate{}, nil
+		case v1beta1.ClusterUpdateStatusFailed, v1beta1.ClusterUpdateStatusCancelled:
+			// The same update would most likely fail again, so it is retried
+			// only after the spec is changed.
+			if u.Generation == cr.GetGeneration() {
+				rsp, err := e.client.DescribeUpdate(ctx, &awseks.DescribeUpdateInput{Name: awsclient.String(meta.GetExternalName(cr)), UpdateId: awsclient.String(u.ID)})
+				if err != nil {
+					return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeUpdate)
+				}
+				return managed.ExternalUpdate{}, errors.Errorf(errUpdateFailedFmt, u.ID, eks.UpdateErrors(rsp.Update))
+			}
+		}
+	}
 
 	// NOTE(hasheddan): we have to describe the cluster again because different
 	// fields require different update methods.
@@ -187,11 +257,23 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPatchCreationFailed)
 	}
 	if patch.Version != nil {
-		_, err := e.client.UpdateClusterVersion(ctx, &awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: patch.Version})
-		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		version, err := eks.NextVersion(aws.ToString(rsp.Cluster.Version), aws.ToString(patch.Version), cr.GetAnnotations()[v1beta1.AnnotationKeyAllowMultiMinorUpgrade] == "true")
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateVersionFailed)
+		}
+		out, err := e.client.UpdateClusterVersion(ctx, &awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: awsclient.String(version)})
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		}
+		cr.Status.AtProvider.LastUpdate = eks.GenerateClusterUpdate(out.Update, cr.GetGeneration())
+		return managed.ExternalUpdate{}, nil
 	}
-	_, err = e.client.UpdateClusterConfig(ctx, eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch))
-	return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
+	out, err := e.client.UpdateClusterConfig(ctx, eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
+	}
+	cr.Status.AtProvider.LastUpdate = eks.GenerateClusterUpdate(out.Update, cr.GetGeneration())
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -200,6 +282,9 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotEKSCluster)
 	}
 	cr.SetConditions(xpv1.Deleting())
+	if e.connections != nil {
+		e.connections.Delete(cr.GetUID())
+	}
 	if cr.Status.AtProvider.Status == v1beta1.ClusterStatusDeleting {
 		return nil
 	}
@@ -207,6 +292,63 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
 }
 
+// connectionDetailsCache stores the connection details generated for the
+// clusters until the token in their kubeconfig is about to expire.
+type connectionDetailsCache struct {
+	mu      sync.Mutex
+	entries map[types.UID]cachedConnectionDetails
+}
+
+type cachedConnectionDetails struct {
+	endpoint string
+	caData   string
+	details  managed.ConnectionDetails
+	expiry   time.Time
+}
+
+func newConnectionDetailsCache() *connectionDetailsCache {
+	return &connectionDetailsCache{entries: map[types.UID]cachedConnectionDetails{}}
+}
+
+// Get returns the connection details stored for the cluster with given UID
+// if the endpoint and the certificate authority of the cluster haven't
+// changed and the token will not expire within kubeconfigRefreshWindow.
+func (c *connectionDetailsCache) Get(uid types.UID, cluster *ekstypes.Cluster, now time.Time) (managed.ConnectionDetails, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[uid]
+	if !ok || e.endpoint != aws.ToString(cluster.Endpoint) || cluster.CertificateAuthority == nil || e.caData != aws.ToString(cluster.CertificateAuthority.Data) {
+		return nil, false
+	}
+	if !now.Add(kubeconfigRefreshWindow).Before(e.expiry) {
+		return nil, false
+	}
+	return e.details, true
+}
+
+// Set stores the connection details of the cluster with given UID until
+// given expiry time. Connection details without an expiry are not stored.
+func (c *connectionDetailsCache) Set(uid types.UID, cluster *ekstypes.Cluster, cd managed.ConnectionDetails, expiry time.Time) {
+	if expiry.IsZero() || cluster.CertificateAuthority == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[uid] = cachedConnectionDetails{
+		endpoint: aws.ToString(cluster.Endpoint),
+		caData:   aws.ToString(cluster.CertificateAuthority.Data),
+		details:  cd,
+		expiry:   expiry,
+	}
+}
+
+// Delete removes the connection details of the cluster with given UID.
+func (c *connectionDetailsCache) Delete(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, uid)
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -18,10 +18,14 @@ package eks
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
+	"time"
 
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -65,6 +69,18 @@ func withTags(tagMaps ...map[string]string) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.Tags = tags }
 }
 
+func withLastUpdate(u *v1beta1.ClusterUpdate) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Status.AtProvider.LastUpdate = u }
+}
+
+func withAnnotations(a map[string]string) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.SetAnnotations(a) }
+}
+
+func withGeneration(g int64) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.SetGeneration(g) }
+}
+
 func withVersion(v *string) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.Version = v }
 }
@@ -119,7 +135,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -143,7 +159,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -167,7 +183,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -224,7 +240,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -250,6 +266,55 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errKubeUpdateFailed),
 			},
 		},
+		"UpdateInProgress": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{
+								Status: awsekstypes.ClusterStatusActive,
+							},
+						}, nil
+					},
+					MockDescribeUpdate: func(ctx context.Context, input *awseks.DescribeUpdateInput, opts []func(*awseks.Options)) (*awseks.DescribeUpdateOutput, error) {
+						return &awseks.DescribeUpdateOutput{
+							Update: &awsekstypes.Update{Id: input.UpdateId, Type: awsekstypes.UpdateTypeVersionUpdate, Status: awsekstypes.UpdateStatusSuccessful},
+						}, nil
+					},
+				},
+				cr: cluster(withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Type: "VersionUpdate", Status: v1beta1.ClusterUpdateStatusInProgress, Generation: 2})),
+			},
+			want: want{
+				cr: cluster(
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive),
+					withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Type: "VersionUpdate", Status: v1beta1.ClusterUpdateStatusSuccessful, Generation: 2})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"FailedDescribeUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+					MockDescribeUpdate: func(ctx context.Context, input *awseks.DescribeUpdateInput, opts []func(*awseks.Options)) (*awseks.DescribeUpdateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Status: v1beta1.ClusterUpdateStatusInProgress})),
+			},
+			want: want{
+				cr:  cluster(withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Status: v1beta1.ClusterUpdateStatusInProgress})),
+				err: awsclient.Wrap(errBoom, errDescribeUpdate),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -532,6 +597,95 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errAddTagsFailed),
 			},
 		},
+		"StepThroughMinorVersions": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateClusterVersion: func(ctx context.Context, input *awseks.UpdateClusterVersionInput, opts []func(*awseks.Options)) (*awseks.UpdateClusterVersionOutput, error) {
+						if v := awsclient.StringValue(input.Version); v != "1.17" {
+							return nil, errors.Errorf("unexpected version %s", v)
+						}
+						return &awseks.UpdateClusterVersionOutput{
+							Update: &awsekstypes.Update{Id: awsclient.String("update"), Type: awsekstypes.UpdateTypeVersionUpdate, Status: awsekstypes.UpdateStatusInProgress},
+						}, nil
+					},
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{Version: awsclient.String("1.16")},
+						}, nil
+					},
+				},
+				cr: cluster(withVersion(awsclient.String("1.18")), withGeneration(3), withAnnotations(map[string]string{v1beta1.AnnotationKeyAllowMultiMinorUpgrade: "true"})),
+			},
+			want: want{
+				cr: cluster(withVersion(awsclient.String("1.18")), withGeneration(3), withAnnotations(map[string]string{v1beta1.AnnotationKeyAllowMultiMinorUpgrade: "true"}),
+					withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Type: "VersionUpdate", Status: v1beta1.ClusterUpdateStatusInProgress, Generation: 3})),
+			},
+		},
+		"RefuseMultiMinorUpgrade": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{Version: awsclient.String("1.16")},
+						}, nil
+					},
+				},
+				cr: cluster(withVersion(awsclient.String("1.18"))),
+			},
+			want: want{
+				cr: cluster(withVersion(awsclient.String("1.18"))),
+				err: errors.Wrap(errors.Errorf("cannot upgrade from version 1.16 to 1.18 since it is more than one minor version, set %s annotation to true to upgrade one minor version at a time", v1beta1.AnnotationKeyAllowMultiMinorUpgrade),
+					errUpdateVersionFailed),
+			},
+		},
+		"UpdateInProgress": {
+			args: args{
+				cr: cluster(withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Status: v1beta1.ClusterUpdateStatusInProgress})),
+			},
+			want: want{
+				cr: cluster(withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Status: v1beta1.ClusterUpdateStatusInProgress})),
+			},
+		},
+		"LastUpdateFailed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeUpdate: func(ctx context.Context, input *awseks.DescribeUpdateInput, opts []func(*awseks.Options)) (*awseks.DescribeUpdateOutput, error) {
+						return &awseks.DescribeUpdateOutput{
+							Update: &awsekstypes.Update{
+								Id:     input.UpdateId,
+								Status: awsekstypes.UpdateStatusFailed,
+								Errors: []awsekstypes.ErrorDetail{{ErrorCode: awsekstypes.ErrorCodeInsufficientFreeAddresses, ErrorMessage: awsclient.String("not enough IPs")}},
+							},
+						}, nil
+					},
+				},
+				cr: cluster(withGeneration(2), withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Status: v1beta1.ClusterUpdateStatusFailed, Generation: 2})),
+			},
+			want: want{
+				cr:  cluster(withGeneration(2), withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Status: v1beta1.ClusterUpdateStatusFailed, Generation: 2})),
+				err: errors.Errorf(errUpdateFailedFmt, "update", "InsufficientFreeAddresses: not enough IPs"),
+			},
+		},
+		"RetryFailedUpdateAfterSpecChange": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateClusterConfig: func(ctx context.Context, input *awseks.UpdateClusterConfigInput, opts []func(*awseks.Options)) (*awseks.UpdateClusterConfigOutput, error) {
+						return &awseks.UpdateClusterConfigOutput{
+							Update: &awsekstypes.Update{Id: awsclient.String("retry"), Type: awsekstypes.UpdateTypeEndpointAccessUpdate, Status: awsekstypes.UpdateStatusInProgress},
+						}, nil
+					},
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				cr: cluster(withGeneration(3), withLastUpdate(&v1beta1.ClusterUpdate{ID: "update", Status: v1beta1.ClusterUpdateStatusFailed, Generation: 2})),
+			},
+			want: want{
+				cr: cluster(withGeneration(3), withLastUpdate(&v1beta1.ClusterUpdate{ID: "retry", Type: "EndpointAccessUpdate", Status: v1beta1.ClusterUpdateStatusInProgress, Generation: 3})),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -672,3 +826,172 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionDetailsCache(t *testing.T) {
+	now := time.Now()
+	cluster := &awsekstypes.Cluster{
+		Endpoint:             awsclient.String("https://example.eks.amazonaws.com"),
+		CertificateAuthority: &awsekstypes.Certificate{Data: awsclient.String("Y2E=")},
+	}
+	cd := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretKubeconfigKey: []byte("kubeconfig")}
+
+	type args struct {
+		expiry  time.Time
+		cluster *awsekstypes.Cluster
+		now     time.Time
+	}
+	type want struct {
+		cd managed.ConnectionDetails
+		ok bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Fresh": {
+			args: args{
+				expiry:  now.Add(eks.TokenLifetime),
+				cluster: cluster,
+				now:     now,
+			},
+			want: want{cd: cd, ok: true},
+		},
+		"AboutToExpire": {
+			args: args{
+				expiry:  now.Add(eks.TokenLifetime),
+				cluster: cluster,
+				now:     now.Add(eks.TokenLifetime - kubeconfigRefreshWindow),
+			},
+		},
+		"EndpointChanged": {
+			args: args{
+				expiry: now.Add(eks.TokenLifetime),
+				cluster: &awsekstypes.Cluster{
+					Endpoint:             awsclient.String("https://other.eks.amazonaws.com"),
+					CertificateAuthority: cluster.CertificateAuthority,
+				},
+				now: now,
+			},
+		},
+		"NoExpiry": {
+			args: args{
+				cluster: cluster,
+				now:     now,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newConnectionDetailsCache()
+			c.Set("uid", cluster, cd, tc.args.expiry)
+			got, ok := c.Get("uid", tc.args.cluster, tc.args.now)
+			if diff := cmp.Diff(tc.want.ok, ok); diff != "" {
+				t.Errorf("ok: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cd, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveConnectionDetails(t *testing.T) {
+	now := time.Now()
+	// Certificate authority data is not valid base64 so that generating a
+	// kubeconfig fails right after the token is presigned.
+	ekscluster := &awsekstypes.Cluster{
+		Name:                 awsclient.String("example"),
+		Endpoint:             awsclient.String("https://example.eks.amazonaws.com"),
+		CertificateAuthority: &awsekstypes.Certificate{Data: awsclient.String("!")},
+		Status:               awsekstypes.ClusterStatusActive,
+	}
+	cached := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretKubeconfigKey: []byte("cached")}
+	errDecode := errors.Wrap(base64.CorruptInputError(0), "cannot decode certificate authority data")
+
+	type args struct {
+		presignErr error
+		expiry     time.Time
+	}
+	type want struct {
+		cd        managed.ConnectionDetails
+		presigned bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Cached": {
+			args: args{
+				expiry: now.Add(eks.TokenLifetime),
+			},
+			want: want{cd: cached},
+		},
+		"RefreshedBeforeExpiry": {
+			args: args{
+				expiry: now.Add(kubeconfigRefreshWindow / 2),
+			},
+			want: want{
+				presigned: true,
+				err:       errors.Wrap(errDecode, errConnectionDetails),
+			},
+		},
+		"NotCached": {
+			want: want{
+				presigned: true,
+				err:       errors.Wrap(errDecode, errConnectionDetails),
+			},
+		},
+		"PresignFailed": {
+			args: args{
+				presignErr: errBoom,
+			},
+			want: want{
+				presigned: true,
+				err:       errors.Wrap(errors.Wrap(errBoom, "cannot presign GetCallerIdentity request"), errConnectionDetails),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := cluster()
+			cr.SetUID("uid")
+			presigned := false
+			e := &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockClient{
+					MockDescribeCluster: func(_ context.Context, _ *awseks.DescribeClusterInput, _ []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{Cluster: ekscluster}, nil
+					},
+				},
+				sts: &fake.MockSTSClient{
+					MockPresignGetCallerIdentity: func(_ context.Context, _ *sts.GetCallerIdentityInput, _ []func(*sts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+						presigned = true
+						if tc.args.presignErr != nil {
+							return nil, tc.args.presignErr
+						}
+						return &v4.PresignedHTTPRequest{URL: "https://sts.amazonaws.com/"}, nil
+					},
+				},
+				connections: newConnectionDetailsCache(),
+			}
+			if !tc.args.expiry.IsZero() {
+				e.connections.Set("uid", ekscluster, cached, tc.args.expiry)
+			}
+			o, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cd, o.ConnectionDetails); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.presigned, presigned); diff != "" {
+				t.Errorf("presigned: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
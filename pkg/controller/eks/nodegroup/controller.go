@@ -52,6 +52,8 @@ const (
 	errAddTagsFailed       = "cannot add tags to EKS node group"
 	errDeleteFailed        = "cannot delete EKS node group"
 	errDescribeFailed      = "cannot describe EKS node group"
+
+	errCapacityTypeChanged = "capacity type of EKS node group cannot be changed after creation"
 )
 
 // SetupNodeGroup adds a controller that reconciles NodeGroups.
@@ -67,7 +69,7 @@ func SetupNodeGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(manualv1alpha1.NodeGroupGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}, awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -116,6 +118,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = eks.GenerateNodeGroupObservation(rsp.Nodegroup)
+	if eks.IsCapacityTypeChanged(&cr.Spec.ForProvider, rsp.Nodegroup) {
+		return managed.ExternalObservation{}, errors.New(errCapacityTypeChanged)
+	}
 	// Any of the statuses we don't explicitly address should be considered as
 	// the node group being unavailable.
 	switch cr.Status.AtProvider.Status { // nolint:exhaustive
@@ -175,11 +180,8 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errAddTagsFailed)
 		}
 	}
-	if !reflect.DeepEqual(rsp.Nodegroup.Version, cr.Spec.ForProvider.Version) {
-		_, err := e.client.UpdateNodegroupVersion(ctx, &awseks.UpdateNodegroupVersionInput{
-			ClusterName:   &cr.Spec.ForProvider.ClusterName,
-			NodegroupName: awsclient.String(meta.GetExternalName(cr)),
-			Version:       cr.Spec.ForProvider.Version})
+	if !eks.IsNodeGroupVersionUpToDate(&cr.Spec.ForProvider, rsp.Nodegroup) {
+		_, err := e.client.UpdateNodegroupVersion(ctx, eks.GenerateUpdateNodeGroupVersionInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup))
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
 	}
 	_, err = e.client.UpdateNodegroupConfig(ctx, eks.GenerateUpdateNodeGroupConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup))
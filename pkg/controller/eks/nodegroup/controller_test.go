@@ -78,6 +78,14 @@ func withScalingConfig(c *manualv1alpha1.NodeGroupScalingConfig) nodeGroupModifi
 	return func(r *manualv1alpha1.NodeGroup) { r.Spec.ForProvider.ScalingConfig = c }
 }
 
+func withLaunchTemplate(lt *manualv1alpha1.LaunchTemplateSpecification) nodeGroupModifier {
+	return func(r *manualv1alpha1.NodeGroup) { r.Spec.ForProvider.LaunchTemplate = lt }
+}
+
+func withCapacityType(c string) nodeGroupModifier {
+	return func(r *manualv1alpha1.NodeGroup) { r.Spec.ForProvider.CapacityType = &c }
+}
+
 func nodeGroup(m ...nodeGroupModifier) *manualv1alpha1.NodeGroup {
 	cr := &manualv1alpha1.NodeGroup{}
 	for _, f := range m {
@@ -247,6 +255,27 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errKubeUpdateFailed),
 			},
 		},
+		"CapacityTypeChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								Status:       awsekstypes.NodegroupStatusActive,
+								CapacityType: awsekstypes.CapacityTypesOnDemand,
+							},
+						}, nil
+					},
+				},
+				cr: nodeGroup(withCapacityType("SPOT")),
+			},
+			want: want{
+				cr: nodeGroup(
+					withCapacityType("SPOT"),
+					withStatus(manualv1alpha1.NodeGroupStatusActive)),
+				err: errors.New(errCapacityTypeChanged),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -409,6 +438,30 @@ func TestUpdate(t *testing.T) {
 				cr: nodeGroup(withVersion(&version)),
 			},
 		},
+		"SuccessfulUpdateLaunchTemplateVersion": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateNodegroupVersion: func(tx context.Context, input *awseks.UpdateNodegroupVersionInput, opts []func(*awseks.Options)) (*awseks.UpdateNodegroupVersionOutput, error) {
+						if input.Version != nil || awsclient.StringValue(input.LaunchTemplate.Version) != "2" {
+							return nil, errBoom
+						}
+						return &awseks.UpdateNodegroupVersionOutput{}, nil
+					},
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								Version:        &version,
+								LaunchTemplate: &awsekstypes.LaunchTemplateSpecification{Id: awsclient.String("lt-123"), Version: awsclient.String("1")},
+							},
+						}, nil
+					},
+				},
+				cr: nodeGroup(withVersion(&version), withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{ID: awsclient.String("lt-123"), Version: awsclient.String("2")})),
+			},
+			want: want{
+				cr: nodeGroup(withVersion(&version), withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{ID: awsclient.String("lt-123"), Version: awsclient.String("2")})),
+			},
+		},
 		"SuccessfulUpdateNodeGroup": {
 			args: args{
 				eks: &fake.MockClient{
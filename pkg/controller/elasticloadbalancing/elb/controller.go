@@ -68,6 +68,7 @@ func SetupELB(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ELBGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
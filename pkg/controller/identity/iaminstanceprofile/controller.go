@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iaminstanceprofile
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an IAMInstanceProfile resource"
+	errGet              = "failed to get IAMInstanceProfile with name"
+	errCreate           = "failed to create the IAMInstanceProfile resource"
+	errDelete           = "failed to delete the IAMInstanceProfile resource"
+	errSDK              = "empty IAMInstanceProfile received from IAM API"
+	errAddRole          = "cannot add role to the IAMInstanceProfile"
+	errRemoveRole       = "cannot remove role from the IAMInstanceProfile"
+	errTag              = "cannot tag the IAMInstanceProfile"
+	errUntag            = "cannot untag the IAMInstanceProfile"
+)
+
+// SetupIAMInstanceProfile adds a controller that reconciles
+// IAMInstanceProfiles.
+func SetupIAMInstanceProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1beta1.IAMInstanceProfileGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1beta1.IAMInstanceProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.IAMInstanceProfileGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewInstanceProfileClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewDefaultTagger(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.InstanceProfileClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client iam.InstanceProfileClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.IAMInstanceProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.GetInstanceProfile(ctx, &awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if observed.InstanceProfile == nil {
+		return managed.ExternalObservation{}, errors.New(errSDK)
+	}
+	profile := *observed.InstanceProfile
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeInstanceProfile(&cr.Spec.ForProvider, &profile)
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = iam.GenerateInstanceProfileObservation(profile)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        iam.IsInstanceProfileUpToDate(cr.Spec.ForProvider, profile),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.IAMInstanceProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	// The role is added in the first update since the instance profile is
+	// observed without it after the creation.
+	_, err := e.client.CreateInstanceProfile(ctx, iam.GenerateCreateInstanceProfileInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.IAMInstanceProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.GetInstanceProfile(ctx, &awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if observed.InstanceProfile == nil {
+		return managed.ExternalUpdate{}, errors.New(errSDK)
+	}
+
+	if err := e.updateRole(ctx, cr, *observed.InstanceProfile); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	tags := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tags[t.Key] = t.Value
+	}
+	add, remove, _ := iam.DiffIAMTags(tags, observed.InstanceProfile.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagInstanceProfile(ctx, &awsiam.UntagInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			TagKeys:             remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagInstanceProfile(ctx, &awsiam.TagInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			Tags:                add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errTag)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateRole replaces the role of the instance profile with the desired one
+// since an instance profile can contain only one role.
+func (e *external) updateRole(ctx context.Context, cr *v1beta1.IAMInstanceProfile, observed iamtypes.InstanceProfile) error {
+	current, desired := iam.InstanceProfileRoleName(observed), aws.ToString(cr.Spec.ForProvider.RoleName)
+	if current == desired {
+		return nil
+	}
+	if current != "" {
+		if _, err := e.client.RemoveRoleFromInstanceProfile(ctx, &awsiam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(current),
+		}); err != nil {
+			return awsclient.Wrap(err, errRemoveRole)
+		}
+	}
+	if desired != "" {
+		if _, err := e.client.AddRoleToInstanceProfile(ctx, &awsiam.AddRoleToInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(desired),
+		}); err != nil {
+			return awsclient.Wrap(err, errAddRole)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.IAMInstanceProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	// An instance profile cannot be deleted while it contains a role.
+	if cr.Spec.ForProvider.RoleName != nil {
+		if _, err := e.client.RemoveRoleFromInstanceProfile(ctx, &awsiam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            cr.Spec.ForProvider.RoleName,
+		}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return awsclient.Wrap(err, errRemoveRole)
+		}
+	}
+
+	_, err := e.client.DeleteInstanceProfile(ctx, &awsiam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
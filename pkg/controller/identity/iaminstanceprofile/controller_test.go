@@ -0,0 +1,486 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iaminstanceprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	profileName    = "some-profile"
+	profileARN     = "arn:aws:iam::123456789012:instance-profile/some-profile"
+	roleName       = "some-role"
+	otherRoleName  = "other-role"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.InstanceProfileClient
+	cr  resource.Managed
+}
+
+type profileModifier func(*v1beta1.IAMInstanceProfile)
+
+func withConditions(c ...xpv1.Condition) profileModifier {
+	return func(r *v1beta1.IAMInstanceProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) profileModifier {
+	return func(r *v1beta1.IAMInstanceProfile) { meta.SetExternalName(r, s) }
+}
+
+func withRoleName(s string) profileModifier {
+	return func(r *v1beta1.IAMInstanceProfile) { r.Spec.ForProvider.RoleName = aws.String(s) }
+}
+
+func withARN(s string) profileModifier {
+	return func(r *v1beta1.IAMInstanceProfile) { r.Status.AtProvider.ARN = s }
+}
+
+func profile(m ...profileModifier) *v1beta1.IAMInstanceProfile {
+	cr := &v1beta1.IAMInstanceProfile{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return &awsiam.GetInstanceProfileOutput{
+							InstanceProfile: &awsiamtypes.InstanceProfile{
+								Arn:   aws.String(profileARN),
+								Roles: []awsiamtypes.Role{{RoleName: aws.String(roleName)}},
+							},
+						}, nil
+					},
+				},
+				cr: profile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: profile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withARN(profileARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RoleNotAdded": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return &awsiam.GetInstanceProfileOutput{
+							InstanceProfile: &awsiamtypes.InstanceProfile{Arn: aws.String(profileARN)},
+						}, nil
+					},
+				},
+				cr: profile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: profile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withARN(profileARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"LateInitRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return &awsiam.GetInstanceProfileOutput{
+							InstanceProfile: &awsiamtypes.InstanceProfile{
+								Arn:   aws.String(profileARN),
+								Roles: []awsiamtypes.Role{{RoleName: aws.String(roleName)}},
+							},
+						}, nil
+					},
+				},
+				cr: profile(withExternalName(profileName)),
+			},
+			want: want{
+				cr: profile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withARN(profileARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: profile(withExternalName(profileName)),
+			},
+			want: want{
+				cr: profile(withExternalName(profileName)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: profile(withExternalName(profileName)),
+			},
+			want: want{
+				cr:  profile(withExternalName(profileName)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfile: func(ctx context.Context, input *awsiam.CreateInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateInstanceProfileOutput, error) {
+						if aws.ToString(input.InstanceProfileName) != profileName {
+							return nil, errBoom
+						}
+						return &awsiam.CreateInstanceProfileOutput{}, nil
+					},
+				},
+				cr: profile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: profile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfile: func(ctx context.Context, input *awsiam.CreateInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr:  profile(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return &awsiam.GetInstanceProfileOutput{
+							InstanceProfile: &awsiamtypes.InstanceProfile{
+								Roles: []awsiamtypes.Role{{RoleName: aws.String(otherRoleName)}},
+							},
+						}, nil
+					},
+					MockRemoveRoleFromInstanceProfile: func(ctx context.Context, input *awsiam.RemoveRoleFromInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.RemoveRoleFromInstanceProfileOutput, error) {
+						if aws.ToString(input.RoleName) != otherRoleName {
+							return nil, errBoom
+						}
+						return &awsiam.RemoveRoleFromInstanceProfileOutput{}, nil
+					},
+					MockAddRoleToInstanceProfile: func(ctx context.Context, input *awsiam.AddRoleToInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.AddRoleToInstanceProfileOutput, error) {
+						if aws.ToString(input.RoleName) != roleName {
+							return nil, errBoom
+						}
+						return &awsiam.AddRoleToInstanceProfileOutput{}, nil
+					},
+				},
+				cr: profile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: profile(withExternalName(profileName), withRoleName(roleName)),
+			},
+		},
+		"Tags": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return &awsiam.GetInstanceProfileOutput{
+							InstanceProfile: &awsiamtypes.InstanceProfile{
+								Tags: []awsiamtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+							},
+						}, nil
+					},
+					MockUntagInstanceProfile: func(ctx context.Context, input *awsiam.UntagInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.UntagInstanceProfileOutput, error) {
+						return &awsiam.UntagInstanceProfileOutput{}, nil
+					},
+				},
+				cr: profile(withExternalName(profileName)),
+			},
+			want: want{
+				cr: profile(withExternalName(profileName)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientAddRoleError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(ctx context.Context, input *awsiam.GetInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return &awsiam.GetInstanceProfileOutput{
+							InstanceProfile: &awsiamtypes.InstanceProfile{},
+						}, nil
+					},
+					MockAddRoleToInstanceProfile: func(ctx context.Context, input *awsiam.AddRoleToInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.AddRoleToInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: profile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr:  profile(withExternalName(profileName), withRoleName(roleName)),
+				err: awsclient.Wrap(errBoom, errAddRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockRemoveRoleFromInstanceProfile: func(ctx context.Context, input *awsiam.RemoveRoleFromInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.RemoveRoleFromInstanceProfileOutput, error) {
+						return &awsiam.RemoveRoleFromInstanceProfileOutput{}, nil
+					},
+					MockDeleteInstanceProfile: func(ctx context.Context, input *awsiam.DeleteInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteInstanceProfileOutput, error) {
+						return &awsiam.DeleteInstanceProfileOutput{}, nil
+					},
+				},
+				cr: profile(withExternalName(profileName), withRoleName(roleName)),
+			},
+			want: want{
+				cr: profile(
+					withExternalName(profileName),
+					withRoleName(roleName),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientRemoveRoleError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockRemoveRoleFromInstanceProfile: func(ctx context.Context, input *awsiam.RemoveRoleFromInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.RemoveRoleFromInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: profile(withRoleName(roleName)),
+			},
+			want: want{
+				cr:  profile(withRoleName(roleName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errRemoveRole),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockDeleteInstanceProfile: func(ctx context.Context, input *awsiam.DeleteInstanceProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteInstanceProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: profile(),
+			},
+			want: want{
+				cr: profile(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
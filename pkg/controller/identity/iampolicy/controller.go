@@ -39,9 +39,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
@@ -55,6 +57,8 @@ const (
 	errEmptyPolicy   = "empty IAM Policy received from IAM API"
 	errPolicyVersion = "No version for policy received from IAM API"
 	errUpToDate      = "cannot check if policy is up to date"
+	errListVersions  = "cannot list policy versions"
+	errNoDocument    = "either document or policyDocument must be specified"
 )
 
 // SetupIAMPolicy adds a controller that reconciles IAM Policy.
@@ -70,13 +74,22 @@ func SetupIAMPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.IAMPolicyGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}),
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclient.NewDefaultTagger(mgr.GetClient())),
+			managed.WithReferenceResolver(awspolicy.NewReferenceResolver(mgr.GetClient(), policyDocuments)),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+func policyDocuments(mg resource.Managed) []*commonv1alpha1.PolicyDocument {
+	cr, ok := mg.(*v1alpha1.IAMPolicy)
+	if !ok {
+		return nil
+	}
+	return []*commonv1alpha1.PolicyDocument{cr.Spec.ForProvider.PolicyDocument}
+}
+
 type connector struct {
 	kube           client.Client
 	newClientFn    func(config aws.Config) iam.PolicyClient
@@ -138,6 +151,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		PolicyID:                      aws.ToString(policy.PolicyId),
 	}
 
+	versions, err := e.listPolicyVersions(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListVersions)
+	}
+	cr.Status.AtProvider.Versions = iam.GenerateIAMPolicyVersions(versions)
+
 	versionRsp, err := e.client.GetPolicyVersion(ctx, &awsiam.GetPolicyVersionInput{
 		PolicyArn: aws.String(meta.GetExternalName(cr)),
 		VersionId: aws.String(cr.Status.AtProvider.DefaultVersionID),
@@ -158,10 +177,11 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		crTagMap[v.Key] = v.Value
 	}
 	_, _, areRolesUpdated := iam.DiffIAMTags(crTagMap, policyResp.Policy.Tags)
+	pruned := len(iam.PolicyVersionsToPrune(versions, iam.RetainedPolicyVersions(cr.Spec.ForProvider))) == 0
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: update && areRolesUpdated,
+		ResourceUpToDate: update && areRolesUpdated && pruned,
 	}, nil
 }
 
@@ -170,6 +190,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
+	if cr.Spec.ForProvider.Document == "" && cr.Spec.ForProvider.PolicyDocument == nil {
+		return managed.ExternalCreation{}, errors.New(errNoDocument)
+	}
 
 	tags := cr.Spec.ForProvider.Tags
 	inputPolicyTags := make([]awsiamtypes.Tag, len(tags))
@@ -183,7 +206,7 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	createOutput, err := e.client.CreatePolicy(ctx, &awsiam.CreatePolicyInput{
 		Description:    cr.Spec.ForProvider.Description,
 		Path:           cr.Spec.ForProvider.Path,
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		PolicyDocument: aws.String(iam.IAMPolicyDocument(cr.Spec.ForProvider)),
 		PolicyName:     aws.String(cr.Spec.ForProvider.Name),
 		Tags:           inputPolicyTags,
 	})
@@ -203,22 +226,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// An update to AWS Policy is a new version of that policy.
-	// A maximum of 5 versions are allowed. Below, the oldest version is deleted
-	// for an update request when 5 versions already exist.
-	// The new version is set as default.
-
-	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
-	}
-
-	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
-		PolicyArn:      aws.String(meta.GetExternalName(cr)),
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
-		SetAsDefault:   true,
-	})
-
-	if err != nil {
+	if err := e.updateVersions(ctx, cr); err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 	}
 
@@ -296,35 +304,82 @@ func (e *external) listPolicyVersions(ctx context.Context, policyArn string) ([]
 	return resp.Versions, nil
 }
 
-func (e *external) deleteOldestVersion(ctx context.Context, arn string) error {
-	allVersions, err := e.listPolicyVersions(ctx, arn)
+// updateVersions makes the version whose document matches the desired one
+// the default version of the policy. An existing version is reused if there
+// is one so that reverting the document restores the prior version, and a new
+// version is created otherwise. The oldest versions that don't fit into the
+// retained version count are deleted.
+func (e *external) updateVersions(ctx context.Context, cr *v1alpha1.IAMPolicy) error {
+	policyArn := meta.GetExternalName(cr)
+	retain := iam.RetainedPolicyVersions(cr.Spec.ForProvider)
+	versions, err := e.listPolicyVersions(ctx, policyArn)
 	if err != nil {
 		return err
 	}
 
-	if len(allVersions) < 5 {
-		return nil
+	var match *awsiamtypes.PolicyVersion
+	for i := range versions {
+		rsp, err := e.client.GetPolicyVersion(ctx, &awsiam.GetPolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: versions[i].VersionId,
+		})
+		if err != nil {
+			return err
+		}
+		if rsp.PolicyVersion == nil {
+			continue
+		}
+		if ok, _ := iam.IsPolicyUpToDate(cr.Spec.ForProvider, *rsp.PolicyVersion); ok && (match == nil || versions[i].IsDefaultVersion) {
+			match = &versions[i]
+		}
 	}
 
-	var oldestVersion awsiamtypes.PolicyVersion
-
-	// loop through all version to find the oldest version.
-	for _, version := range allVersions {
-		if version.IsDefaultVersion {
-			continue
+	switch {
+	case match == nil:
+		if cr.Spec.ForProvider.Document == "" && cr.Spec.ForProvider.PolicyDocument == nil {
+			return errors.New(errNoDocument)
+		}
+		// IAM doesn't allow creating a version when the maximum number of
+		// versions is reached, so the room for it is made beforehand.
+		if err := e.deletePolicyVersions(ctx, policyArn, iam.PolicyVersionsToPrune(versions, retain-1)); err != nil {
+			return err
 		}
-		if oldestVersion.CreateDate == nil ||
-			version.CreateDate.Before(*oldestVersion.CreateDate) {
-			oldestVersion = version
+		if _, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
+			PolicyArn:      aws.String(policyArn),
+			PolicyDocument: aws.String(iam.IAMPolicyDocument(cr.Spec.ForProvider)),
+			SetAsDefault:   true,
+		}); err != nil {
+			return err
+		}
+	case !match.IsDefaultVersion:
+		if _, err := e.client.SetDefaultPolicyVersion(ctx, &awsiam.SetDefaultPolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: match.VersionId,
+		}); err != nil {
+			return err
 		}
+	default:
+		return e.deletePolicyVersions(ctx, policyArn, iam.PolicyVersionsToPrune(versions, retain))
 	}
 
-	_, err = e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
-		PolicyArn: aws.String(arn),
-		VersionId: oldestVersion.VersionId,
-	})
+	// The default version has changed, so the versions are listed again to
+	// prune the old default version if needed.
+	if versions, err = e.listPolicyVersions(ctx, policyArn); err != nil {
+		return err
+	}
+	return e.deletePolicyVersions(ctx, policyArn, iam.PolicyVersionsToPrune(versions, retain))
+}
 
-	return err
+func (e *external) deletePolicyVersions(ctx context.Context, policyArn string, ids []string) error {
+	for _, id := range ids {
+		if _, err := e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: aws.String(id),
+		}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *external) deleteNonDefaultVersions(ctx context.Context, policyArn string) error {
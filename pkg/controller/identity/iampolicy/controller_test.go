@@ -19,6 +19,7 @@ package iampolicy
 import (
 	"context"
 	"testing"
+	"time"
 
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
@@ -26,6 +27,7 @@ import (
 	"github.com/aws/smithy-go/middleware"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -56,7 +58,18 @@ var (
 		  }
 		]
 	  }`
-	boolFalse = false
+	boolFalse   = false
+	newDocument = `{
+		"Version": "2012-10-17",
+		"Statement": [
+		  {
+			  "Effect": "Allow",
+			  "Action": "s3:GetObject",
+			  "Resource": "*"
+		  }
+		]
+	  }`
+	versionTime = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
 
 	errBoom = errors.New("boom")
 
@@ -91,12 +104,38 @@ func withSpec(spec v1alpha1.IAMPolicyParameters) policyModifier {
 	}
 }
 
+func withDocument(doc string) policyModifier {
+	return func(r *v1alpha1.IAMPolicy) {
+		r.Spec.ForProvider.Document = doc
+	}
+}
+
 func withPath(path string) policyModifier {
 	return func(r *v1alpha1.IAMPolicy) {
 		r.Spec.ForProvider.Path = awsclient.String(path)
 	}
 }
 
+func withVersions(v ...v1alpha1.IAMPolicyVersion) policyModifier {
+	return func(r *v1alpha1.IAMPolicy) {
+		r.Status.AtProvider.Versions = v
+	}
+}
+
+func policyVersion(id string, isDefault bool, age int) awsiamtypes.PolicyVersion {
+	t := versionTime.Add(-time.Duration(age) * time.Hour)
+	return awsiamtypes.PolicyVersion{
+		VersionId:        awsclient.String(id),
+		IsDefaultVersion: isDefault,
+		CreateDate:       &t,
+	}
+}
+
+func observedVersion(id string, isDefault bool, age int) v1alpha1.IAMPolicyVersion {
+	t := metav1.NewTime(versionTime.Add(-time.Duration(age) * time.Hour))
+	return v1alpha1.IAMPolicyVersion{VersionID: id, IsDefaultVersion: isDefault, CreateDate: &t}
+}
+
 func policy(m ...policyModifier) *v1alpha1.IAMPolicy {
 	cr := &v1alpha1.IAMPolicy{}
 	cr.Spec.ForProvider.Name = name
@@ -126,6 +165,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -151,6 +193,55 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"VersionsNotPruned": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								policyVersion("v1", false, 2),
+								policyVersion("v3", true, 0),
+								policyVersion("v2", false, 1),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{
+								Document: &document,
+							},
+						}, nil
+					},
+				},
+				cr: policy(withSpec(v1alpha1.IAMPolicyParameters{
+					Document:       document,
+					Name:           name,
+					RetainVersions: awsclient.Int32(2),
+				}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1alpha1.IAMPolicyParameters{
+					Document:       document,
+					Name:           name,
+					RetainVersions: awsclient.Int32(2),
+				}), withExternalName(policyArn),
+					withVersions(
+						observedVersion("v3", true, 0),
+						observedVersion("v2", false, 1),
+						observedVersion("v1", false, 2),
+					),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -182,6 +273,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -213,6 +307,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, &awsiamtypes.NoSuchEntityException{}
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -243,6 +340,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, &awsiamtypes.NoSuchEntityException{}
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -273,6 +373,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -368,13 +471,23 @@ func TestCreate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: policy(),
+				cr: policy(withDocument(document)),
 			},
 			want: want{
-				cr:  policy(),
+				cr:  policy(withDocument(document)),
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"NoDocument": {
+			args: args{
+				iam: &fake.MockPolicyClient{},
+				cr:  policy(),
+			},
+			want: want{
+				cr:  policy(),
+				err: errors.New(errNoDocument),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -425,10 +538,103 @@ func TestUpdate(t *testing.T) {
 						}, nil
 					},
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withDocument(document), withExternalName(policyArn)),
 			},
 			want: want{
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withDocument(document), withExternalName(policyArn)),
+			},
+		},
+		"ReuseMatchingVersion": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								policyVersion("v2", true, 0),
+								policyVersion("v1", false, 1),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						doc := newDocument
+						if awsclient.StringValue(input.VersionId) == "v1" {
+							doc = document
+						}
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{
+								Document: &doc,
+							},
+						}, nil
+					},
+					MockSetDefaultPolicyVersion: func(ctx context.Context, input *awsiam.SetDefaultPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.SetDefaultPolicyVersionOutput, error) {
+						if awsclient.StringValue(input.VersionId) != "v1" {
+							return nil, errBoom
+						}
+						return &awsiam.SetDefaultPolicyVersionOutput{}, nil
+					},
+					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(withSpec(v1alpha1.IAMPolicyParameters{
+					Document: document,
+					Name:     name,
+				}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1alpha1.IAMPolicyParameters{
+					Document: document,
+					Name:     name,
+				}), withExternalName(policyArn)),
+			},
+		},
+		"PruneVersionsOverRetained": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								policyVersion("v2", true, 0),
+								policyVersion("v1", false, 1),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{
+								Document: &document,
+							},
+						}, nil
+					},
+					MockDeletePolicyVersion: func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
+						if awsclient.StringValue(input.VersionId) != "v1" {
+							return nil, errBoom
+						}
+						return &awsiam.DeletePolicyVersionOutput{}, nil
+					},
+				},
+				cr: policy(withSpec(v1alpha1.IAMPolicyParameters{
+					Document:       document,
+					Name:           name,
+					RetainVersions: awsclient.Int32(1),
+				}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1alpha1.IAMPolicyParameters{
+					Document:       document,
+					Name:           name,
+					RetainVersions: awsclient.Int32(1),
+				}), withExternalName(policyArn)),
 			},
 		},
 		"InValidInput": {
@@ -467,11 +673,25 @@ func TestUpdate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
+				cr: policy(withDocument(document), withExternalName(policyArn)),
+			},
+			want: want{
+				cr:  policy(withDocument(document), withExternalName(policyArn)),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"NoDocument": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
+				},
 				cr: policy(withExternalName(policyArn)),
 			},
 			want: want{
 				cr:  policy(withExternalName(policyArn)),
-				err: awsclient.Wrap(errBoom, errUpdate),
+				err: awsclient.Wrap(errors.New(errNoDocument), errUpdate),
 			},
 		},
 	}
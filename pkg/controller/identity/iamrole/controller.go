@@ -37,9 +37,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
@@ -50,6 +52,10 @@ const (
 	errUpdate           = "failed to update the IAMRole resource"
 	errSDK              = "empty IAMRole received from IAM API"
 	errCreatePatch      = "failed to create patch object for comparison"
+	errGetInlinePolicy  = "cannot get inline policies of the IAMRole"
+	errPutInlinePolicy  = "cannot put inline policy of the IAMRole"
+	errDelInlinePolicy  = "cannot delete inline policy of the IAMRole"
+	errNoAssumeRole     = "either assumeRolePolicyDocument or trustPolicyDocument must be specified"
 
 	errKubeUpdateFailed = "cannot late initialize IAMRole"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
@@ -68,13 +74,26 @@ func SetupIAMRole(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.IAMRoleGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewDefaultTagger(mgr.GetClient())),
+			managed.WithReferenceResolver(awspolicy.NewReferenceResolver(mgr.GetClient(), policyDocuments)),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+func policyDocuments(mg resource.Managed) []*commonv1alpha1.PolicyDocument {
+	cr, ok := mg.(*v1beta1.IAMRole)
+	if !ok {
+		return nil
+	}
+	docs := []*commonv1alpha1.PolicyDocument{cr.Spec.ForProvider.TrustPolicyDocument}
+	for i := range cr.Spec.ForProvider.InlinePolicies {
+		docs = append(docs, cr.Spec.ForProvider.InlinePolicies[i].PolicyDocument)
+	}
+	return docs
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) iam.RoleClient
@@ -129,6 +148,15 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	if upToDate && cr.Spec.ForProvider.InlinePolicies != nil {
+		current, err := e.getInlinePolicies(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetInlinePolicy)
+		}
+		put, remove := iam.DiffInlinePolicies(cr.Spec.ForProvider.InlinePolicies, current)
+		upToDate = len(put) == 0 && len(remove) == 0
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: upToDate,
@@ -143,6 +171,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	cr.Status.SetConditions(xpv1.Creating())
+	if cr.Spec.ForProvider.AssumeRolePolicyDocument == "" && cr.Spec.ForProvider.TrustPolicyDocument == nil {
+		return managed.ExternalCreation{}, errors.New(errNoAssumeRole)
+	}
 
 	_, err := e.client.CreateRole(ctx, iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
@@ -205,18 +236,77 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if patch.AssumeRolePolicyDocument != "" {
+	policyUpToDate, err := iam.IsAssumeRolePolicyUpToDate(cr.Spec.ForProvider, *observed.Role)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if !policyUpToDate {
 		_, err = e.client.UpdateAssumeRolePolicy(ctx, &awsiam.UpdateAssumeRolePolicyInput{
-			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
+			PolicyDocument: aws.String(iam.AssumeRolePolicyDocument(cr.Spec.ForProvider)),
 			RoleName:       aws.String(meta.GetExternalName(cr)),
 		})
 		if err != nil {
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
+
+	if cr.Spec.ForProvider.InlinePolicies != nil {
+		return managed.ExternalUpdate{}, e.updateInlinePolicies(ctx, cr)
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
+func (e *external) getInlinePolicies(ctx context.Context, roleName string) (map[string]string, error) {
+	policies := map[string]string{}
+	input := &awsiam.ListRolePoliciesInput{RoleName: aws.String(roleName)}
+	for {
+		out, err := e.client.ListRolePolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range out.PolicyNames {
+			p, err := e.client.GetRolePolicy(ctx, &awsiam.GetRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(name),
+			})
+			if err != nil {
+				return nil, err
+			}
+			policies[name] = aws.ToString(p.PolicyDocument)
+		}
+		if !out.IsTruncated {
+			return policies, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+func (e *external) updateInlinePolicies(ctx context.Context, cr *v1beta1.IAMRole) error {
+	current, err := e.getInlinePolicies(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return awsclient.Wrap(err, errGetInlinePolicy)
+	}
+	put, remove := iam.DiffInlinePolicies(cr.Spec.ForProvider.InlinePolicies, current)
+	for name, doc := range put {
+		if _, err := e.client.PutRolePolicy(ctx, &awsiam.PutRolePolicyInput{
+			RoleName:       aws.String(meta.GetExternalName(cr)),
+			PolicyName:     aws.String(name),
+			PolicyDocument: aws.String(doc),
+		}); err != nil {
+			return awsclient.Wrap(err, errPutInlinePolicy)
+		}
+	}
+	for _, name := range remove {
+		if _, err := e.client.DeleteRolePolicy(ctx, &awsiam.DeleteRolePolicyInput{
+			RoleName:   aws.String(meta.GetExternalName(cr)),
+			PolicyName: aws.String(name),
+		}); err != nil {
+			return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelInlinePolicy)
+		}
+	}
+	return nil
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1beta1.IAMRole)
 	if !ok {
@@ -225,6 +315,16 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	// A role cannot be deleted while it has inline policies.
+	for _, p := range cr.Spec.ForProvider.InlinePolicies {
+		if _, err := e.client.DeleteRolePolicy(ctx, &awsiam.DeleteRolePolicyInput{
+			RoleName:   aws.String(meta.GetExternalName(cr)),
+			PolicyName: aws.String(p.Name),
+		}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return awsclient.Wrap(err, errDelInlinePolicy)
+		}
+	}
+
 	_, err := e.client.DeleteRole(ctx, &awsiam.DeleteRoleInput{
 		RoleName: aws.String(meta.GetExternalName(cr)),
 	})
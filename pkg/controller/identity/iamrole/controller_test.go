@@ -18,6 +18,7 @@ package iamrole
 
 import (
 	"context"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -90,6 +91,12 @@ func withDescription() roleModifier {
 	}
 }
 
+func withInlinePolicy(name, doc string) roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Spec.ForProvider.InlinePolicies = append(r.Spec.ForProvider.InlinePolicies, v1beta1.InlinePolicy{Name: name, Document: aws.String(doc)})
+	}
+}
+
 func role(m ...roleModifier) *v1beta1.IAMRole {
 	cr := &v1beta1.IAMRole{}
 	for _, f := range m {
@@ -131,6 +138,33 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"InlinePolicyNotUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{PolicyNames: []string{"stale"}}, nil
+					},
+					MockGetRolePolicy: func(ctx context.Context, input *awsiam.GetRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						return &awsiam.GetRolePolicyOutput{PolicyDocument: aws.String(policy)}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withInlinePolicy("p", policy)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withInlinePolicy("p", policy),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -206,11 +240,12 @@ func TestCreate(t *testing.T) {
 						return &awsiam.CreateRoleOutput{}, nil
 					},
 				},
-				cr: role(withRoleName(&roleName)),
+				cr: role(withRoleName(&roleName), withPolicy()),
 			},
 			want: want{
 				cr: role(
 					withRoleName(&roleName),
+					withPolicy(),
 					withConditions(xpv1.Creating())),
 			},
 		},
@@ -230,13 +265,23 @@ func TestCreate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: role(),
+				cr: role(withPolicy()),
 			},
 			want: want{
-				cr:  role(withConditions(xpv1.Creating())),
+				cr:  role(withPolicy(), withConditions(xpv1.Creating())),
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"NoAssumeRolePolicy": {
+			args: args{
+				iam: &fake.MockRoleClient{},
+				cr:  role(),
+			},
+			want: want{
+				cr:  role(withConditions(xpv1.Creating())),
+				err: errors.New(errNoAssumeRole),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -337,6 +382,79 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"AssumeRolePolicyUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{AssumeRolePolicyDocument: aws.String(url.QueryEscape(policy))},
+						}, nil
+					},
+					MockUpdateAssumeRolePolicy: func(ctx context.Context, input *awsiam.UpdateAssumeRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAssumeRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withPolicy()),
+			},
+			want: want{
+				cr: role(withPolicy()),
+			},
+		},
+		"InlinePolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{PolicyNames: []string{"stale"}}, nil
+					},
+					MockGetRolePolicy: func(ctx context.Context, input *awsiam.GetRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						return &awsiam.GetRolePolicyOutput{PolicyDocument: aws.String(policy)}, nil
+					},
+					MockPutRolePolicy: func(ctx context.Context, input *awsiam.PutRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.PutRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyName) != "p" {
+							return nil, errBoom
+						}
+						return &awsiam.PutRolePolicyOutput{}, nil
+					},
+					MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyName) != "stale" {
+							return nil, errBoom
+						}
+						return &awsiam.DeleteRolePolicyOutput{}, nil
+					},
+				},
+				cr: role(withInlinePolicy("p", policy)),
+			},
+			want: want{
+				cr: role(withInlinePolicy("p", policy)),
+			},
+		},
+		"ClientPutRolePolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{}, nil
+					},
+					MockPutRolePolicy: func(ctx context.Context, input *awsiam.PutRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.PutRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withInlinePolicy("p", policy)),
+			},
+			want: want{
+				cr:  role(withInlinePolicy("p", policy)),
+				err: awsclient.Wrap(errBoom, errPutInlinePolicy),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -405,6 +523,22 @@ func TestDelete(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDelete),
 			},
 		},
+		"InlinePolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+					MockDeleteRole: func(ctx context.Context, input *awsiam.DeleteRoleInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRoleOutput, error) {
+						return &awsiam.DeleteRoleOutput{}, nil
+					},
+				},
+				cr: role(withInlinePolicy("p", policy)),
+			},
+			want: want{
+				cr: role(withInlinePolicy("p", policy), withConditions(xpv1.Deleting())),
+			},
+		},
 		"ResourceDoesNotExist": {
 			args: args{
 				iam: &fake.MockRoleClient{
@@ -19,11 +19,13 @@ import (
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -35,15 +37,31 @@ import (
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errGetBootstrapBrokers       = "cannot get bootstrap brokers of the cluster"
+	errUpdateBrokerCount         = "cannot update broker count of the cluster"
+	errUpdateBrokerStorage       = "cannot update broker storage of the cluster"
+	errUpdateClusterKafkaVersion = "cannot update Kafka version of the cluster"
+	errUpdateClusterConfig       = "cannot update configuration of the cluster"
+	errUpdateMonitoring          = "cannot update monitoring of the cluster"
+
+	// allBrokers is the only broker ID accepted by UpdateBrokerStorage.
+	allBrokers = "All"
+)
+
 // SetupCluster adds a controller that reconciles Cluster.
 func SetupCluster(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(svcapitypes.ClusterGroupKind)
 	opts := []option{
 		func(e *external) {
+			h := &hooks{client: e.client}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = h.postObserve
+			e.isUpToDate = isUpToDate
+			e.update = h.update
 			e.preDelete = preDelete
 			e.postDelete = postDelete
+			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.lateInitialize = LateInitialize
 		},
@@ -56,13 +74,17 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimi
 		For(&svcapitypes.Cluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.ClusterGroupVersionKind),
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclients.NewDefaultTagger(mgr.GetClient())),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+type hooks struct {
+	client svcsdkapi.KafkaAPI
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DeleteClusterInput) (bool, error) {
 	obj.ClusterArn = awsclients.String(meta.GetExternalName(cr))
 	return false, nil
@@ -84,7 +106,7 @@ func preObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.Describe
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -109,9 +131,203 @@ func postObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.Describ
 		"clusterEndpointIAM":     []byte(strings.ReplaceAll(awsclients.StringValue(cr.Spec.ForProvider.ZookeeperConnectString), "2181", "9098")),
 	}
 
+	// Bootstrap brokers are available only after the cluster becomes active.
+	if awsclients.StringValue(obj.ClusterInfo.State) != string(svcapitypes.ClusterState_ACTIVE) {
+		return obs, nil
+	}
+	resp, err := h.client.GetBootstrapBrokersWithContext(ctx, &svcsdk.GetBootstrapBrokersInput{ClusterArn: awsclients.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errGetBootstrapBrokers)
+	}
+	for k, v := range map[string]*string{
+		"bootstrapBrokerString":          resp.BootstrapBrokerString,
+		"bootstrapBrokerStringTls":       resp.BootstrapBrokerStringTls,
+		"bootstrapBrokerStringSaslScram": resp.BootstrapBrokerStringSaslScram,
+	} {
+		if awsclients.StringValue(v) != "" {
+			obs.ConnectionDetails[k] = []byte(awsclients.StringValue(v))
+		}
+	}
 	return obs, nil
 }
 
+func isUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) (bool, error) {
+	// MSK accepts updates only for active clusters and every update moves
+	// the cluster to UPDATING state until it is completed.
+	if awsclients.StringValue(obj.ClusterInfo.State) != string(svcapitypes.ClusterState_ACTIVE) {
+		return true, nil
+	}
+	return isKafkaVersionUpToDate(cr, obj) &&
+		isConfigurationUpToDate(cr, obj) &&
+		isBrokerCountUpToDate(cr, obj) &&
+		isBrokerStorageUpToDate(cr, obj) &&
+		isMonitoringUpToDate(cr, obj), nil
+}
+
+func isKafkaVersionUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) bool {
+	if obj.ClusterInfo.CurrentBrokerSoftwareInfo == nil {
+		return true
+	}
+	return awsclients.StringValue(cr.Spec.ForProvider.KafkaVersion) == awsclients.StringValue(obj.ClusterInfo.CurrentBrokerSoftwareInfo.KafkaVersion)
+}
+
+func isConfigurationUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) bool {
+	if cr.Spec.ForProvider.ConfigurationInfo == nil {
+		return true
+	}
+	current := obj.ClusterInfo.CurrentBrokerSoftwareInfo
+	if current == nil {
+		return false
+	}
+	return awsclients.StringValue(cr.Spec.ForProvider.ConfigurationInfo.ARN) == awsclients.StringValue(current.ConfigurationArn) &&
+		awsclients.Int64Value(cr.Spec.ForProvider.ConfigurationInfo.Revision) == awsclients.Int64Value(current.ConfigurationRevision)
+}
+
+func isBrokerCountUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) bool {
+	return awsclients.Int64Value(cr.Spec.ForProvider.NumberOfBrokerNodes) == awsclients.Int64Value(obj.ClusterInfo.NumberOfBrokerNodes)
+}
+
+func isBrokerStorageUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) bool {
+	desired := getVolumeSize(cr.Spec.ForProvider.BrokerNodeGroupInfo)
+	if desired == nil {
+		return true
+	}
+	var current *int64
+	if b := obj.ClusterInfo.BrokerNodeGroupInfo; b != nil && b.StorageInfo != nil && b.StorageInfo.EbsStorageInfo != nil {
+		current = b.StorageInfo.EbsStorageInfo.VolumeSize
+	}
+	return awsclients.Int64Value(desired) == awsclients.Int64Value(current)
+}
+
+func isMonitoringUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) bool {
+	if cr.Spec.ForProvider.EnhancedMonitoring != nil &&
+		awsclients.StringValue(cr.Spec.ForProvider.EnhancedMonitoring) != awsclients.StringValue(obj.ClusterInfo.EnhancedMonitoring) {
+		return false
+	}
+	if cr.Spec.ForProvider.OpenMonitoring == nil {
+		return true
+	}
+	jmx, node := getExporters(cr.Spec.ForProvider.OpenMonitoring)
+	currentJmx, currentNode := false, false
+	if o := obj.ClusterInfo.OpenMonitoring; o != nil && o.Prometheus != nil {
+		if o.Prometheus.JmxExporter != nil {
+			currentJmx = awsclients.BoolValue(o.Prometheus.JmxExporter.EnabledInBroker)
+		}
+		if o.Prometheus.NodeExporter != nil {
+			currentNode = awsclients.BoolValue(o.Prometheus.NodeExporter.EnabledInBroker)
+		}
+	}
+	return jmx == currentJmx && node == currentNode
+}
+
+func getVolumeSize(b *svcapitypes.BrokerNodeGroupInfo) *int64 {
+	if b == nil || b.StorageInfo == nil || b.StorageInfo.EBSStorageInfo == nil {
+		return nil
+	}
+	return b.StorageInfo.EBSStorageInfo.VolumeSize
+}
+
+func getExporters(o *svcapitypes.OpenMonitoringInfo) (jmx bool, node bool) {
+	if o == nil || o.Prometheus == nil {
+		return false, false
+	}
+	if o.Prometheus.JmxExporter != nil {
+		jmx = awsclients.BoolValue(o.Prometheus.JmxExporter.EnabledInBroker)
+	}
+	if o.Prometheus.NodeExporter != nil {
+		node = awsclients.BoolValue(o.Prometheus.NodeExporter.EnabledInBroker)
+	}
+	return jmx, node
+}
+
+// update makes a single update call per reconciliation since MSK doesn't
+// accept another update while the cluster is in UPDATING state. The current
+// version of the cluster is passed to every call for optimistic concurrency.
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	obj, err := h.client.DescribeClusterWithContext(ctx, &svcsdk.DescribeClusterInput{ClusterArn: awsclients.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errDescribe)
+	}
+	arn, version := obj.ClusterInfo.ClusterArn, obj.ClusterInfo.CurrentVersion
+	p := cr.Spec.ForProvider
+
+	switch {
+	case !isKafkaVersionUpToDate(cr, obj):
+		input := &svcsdk.UpdateClusterKafkaVersionInput{
+			ClusterArn:         arn,
+			CurrentVersion:     version,
+			TargetKafkaVersion: p.KafkaVersion,
+		}
+		if !isConfigurationUpToDate(cr, obj) {
+			input.ConfigurationInfo = generateConfigurationInfo(p.ConfigurationInfo)
+		}
+		_, err = h.client.UpdateClusterKafkaVersionWithContext(ctx, input)
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateClusterKafkaVersion)
+	case !isConfigurationUpToDate(cr, obj):
+		_, err = h.client.UpdateClusterConfigurationWithContext(ctx, &svcsdk.UpdateClusterConfigurationInput{
+			ClusterArn:        arn,
+			CurrentVersion:    version,
+			ConfigurationInfo: generateConfigurationInfo(p.ConfigurationInfo),
+		})
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateClusterConfig)
+	case !isBrokerCountUpToDate(cr, obj):
+		_, err = h.client.UpdateBrokerCountWithContext(ctx, &svcsdk.UpdateBrokerCountInput{
+			ClusterArn:                arn,
+			CurrentVersion:            version,
+			TargetNumberOfBrokerNodes: p.NumberOfBrokerNodes,
+		})
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateBrokerCount)
+	case !isBrokerStorageUpToDate(cr, obj):
+		_, err = h.client.UpdateBrokerStorageWithContext(ctx, &svcsdk.UpdateBrokerStorageInput{
+			ClusterArn:     arn,
+			CurrentVersion: version,
+			TargetBrokerEBSVolumeInfo: []*svcsdk.BrokerEBSVolumeInfo{{
+				KafkaBrokerNodeId: awsclients.String(allBrokers),
+				VolumeSizeGB:      getVolumeSize(p.BrokerNodeGroupInfo),
+			}},
+		})
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateBrokerStorage)
+	case !isMonitoringUpToDate(cr, obj):
+		_, err = h.client.UpdateMonitoringWithContext(ctx, &svcsdk.UpdateMonitoringInput{
+			ClusterArn:         arn,
+			CurrentVersion:     version,
+			EnhancedMonitoring: p.EnhancedMonitoring,
+			OpenMonitoring:     generateOpenMonitoringInfo(p.OpenMonitoring),
+		})
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateMonitoring)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func generateConfigurationInfo(c *svcapitypes.CustomConfigurationInfo) *svcsdk.ConfigurationInfo {
+	if c == nil {
+		return nil
+	}
+	return &svcsdk.ConfigurationInfo{Arn: c.ARN, Revision: c.Revision}
+}
+
+func generateOpenMonitoringInfo(o *svcapitypes.OpenMonitoringInfo) *svcsdk.OpenMonitoringInfo {
+	if o == nil {
+		return nil
+	}
+	jmx, node := getExporters(o)
+	return &svcsdk.OpenMonitoringInfo{
+		Prometheus: &svcsdk.PrometheusInfo{
+			JmxExporter:  &svcsdk.JmxExporterInfo{EnabledInBroker: &jmx},
+			NodeExporter: &svcsdk.NodeExporterInfo{EnabledInBroker: &node},
+		},
+	}
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.CreateClusterInput) error {
+	obj.ConfigurationInfo = generateConfigurationInfo(cr.Spec.ForProvider.ConfigurationInfo)
+	return nil
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.CreateClusterOutput, _ managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+type clusterModifier func(*svcapitypes.Cluster)
+
+func cluster(m ...clusterModifier) *svcapitypes.Cluster {
+	cr := &svcapitypes.Cluster{
+		Spec: svcapitypes.ClusterSpec{
+			ForProvider: svcapitypes.ClusterParameters{
+				BrokerNodeGroupInfo: &svcapitypes.BrokerNodeGroupInfo{
+					StorageInfo: &svcapitypes.StorageInfo{
+						EBSStorageInfo: &svcapitypes.EBSStorageInfo{VolumeSize: awsclients.Int64(100)},
+					},
+				},
+				KafkaVersion:        awsclients.String("2.6.1"),
+				NumberOfBrokerNodes: awsclients.Int64(3),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type outputModifier func(*svcsdk.DescribeClusterOutput)
+
+func output(m ...outputModifier) *svcsdk.DescribeClusterOutput {
+	o := &svcsdk.DescribeClusterOutput{
+		ClusterInfo: &svcsdk.ClusterInfo{
+			BrokerNodeGroupInfo: &svcsdk.BrokerNodeGroupInfo{
+				StorageInfo: &svcsdk.StorageInfo{
+					EbsStorageInfo: &svcsdk.EBSStorageInfo{VolumeSize: awsclients.Int64(100)},
+				},
+			},
+			CurrentBrokerSoftwareInfo: &svcsdk.BrokerSoftwareInfo{
+				ConfigurationArn:      awsclients.String("arn"),
+				ConfigurationRevision: awsclients.Int64(1),
+				KafkaVersion:          awsclients.String("2.6.1"),
+			},
+			EnhancedMonitoring:  awsclients.String("DEFAULT"),
+			NumberOfBrokerNodes: awsclients.Int64(3),
+			State:               awsclients.String(string(svcapitypes.ClusterState_ACTIVE)),
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr  *svcapitypes.Cluster
+		obj *svcsdk.DescribeClusterOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				cr:  cluster(),
+				obj: output(),
+			},
+			want: true,
+		},
+		"NotActive": {
+			args: args{
+				cr: cluster(func(cr *svcapitypes.Cluster) {
+					cr.Spec.ForProvider.NumberOfBrokerNodes = awsclients.Int64(6)
+				}),
+				obj: output(func(o *svcsdk.DescribeClusterOutput) {
+					o.ClusterInfo.State = awsclients.String(string(svcapitypes.ClusterState_UPDATING))
+				}),
+			},
+			want: true,
+		},
+		"KafkaVersionChanged": {
+			args: args{
+				cr: cluster(func(cr *svcapitypes.Cluster) {
+					cr.Spec.ForProvider.KafkaVersion = awsclients.String("2.7.0")
+				}),
+				obj: output(),
+			},
+			want: false,
+		},
+		"ConfigurationRevisionChanged": {
+			args: args{
+				cr: cluster(func(cr *svcapitypes.Cluster) {
+					cr.Spec.ForProvider.ConfigurationInfo = &svcapitypes.CustomConfigurationInfo{
+						ARN:      awsclients.String("arn"),
+						Revision: awsclients.Int64(2),
+					}
+				}),
+				obj: output(),
+			},
+			want: false,
+		},
+		"BrokerCountChanged": {
+			args: args{
+				cr: cluster(func(cr *svcapitypes.Cluster) {
+					cr.Spec.ForProvider.NumberOfBrokerNodes = awsclients.Int64(6)
+				}),
+				obj: output(),
+			},
+			want: false,
+		},
+		"VolumeSizeChanged": {
+			args: args{
+				cr: cluster(func(cr *svcapitypes.Cluster) {
+					cr.Spec.ForProvider.BrokerNodeGroupInfo.StorageInfo.EBSStorageInfo.VolumeSize = awsclients.Int64(200)
+				}),
+				obj: output(),
+			},
+			want: false,
+		},
+		"EnhancedMonitoringChanged": {
+			args: args{
+				cr: cluster(func(cr *svcapitypes.Cluster) {
+					cr.Spec.ForProvider.EnhancedMonitoring = awsclients.String("PER_BROKER")
+				}),
+				obj: output(),
+			},
+			want: false,
+		},
+		"OpenMonitoringChanged": {
+			args: args{
+				cr: cluster(func(cr *svcapitypes.Cluster) {
+					enabled := true
+					cr.Spec.ForProvider.OpenMonitoring = &svcapitypes.OpenMonitoringInfo{
+						Prometheus: &svcapitypes.PrometheusInfo{
+							JmxExporter: &svcapitypes.JmxExporterInfo{EnabledInBroker: &enabled},
+						},
+					}
+				}),
+				obj: output(),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := isUpToDate(tc.args.cr, tc.args.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreCreate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.Cluster
+		want *svcsdk.ConfigurationInfo
+	}{
+		"NoConfiguration": {
+			cr: cluster(),
+		},
+		"Configuration": {
+			cr: cluster(func(cr *svcapitypes.Cluster) {
+				cr.Spec.ForProvider.ConfigurationInfo = &svcapitypes.CustomConfigurationInfo{
+					ARN:      awsclients.String("arn"),
+					Revision: awsclients.Int64(2),
+				}
+			}),
+			want: &svcsdk.ConfigurationInfo{Arn: awsclients.String("arn"), Revision: awsclients.Int64(2)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj := &svcsdk.CreateClusterInput{}
+			if err := preCreate(context.Background(), tc.cr, obj); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, obj.ConfigurationInfo); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -132,36 +132,26 @@ func GenerateCreateClusterInput(cr *svcapitypes.Cluster) *svcsdk.CreateClusterIn
 	if cr.Spec.ForProvider.ClusterName != nil {
 		res.SetClusterName(*cr.Spec.ForProvider.ClusterName)
 	}
-	if cr.Spec.ForProvider.ConfigurationInfo != nil {
-		f3 := &svcsdk.ConfigurationInfo{}
-		if cr.Spec.ForProvider.ConfigurationInfo.ARN != nil {
-			f3.SetArn(*cr.Spec.ForProvider.ConfigurationInfo.ARN)
-		}
-		if cr.Spec.ForProvider.ConfigurationInfo.Revision != nil {
-			f3.SetRevision(*cr.Spec.ForProvider.ConfigurationInfo.Revision)
-		}
-		res.SetConfigurationInfo(f3)
-	}
 	if cr.Spec.ForProvider.EncryptionInfo != nil {
-		f4 := &svcsdk.EncryptionInfo{}
+		f3 := &svcsdk.EncryptionInfo{}
 		if cr.Spec.ForProvider.EncryptionInfo.EncryptionAtRest != nil {
-			f4f0 := &svcsdk.EncryptionAtRest{}
+			f3f0 := &svcsdk.EncryptionAtRest{}
 			if cr.Spec.ForProvider.EncryptionInfo.EncryptionAtRest.DataVolumeKMSKeyID != nil {
-				f4f0.SetDataVolumeKMSKeyId(*cr.Spec.ForProvider.EncryptionInfo.EncryptionAtRest.DataVolumeKMSKeyID)
+				f3f0.SetDataVolumeKMSKeyId(*cr.Spec.ForProvider.EncryptionInfo.EncryptionAtRest.DataVolumeKMSKeyID)
 			}
-			f4.SetEncryptionAtRest(f4f0)
+			f3.SetEncryptionAtRest(f3f0)
 		}
 		if cr.Spec.ForProvider.EncryptionInfo.EncryptionInTransit != nil {
-			f4f1 := &svcsdk.EncryptionInTransit{}
+			f3f1 := &svcsdk.EncryptionInTransit{}
 			if cr.Spec.ForProvider.EncryptionInfo.EncryptionInTransit.ClientBroker != nil {
-				f4f1.SetClientBroker(*cr.Spec.ForProvider.EncryptionInfo.EncryptionInTransit.ClientBroker)
+				f3f1.SetClientBroker(*cr.Spec.ForProvider.EncryptionInfo.EncryptionInTransit.ClientBroker)
 			}
 			if cr.Spec.ForProvider.EncryptionInfo.EncryptionInTransit.InCluster != nil {
-				f4f1.SetInCluster(*cr.Spec.ForProvider.EncryptionInfo.EncryptionInTransit.InCluster)
+				f3f1.SetInCluster(*cr.Spec.ForProvider.EncryptionInfo.EncryptionInTransit.InCluster)
 			}
-			f4.SetEncryptionInTransit(f4f1)
+			f3.SetEncryptionInTransit(f3f1)
 		}
-		res.SetEncryptionInfo(f4)
+		res.SetEncryptionInfo(f3)
 	}
 	if cr.Spec.ForProvider.EnhancedMonitoring != nil {
 		res.SetEnhancedMonitoring(*cr.Spec.ForProvider.EnhancedMonitoring)
@@ -170,79 +160,79 @@ func GenerateCreateClusterInput(cr *svcapitypes.Cluster) *svcsdk.CreateClusterIn
 		res.SetKafkaVersion(*cr.Spec.ForProvider.KafkaVersion)
 	}
 	if cr.Spec.ForProvider.LoggingInfo != nil {
-		f7 := &svcsdk.LoggingInfo{}
+		f6 := &svcsdk.LoggingInfo{}
 		if cr.Spec.ForProvider.LoggingInfo.BrokerLogs != nil {
-			f7f0 := &svcsdk.BrokerLogs{}
+			f6f0 := &svcsdk.BrokerLogs{}
 			if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.CloudWatchLogs != nil {
-				f7f0f0 := &svcsdk.CloudWatchLogs{}
+				f6f0f0 := &svcsdk.CloudWatchLogs{}
 				if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.CloudWatchLogs.Enabled != nil {
-					f7f0f0.SetEnabled(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.CloudWatchLogs.Enabled)
+					f6f0f0.SetEnabled(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.CloudWatchLogs.Enabled)
 				}
 				if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.CloudWatchLogs.LogGroup != nil {
-					f7f0f0.SetLogGroup(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.CloudWatchLogs.LogGroup)
+					f6f0f0.SetLogGroup(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.CloudWatchLogs.LogGroup)
 				}
-				f7f0.SetCloudWatchLogs(f7f0f0)
+				f6f0.SetCloudWatchLogs(f6f0f0)
 			}
 			if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.Firehose != nil {
-				f7f0f1 := &svcsdk.Firehose{}
+				f6f0f1 := &svcsdk.Firehose{}
 				if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.Firehose.DeliveryStream != nil {
-					f7f0f1.SetDeliveryStream(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.Firehose.DeliveryStream)
+					f6f0f1.SetDeliveryStream(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.Firehose.DeliveryStream)
 				}
 				if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.Firehose.Enabled != nil {
-					f7f0f1.SetEnabled(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.Firehose.Enabled)
+					f6f0f1.SetEnabled(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.Firehose.Enabled)
 				}
-				f7f0.SetFirehose(f7f0f1)
+				f6f0.SetFirehose(f6f0f1)
 			}
 			if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3 != nil {
-				f7f0f2 := &svcsdk.S3{}
+				f6f0f2 := &svcsdk.S3{}
 				if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Bucket != nil {
-					f7f0f2.SetBucket(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Bucket)
+					f6f0f2.SetBucket(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Bucket)
 				}
 				if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Enabled != nil {
-					f7f0f2.SetEnabled(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Enabled)
+					f6f0f2.SetEnabled(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Enabled)
 				}
 				if cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Prefix != nil {
-					f7f0f2.SetPrefix(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Prefix)
+					f6f0f2.SetPrefix(*cr.Spec.ForProvider.LoggingInfo.BrokerLogs.S3.Prefix)
 				}
-				f7f0.SetS3(f7f0f2)
+				f6f0.SetS3(f6f0f2)
 			}
-			f7.SetBrokerLogs(f7f0)
+			f6.SetBrokerLogs(f6f0)
 		}
-		res.SetLoggingInfo(f7)
+		res.SetLoggingInfo(f6)
 	}
 	if cr.Spec.ForProvider.NumberOfBrokerNodes != nil {
 		res.SetNumberOfBrokerNodes(*cr.Spec.ForProvider.NumberOfBrokerNodes)
 	}
 	if cr.Spec.ForProvider.OpenMonitoring != nil {
-		f9 := &svcsdk.OpenMonitoringInfo{}
+		f8 := &svcsdk.OpenMonitoringInfo{}
 		if cr.Spec.ForProvider.OpenMonitoring.Prometheus != nil {
-			f9f0 := &svcsdk.PrometheusInfo{}
+			f8f0 := &svcsdk.PrometheusInfo{}
 			if cr.Spec.ForProvider.OpenMonitoring.Prometheus.JmxExporter != nil {
-				f9f0f0 := &svcsdk.JmxExporterInfo{}
+				f8f0f0 := &svcsdk.JmxExporterInfo{}
 				if cr.Spec.ForProvider.OpenMonitoring.Prometheus.JmxExporter.EnabledInBroker != nil {
-					f9f0f0.SetEnabledInBroker(*cr.Spec.ForProvider.OpenMonitoring.Prometheus.JmxExporter.EnabledInBroker)
+					f8f0f0.SetEnabledInBroker(*cr.Spec.ForProvider.OpenMonitoring.Prometheus.JmxExporter.EnabledInBroker)
 				}
-				f9f0.SetJmxExporter(f9f0f0)
+				f8f0.SetJmxExporter(f8f0f0)
 			}
 			if cr.Spec.ForProvider.OpenMonitoring.Prometheus.NodeExporter != nil {
-				f9f0f1 := &svcsdk.NodeExporterInfo{}
+				f8f0f1 := &svcsdk.NodeExporterInfo{}
 				if cr.Spec.ForProvider.OpenMonitoring.Prometheus.NodeExporter.EnabledInBroker != nil {
-					f9f0f1.SetEnabledInBroker(*cr.Spec.ForProvider.OpenMonitoring.Prometheus.NodeExporter.EnabledInBroker)
+					f8f0f1.SetEnabledInBroker(*cr.Spec.ForProvider.OpenMonitoring.Prometheus.NodeExporter.EnabledInBroker)
 				}
-				f9f0.SetNodeExporter(f9f0f1)
+				f8f0.SetNodeExporter(f8f0f1)
 			}
-			f9.SetPrometheus(f9f0)
+			f8.SetPrometheus(f8f0)
 		}
-		res.SetOpenMonitoring(f9)
+		res.SetOpenMonitoring(f8)
 	}
 	if cr.Spec.ForProvider.Tags != nil {
-		f10 := map[string]*string{}
-		for f10key, f10valiter := range cr.Spec.ForProvider.Tags {
-			var f10val string
-			f10val = *f10valiter
-			f10[f10key] = &f10val
+		f9 := map[string]*string{}
+		for f9key, f9valiter := range cr.Spec.ForProvider.Tags {
+			var f9val string
+			f9val = *f9valiter
+			f9[f9key] = &f9val
 		}
-		res.SetTags(f10)
+		res.SetTags(f9)
 	}
 
 	return res
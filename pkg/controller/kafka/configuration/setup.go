@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errDescribeRevision = "cannot describe latest revision of the configuration"
+)
+
+// SetupConfiguration adds a controller that reconciles Configuration.
+func SetupConfiguration(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(svcapitypes.ConfigurationGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = h.postObserve
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(limiter),
+		}).
+		For(&svcapitypes.Configuration{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ConfigurationGroupVersionKind),
+			managed.WithInitializers(),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.KafkaAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Configuration, obj *svcsdk.DescribeConfigurationInput) error {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.Configuration, obj *svcsdk.DescribeConfigurationOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(obj.State) {
+	case string(svcapitypes.ConfigurationState_ACTIVE):
+		cr.SetConditions(xpv1.Available())
+	case string(svcapitypes.ConfigurationState_DELETING):
+		cr.SetConditions(xpv1.Deleting())
+	case string(svcapitypes.ConfigurationState_DELETE_FAILED):
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	if obj.LatestRevision == nil {
+		return obs, nil
+	}
+	// Server properties are not returned with the configuration, so they're
+	// fetched from its latest revision.
+	rev, err := h.client.DescribeConfigurationRevisionWithContext(ctx, &svcsdk.DescribeConfigurationRevisionInput{
+		Arn:      awsclients.String(meta.GetExternalName(cr)),
+		Revision: obj.LatestRevision.Revision,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errDescribeRevision)
+	}
+	obs.ResourceUpToDate = isUpToDate(cr, rev)
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Configuration, rev *svcsdk.DescribeConfigurationRevisionOutput) bool {
+	if cr.Spec.ForProvider.Description != nil && awsclients.StringValue(cr.Spec.ForProvider.Description) != awsclients.StringValue(rev.Description) {
+		return false
+	}
+	return strings.TrimSpace(cr.Spec.ForProvider.ServerProperties) == strings.TrimSpace(string(rev.ServerProperties))
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Configuration, obj *svcsdk.CreateConfigurationInput) error {
+	obj.ServerProperties = []byte(cr.Spec.ForProvider.ServerProperties)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Configuration, obj *svcsdk.CreateConfigurationOutput, _ managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.Arn))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// preUpdate creates a new revision of the configuration with the desired
+// server properties.
+func preUpdate(_ context.Context, cr *svcapitypes.Configuration, obj *svcsdk.UpdateConfigurationInput) error {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	obj.ServerProperties = []byte(cr.Spec.ForProvider.ServerProperties)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Configuration, obj *svcsdk.DeleteConfigurationInput) (bool, error) {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p   svcapitypes.ConfigurationParameters
+		rev *svcsdk.DescribeConfigurationRevisionOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.ConfigurationParameters{
+					Description: awsclients.String("desc"),
+					CustomConfigurationParameters: svcapitypes.CustomConfigurationParameters{
+						ServerProperties: "auto.create.topics.enable = true\n",
+					},
+				},
+				rev: &svcsdk.DescribeConfigurationRevisionOutput{
+					Description:      awsclients.String("desc"),
+					ServerProperties: []byte("auto.create.topics.enable = true"),
+				},
+			},
+			want: true,
+		},
+		"NoDescription": {
+			args: args{
+				p: svcapitypes.ConfigurationParameters{
+					CustomConfigurationParameters: svcapitypes.CustomConfigurationParameters{
+						ServerProperties: "auto.create.topics.enable = true",
+					},
+				},
+				rev: &svcsdk.DescribeConfigurationRevisionOutput{
+					Description:      awsclients.String("desc"),
+					ServerProperties: []byte("auto.create.topics.enable = true"),
+				},
+			},
+			want: true,
+		},
+		"DescriptionChanged": {
+			args: args{
+				p: svcapitypes.ConfigurationParameters{
+					Description: awsclients.String("new"),
+					CustomConfigurationParameters: svcapitypes.CustomConfigurationParameters{
+						ServerProperties: "auto.create.topics.enable = true",
+					},
+				},
+				rev: &svcsdk.DescribeConfigurationRevisionOutput{
+					Description:      awsclients.String("desc"),
+					ServerProperties: []byte("auto.create.topics.enable = true"),
+				},
+			},
+			want: false,
+		},
+		"ServerPropertiesChanged": {
+			args: args{
+				p: svcapitypes.ConfigurationParameters{
+					CustomConfigurationParameters: svcapitypes.CustomConfigurationParameters{
+						ServerProperties: "auto.create.topics.enable = false",
+					},
+				},
+				rev: &svcsdk.DescribeConfigurationRevisionOutput{
+					ServerProperties: []byte("auto.create.topics.enable = true"),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Configuration{Spec: svcapitypes.ConfigurationSpec{ForProvider: tc.args.p}}
+			got := isUpToDate(cr, tc.args.rev)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
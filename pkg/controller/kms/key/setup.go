@@ -19,8 +19,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	svcapitypes "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 // SetupKey adds a controller that reconciles Key.
@@ -29,6 +31,7 @@ func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
+			e.preCreate = preCreate
 			e.postObserve = postObserve
 			e.postCreate = postCreate
 			u := &updater{client: e.client}
@@ -49,11 +52,35 @@ func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.KeyGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewDefaultTagger(mgr.GetClient())),
+			managed.WithReferenceResolver(awspolicy.NewReferenceResolver(mgr.GetClient(), policyDocuments)),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+func policyDocuments(mg resource.Managed) []*commonv1alpha1.PolicyDocument {
+	cr, ok := mg.(*svcapitypes.Key)
+	if !ok {
+		return nil
+	}
+	return []*commonv1alpha1.PolicyDocument{cr.Spec.ForProvider.PolicyDocument}
+}
+
+// keyPolicy returns the key policy, which is generated from the structured
+// policy document if it is given.
+func keyPolicy(p svcapitypes.KeyParameters) *string {
+	if p.PolicyDocument != nil {
+		return awsclients.String(awspolicy.Marshal(p.PolicyDocument))
+	}
+	return p.Policy
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.CreateKeyInput) error {
+	obj.Policy = keyPolicy(cr.Spec.ForProvider)
+	return nil
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKeyInput) error {
 	obj.KeyId = awsclients.String(meta.GetExternalName(cr))
 	return nil
@@ -113,7 +140,7 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 	if _, err := u.client.PutKeyPolicyWithContext(ctx, &svcsdk.PutKeyPolicyInput{
 		KeyId:      awsclients.String(meta.GetExternalName(cr)),
 		PolicyName: awsclients.String("default"),
-		Policy:     cr.Spec.ForProvider.Policy,
+		Policy:     keyPolicy(cr.Spec.ForProvider),
 	}); err != nil {
 		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
 	}
@@ -220,7 +247,7 @@ type observer struct {
 
 func (o *observer) lateInitialize(in *svcapitypes.KeyParameters, obj *svcsdk.DescribeKeyOutput) error {
 	// Policy
-	if in.Policy == nil {
+	if in.Policy == nil && in.PolicyDocument == nil {
 		resPolicy, err := o.client.GetKeyPolicy(&svcsdk.GetKeyPolicyInput{
 			KeyId:      obj.KeyMetadata.KeyId,
 			PolicyName: awsclients.String("default"),
@@ -278,7 +305,8 @@ func (o *observer) isUpToDate(cr *svcapitypes.Key, obj *svcsdk.DescribeKeyOutput
 	if err != nil {
 		return false, awsclients.Wrap(err, "cannot get key policy")
 	}
-	if awsclients.StringValue(cr.Spec.ForProvider.Policy) != awsclients.StringValue(resPolicy.Policy) {
+	desired, current := awsclients.StringValue(keyPolicy(cr.Spec.ForProvider)), awsclients.StringValue(resPolicy.Policy)
+	if desired != current && !awspolicy.AreEquivalent(desired, current) {
 		return false, nil
 	}
 
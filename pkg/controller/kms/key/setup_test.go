@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	"github.com/google/go-cmp/cmp"
+
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
+	svcapitypes "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	rawPolicy      = `{"Version":"2012-10-17","Statement":[]}`
+	policyDocument = &commonv1alpha1.PolicyDocument{
+		Version: "2012-10-17",
+		Statements: []commonv1alpha1.PolicyStatement{{
+			Effect: "Allow",
+			Principal: &commonv1alpha1.PolicyPrincipal{AWSPrincipals: []commonv1alpha1.AWSPrincipal{
+				{AWSAccountID: awsclients.String("123456789012")},
+			}},
+			Action:   []string{"kms:*"},
+			Resource: []string{"*"},
+		}},
+	}
+	policyJSON = `{"Statement":[{"Action":"kms:*","Effect":"Allow","Principal":{"AWS":"123456789012"},"Resource":"*"}],"Version":"2012-10-17"}`
+)
+
+func TestPreCreate(t *testing.T) {
+	cases := map[string]struct {
+		params svcapitypes.KeyParameters
+		want   *string
+	}{
+		"NoPolicy": {},
+		"RawPolicy": {
+			params: svcapitypes.KeyParameters{Policy: &rawPolicy},
+			want:   &rawPolicy,
+		},
+		"PolicyDocument": {
+			params: svcapitypes.KeyParameters{
+				Policy:              &rawPolicy,
+				CustomKeyParameters: svcapitypes.CustomKeyParameters{PolicyDocument: policyDocument},
+			},
+			want: &policyJSON,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Key{}
+			cr.Spec.ForProvider = tc.params
+			obj := &svcsdk.CreateKeyInput{}
+			if err := preCreate(context.Background(), cr, obj); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, obj.Policy); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyDocuments(t *testing.T) {
+	cr := &svcapitypes.Key{}
+	cr.Spec.ForProvider.PolicyDocument = policyDocument
+	want := []*commonv1alpha1.PolicyDocument{policyDocument}
+	if diff := cmp.Diff(want, policyDocuments(cr)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -46,7 +46,7 @@ func SetupBroker(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 		For(&svcapitypes.Broker{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.BrokerGroupVersionKind),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), awsclients.NewDefaultTagger(mgr.GetClient())),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -86,9 +86,14 @@ func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.Broker, obj *s
 		return obs, errors.Wrap(err, "cannot get password from the given secret")
 	}
 
+	region, err := awsclients.GetRegion(ctx, e.kube, cr, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return obs, err
+	}
+
 	obs.ConnectionDetails = managed.ConnectionDetails{
 		"BrokerID": []byte(awsclients.StringValue(cr.Status.AtProvider.BrokerID)),
-		"Region":   []byte(awsclients.StringValue(&cr.Spec.ForProvider.Region)),
+		"Region":   []byte(region),
 		"Username": []byte(awsclients.StringValue(cr.Spec.ForProvider.CustomUsers[0].Username)),
 		"Password": []byte(pw),
 	}
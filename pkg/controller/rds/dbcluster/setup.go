@@ -2,6 +2,8 @@ package dbcluster
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
@@ -25,14 +27,21 @@ import (
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
 )
 
+const (
+	errGetPassword = "cannot get password from the given secret"
+)
+
 // SetupDBCluster adds a controller that reconciles DbCluster.
 func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(svcapitypes.DBClusterGroupKind)
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
-			e.postObserve = postObserve
 			c := &custom{client: e.client, kube: e.kube}
+			e.postObserve = c.postObserve
+			e.isUpToDate = isUpToDate
+			e.preUpdate = c.preUpdate
+			e.postUpdate = c.postUpdate
 			e.preCreate = c.preCreate
 			e.postCreate = c.postCreate
 			e.preDelete = preDelete
@@ -48,6 +57,7 @@ func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), aws.NewDefaultTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -62,7 +72,7 @@ func preObserve(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.Descri
 // described here https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Aurora.Status.html
 // Need to get help from community on how to deal with this. Ideally the status should reflect
 // the true status value as described by the provider.
-func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -74,18 +84,191 @@ func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.Desc
 	case "creating":
 		cr.SetConditions(xpv1.Creating())
 	}
+	e.engineVersion = resp.DBClusters[0].EngineVersion
+	obs.ConnectionDetails = getConnectionDetails(cr, resp.DBClusters[0])
+	pw, changed, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
+	switch {
+	// The new password is published once it is set in Update.
+	case changed && aws.StringValue(resp.DBClusters[0].Status) == "available":
+		obs.ResourceUpToDate = false
+	case !changed && pw != "":
+		obs.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	}
 	return obs, nil
 }
 
+func getConnectionDetails(cr *svcapitypes.DBCluster, cluster *svcsdk.DBCluster) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(cluster.Endpoint)),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
+	}
+	if cluster.Port != nil {
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.FormatInt(aws.Int64Value(cluster.Port), 10))
+	}
+	return conn
+}
+
+// isUpToDate checks the modifiable fields of the DB cluster that are given in
+// the spec. A DB cluster that is not available cannot be modified, so it is
+// considered up to date until it becomes available.
+func isUpToDate(cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput) (bool, error) { // nolint:gocyclo
+	cluster := resp.DBClusters[0]
+	if aws.StringValue(cluster.Status) != "available" {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	switch {
+	case !isEngineVersionUpToDate(p.EngineVersion, cluster.EngineVersion),
+		p.BackupRetentionPeriod != nil && aws.Int64Value(p.BackupRetentionPeriod) != aws.Int64Value(cluster.BackupRetentionPeriod),
+		p.PreferredBackupWindow != nil && aws.StringValue(p.PreferredBackupWindow) != aws.StringValue(cluster.PreferredBackupWindow),
+		p.PreferredMaintenanceWindow != nil && !strings.EqualFold(aws.StringValue(p.PreferredMaintenanceWindow), aws.StringValue(cluster.PreferredMaintenanceWindow)),
+		p.DBClusterParameterGroupName != nil && aws.StringValue(p.DBClusterParameterGroupName) != aws.StringValue(cluster.DBClusterParameterGroup),
+		p.DeletionProtection != nil && aws.BoolValue(p.DeletionProtection) != aws.BoolValue(cluster.DeletionProtection),
+		p.EnableIAMDatabaseAuthentication != nil && aws.BoolValue(p.EnableIAMDatabaseAuthentication) != aws.BoolValue(cluster.IAMDatabaseAuthenticationEnabled),
+		p.Port != nil && aws.Int64Value(p.Port) != aws.Int64Value(cluster.Port),
+		p.BacktrackWindow != nil && aws.Int64Value(p.BacktrackWindow) != aws.Int64Value(cluster.BacktrackWindow),
+		p.CopyTagsToSnapshot != nil && aws.BoolValue(p.CopyTagsToSnapshot) != aws.BoolValue(cluster.CopyTagsToSnapshot),
+		p.EnableHTTPEndpoint != nil && aws.BoolValue(p.EnableHTTPEndpoint) != aws.BoolValue(cluster.HttpEndpointEnabled),
+		!isOptionGroupUpToDate(p.OptionGroupName, cluster.DBClusterOptionGroupMemberships),
+		!isDomainUpToDate(p.Domain, cluster.DomainMemberships),
+		!areSecurityGroupsUpToDate(p.VPCSecurityGroupIDs, cluster.VpcSecurityGroups),
+		!isScalingConfigurationUpToDate(p.ScalingConfiguration, cluster.ScalingConfigurationInfo):
+		return false, nil
+	}
+	return true, nil
+}
+
+// isEngineVersionUpToDate returns true if the observed engine version is the
+// desired one or a more specific version of it, e.g. 5.7.mysql_aurora.2.07.2
+// when 5.7 is desired.
+func isEngineVersionUpToDate(spec, obs *string) bool {
+	if spec == nil {
+		return true
+	}
+	return aws.StringValue(spec) == aws.StringValue(obs) || strings.HasPrefix(aws.StringValue(obs), aws.StringValue(spec)+".")
+}
+
+func isOptionGroupUpToDate(spec *string, obs []*svcsdk.DBClusterOptionGroupStatus) bool {
+	if spec == nil {
+		return true
+	}
+	for _, og := range obs {
+		if aws.StringValue(og.DBClusterOptionGroupName) == aws.StringValue(spec) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDomainUpToDate(spec *string, obs []*svcsdk.DomainMembership) bool {
+	if spec == nil {
+		return true
+	}
+	for _, d := range obs {
+		if aws.StringValue(d.Domain) == aws.StringValue(spec) {
+			return true
+		}
+	}
+	return false
+}
+
+func areSecurityGroupsUpToDate(spec []string, obs []*svcsdk.VpcSecurityGroupMembership) bool {
+	if len(spec) == 0 {
+		return true
+	}
+	if len(spec) != len(obs) {
+		return false
+	}
+	existing := map[string]bool{}
+	for _, sg := range obs {
+		existing[aws.StringValue(sg.VpcSecurityGroupId)] = true
+	}
+	for _, id := range spec {
+		if !existing[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func isScalingConfigurationUpToDate(spec *svcapitypes.ScalingConfiguration, obs *svcsdk.ScalingConfigurationInfo) bool {
+	if spec == nil {
+		return true
+	}
+	if obs == nil {
+		return false
+	}
+	switch {
+	case spec.AutoPause != nil && aws.BoolValue(spec.AutoPause) != aws.BoolValue(obs.AutoPause),
+		spec.MaxCapacity != nil && aws.Int64Value(spec.MaxCapacity) != aws.Int64Value(obs.MaxCapacity),
+		spec.MinCapacity != nil && aws.Int64Value(spec.MinCapacity) != aws.Int64Value(obs.MinCapacity),
+		spec.SecondsUntilAutoPause != nil && aws.Int64Value(spec.SecondsUntilAutoPause) != aws.Int64Value(obs.SecondsUntilAutoPause),
+		spec.TimeoutAction != nil && aws.StringValue(spec.TimeoutAction) != aws.StringValue(obs.TimeoutAction):
+		return false
+	}
+	return true
+}
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
+
+	// engineVersion is the engine version of the DB cluster that is
+	// observed before it is updated.
+	engineVersion *string
+}
+
+func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.ModifyDBClusterInput) error {
+	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
+	obj.ApplyImmediately = cr.Spec.ForProvider.ApplyImmediately
+	obj.AllowMajorVersionUpgrade = cr.Spec.ForProvider.AllowMajorVersionUpgrade
+	if len(cr.Spec.ForProvider.VPCSecurityGroupIDs) != 0 {
+		obj.VpcSecurityGroupIds = make([]*string, len(cr.Spec.ForProvider.VPCSecurityGroupIDs))
+		for i, v := range cr.Spec.ForProvider.VPCSecurityGroupIDs {
+			obj.VpcSecurityGroupIds[i] = aws.String(v)
+		}
+	}
+	// The engine version is sent only when it needs to be changed since the
+	// desired version may be a less specific form of the current one, which
+	// the API would consider a downgrade.
+	if isEngineVersionUpToDate(obj.EngineVersion, e.engineVersion) {
+		obj.EngineVersion = nil
+	}
+	pw, changed, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return errors.Wrap(err, errGetPassword)
+	}
+	if changed {
+		obj.MasterUserPassword = aws.String(pw)
+	}
+	return nil
+}
+
+func (e *custom) postUpdate(ctx context.Context, cr *svcapitypes.DBCluster, _ *svcsdk.ModifyDBClusterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The connection secret still has the old password at this point, so
+	// the password is reported as changed if it has just been set.
+	pw, changed, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+	if changed {
+		upd.ConnectionDetails = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}
+	}
+	return upd, nil
 }
 
 func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.CreateDBClusterInput) error {
 	pw, _, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
-		return errors.Wrap(err, "cannot get password from the given secret")
+		return errors.Wrap(err, errGetPassword)
 	}
 	obj.MasterUserPassword = aws.String(pw)
 	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
@@ -106,7 +289,7 @@ func (e *custom) postCreate(ctx context.Context, cr *svcapitypes.DBCluster, _ *s
 	}
 	pw, _, err := rds.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "cannot get password from the given secret")
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
 	}
 	if pw != "" {
 		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
@@ -0,0 +1,475 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	passwordSecretName   = "password"
+	connectionSecretName = "connection"
+	passwordKey          = "pw"
+)
+
+var errBoom = errors.New("boom")
+
+type clusterModifier func(*svcapitypes.DBCluster)
+
+func withSpec(p svcapitypes.DBClusterParameters) clusterModifier {
+	return func(cr *svcapitypes.DBCluster) { cr.Spec.ForProvider = p }
+}
+
+// withPasswordSecrets references the password secret and, if connection is
+// true, the connection secret of the DB cluster.
+func withPasswordSecrets(connection bool) clusterModifier {
+	return func(cr *svcapitypes.DBCluster) {
+		meta.SetExternalName(cr, "cluster")
+		cr.Spec.ForProvider.MasterUserPasswordSecretRef = xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: passwordSecretName},
+			Key:             passwordKey,
+		}
+		if connection {
+			cr.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: connectionSecretName}
+		}
+	}
+}
+
+// secrets returns a client that serves the given password from the password
+// secret and the given current password from the connection secret.
+func secrets(password, current string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			switch key.Name {
+			case passwordSecretName:
+				s.Data = map[string][]byte{passwordKey: []byte(password)}
+			case connectionSecretName:
+				s.Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(current)}
+			}
+			return nil
+		},
+	}
+}
+
+func cluster(m ...clusterModifier) *svcapitypes.DBCluster {
+	cr := &svcapitypes.DBCluster{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeOutput(c svcsdk.DBCluster) *svcsdk.DescribeDBClustersOutput {
+	if c.Status == nil {
+		c.Status = aws.String("available")
+	}
+	return &svcsdk.DescribeDBClustersOutput{DBClusters: []*svcsdk.DBCluster{&c}}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.DBCluster
+		resp *svcsdk.DescribeDBClustersOutput
+	}
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					EngineVersion:              aws.String("5.7"),
+					PreferredMaintenanceWindow: aws.String("Sun:05:00-Sun:06:00"),
+					DeletionProtection:         aws.Bool(true),
+					CustomDBClusterParameters: svcapitypes.CustomDBClusterParameters{
+						VPCSecurityGroupIDs: []string{"sg-2", "sg-1"},
+					},
+				})),
+				resp: describeOutput(svcsdk.DBCluster{
+					EngineVersion:              aws.String("5.7.mysql_aurora.2.07.2"),
+					PreferredMaintenanceWindow: aws.String("sun:05:00-sun:06:00"),
+					DeletionProtection:         aws.Bool(true),
+					VpcSecurityGroups: []*svcsdk.VpcSecurityGroupMembership{
+						{VpcSecurityGroupId: aws.String("sg-1")},
+						{VpcSecurityGroupId: aws.String("sg-2")},
+					},
+				}),
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
+		"EngineVersionChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					EngineVersion: aws.String("5.7.mysql_aurora.2.10.0"),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{
+					EngineVersion: aws.String("5.7.mysql_aurora.2.07.2"),
+				}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"SecurityGroupsChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					CustomDBClusterParameters: svcapitypes.CustomDBClusterParameters{
+						VPCSecurityGroupIDs: []string{"sg-1"},
+					},
+				})),
+				resp: describeOutput(svcsdk.DBCluster{
+					VpcSecurityGroups: []*svcsdk.VpcSecurityGroupMembership{
+						{VpcSecurityGroupId: aws.String("sg-2")},
+					},
+				}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"ScalingConfigurationChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					ScalingConfiguration: &svcapitypes.ScalingConfiguration{MaxCapacity: aws.Int64(16)},
+				})),
+				resp: describeOutput(svcsdk.DBCluster{
+					ScalingConfigurationInfo: &svcsdk.ScalingConfigurationInfo{MaxCapacity: aws.Int64(8), MinCapacity: aws.Int64(2)},
+				}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"IAMAuthenticationChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					EnableIAMDatabaseAuthentication: aws.Bool(true),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"PortChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					Port: aws.Int64(3307),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{Port: aws.Int64(3306)}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"BacktrackWindowChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					BacktrackWindow: aws.Int64(3600),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{BacktrackWindow: aws.Int64(0)}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"CopyTagsToSnapshotChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					CopyTagsToSnapshot: aws.Bool(true),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{CopyTagsToSnapshot: aws.Bool(false)}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"HTTPEndpointChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					EnableHTTPEndpoint: aws.Bool(true),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{HttpEndpointEnabled: aws.Bool(false)}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"OptionGroupChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					OptionGroupName: aws.String("new"),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{
+					DBClusterOptionGroupMemberships: []*svcsdk.DBClusterOptionGroupStatus{
+						{DBClusterOptionGroupName: aws.String("old"), Status: aws.String("in-sync")},
+					},
+				}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"DomainChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					Domain: aws.String("d-2"),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{
+					DomainMemberships: []*svcsdk.DomainMembership{{Domain: aws.String("d-1")}},
+				}),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
+		"OptionGroupAndDomainUpToDate": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					OptionGroupName: aws.String("og"),
+					Domain:          aws.String("d-1"),
+					Port:            aws.Int64(3306),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{
+					Port: aws.Int64(3306),
+					DBClusterOptionGroupMemberships: []*svcsdk.DBClusterOptionGroupStatus{
+						{DBClusterOptionGroupName: aws.String("og"), Status: aws.String("in-sync")},
+					},
+					DomainMemberships: []*svcsdk.DomainMembership{{Domain: aws.String("d-1")}},
+				}),
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.DBClusterParameters{
+					DeletionProtection: aws.Bool(true),
+				})),
+				resp: describeOutput(svcsdk.DBCluster{Status: aws.String("modifying")}),
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, err := isUpToDate(tc.args.cr, tc.args.resp)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	type args struct {
+		kube          client.Client
+		engineVersion *string
+		cr            *svcapitypes.DBCluster
+		obj           *svcsdk.ModifyDBClusterInput
+	}
+	type want struct {
+		obj *svcsdk.ModifyDBClusterInput
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PasswordChanged": {
+			args: args{
+				kube: secrets("new", "old"),
+				cr:   cluster(withPasswordSecrets(true)),
+				obj:  &svcsdk.ModifyDBClusterInput{},
+			},
+			want: want{
+				obj: &svcsdk.ModifyDBClusterInput{
+					DBClusterIdentifier: aws.String("cluster"),
+					MasterUserPassword:  aws.String("new"),
+				},
+			},
+		},
+		"PasswordUnchanged": {
+			args: args{
+				kube: secrets("same", "same"),
+				cr:   cluster(withPasswordSecrets(true)),
+				obj:  &svcsdk.ModifyDBClusterInput{},
+			},
+			want: want{
+				obj: &svcsdk.ModifyDBClusterInput{
+					DBClusterIdentifier: aws.String("cluster"),
+				},
+			},
+		},
+		"NoConnectionSecret": {
+			args: args{
+				kube: secrets("new", "old"),
+				cr:   cluster(withPasswordSecrets(false)),
+				obj:  &svcsdk.ModifyDBClusterInput{},
+			},
+			want: want{
+				obj: &svcsdk.ModifyDBClusterInput{
+					DBClusterIdentifier: aws.String("cluster"),
+				},
+			},
+		},
+		"EngineVersionUpToDate": {
+			args: args{
+				kube:          secrets("same", "same"),
+				engineVersion: aws.String("5.7.mysql_aurora.2.07.2"),
+				cr:            cluster(withPasswordSecrets(true)),
+				obj:           &svcsdk.ModifyDBClusterInput{EngineVersion: aws.String("5.7")},
+			},
+			want: want{
+				obj: &svcsdk.ModifyDBClusterInput{
+					DBClusterIdentifier: aws.String("cluster"),
+				},
+			},
+		},
+		"EngineVersionChanged": {
+			args: args{
+				kube:          secrets("same", "same"),
+				engineVersion: aws.String("5.7.mysql_aurora.2.07.2"),
+				cr:            cluster(withPasswordSecrets(true)),
+				obj:           &svcsdk.ModifyDBClusterInput{EngineVersion: aws.String("5.7.mysql_aurora.2.10.0")},
+			},
+			want: want{
+				obj: &svcsdk.ModifyDBClusterInput{
+					DBClusterIdentifier: aws.String("cluster"),
+					EngineVersion:       aws.String("5.7.mysql_aurora.2.10.0"),
+				},
+			},
+		},
+		"GetPasswordError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   cluster(withPasswordSecrets(true)),
+				obj:  &svcsdk.ModifyDBClusterInput{},
+			},
+			want: want{
+				obj: &svcsdk.ModifyDBClusterInput{
+					DBClusterIdentifier: aws.String("cluster"),
+				},
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get password secret"), errGetPassword),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &custom{kube: tc.args.kube, engineVersion: tc.args.engineVersion}
+			err := e.preUpdate(context.Background(), tc.args.cr, tc.args.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, tc.args.obj); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostUpdate(t *testing.T) {
+	type args struct {
+		kube client.Client
+		cr   *svcapitypes.DBCluster
+		err  error
+	}
+	type want struct {
+		upd managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PasswordChanged": {
+			args: args{
+				kube: secrets("new", "old"),
+				cr:   cluster(withPasswordSecrets(true)),
+			},
+			want: want{
+				upd: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("new"),
+					},
+				},
+			},
+		},
+		"PasswordUnchanged": {
+			args: args{
+				kube: secrets("same", "same"),
+				cr:   cluster(withPasswordSecrets(true)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				kube: secrets("new", "old"),
+				cr:   cluster(withPasswordSecrets(true)),
+				err:  errBoom,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &custom{kube: tc.args.kube}
+			upd, err := e.postUpdate(context.Background(), tc.args.cr, &svcsdk.ModifyDBClusterOutput{}, managed.ExternalUpdate{}, tc.args.err)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
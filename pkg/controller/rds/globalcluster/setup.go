@@ -2,11 +2,17 @@ package globalcluster
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	rdsv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -21,16 +27,27 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errRemoveFromGlobalCluster = "cannot remove DB cluster from the global cluster"
+	errFailoverGlobalCluster   = "cannot fail over the global cluster"
+	errGetFailoverConfig       = "cannot get AWS config for the region of the failover target"
+	errNoPrimaryRegionMember   = "no member of the global cluster is in the primary region %s"
+	errNotMembers              = "DB clusters are not members of the global cluster, they have to be created with its identifier: %s"
+)
+
 // SetupGlobalCluster adds a controller that reconciles GlobalCluster.
 func SetupGlobalCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(svcapitypes.GlobalClusterGroupKind)
 	opts := []option{
 		func(e *external) {
+			h := &hooks{kube: e.kube, client: e.client}
 			e.preObserve = preObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 			e.filterList = filterList
-			e.postObserve = postObserve
+			e.postObserve = h.postObserve
+			e.isUpToDate = isUpToDate
+			e.update = h.update
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -62,11 +79,12 @@ func preDelete(_ context.Context, cr *svcapitypes.GlobalCluster, obj *svcsdk.Del
 	return false, nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.GlobalCluster, resp *svcsdk.DescribeGlobalClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *hooks) postObserve(_ context.Context, cr *svcapitypes.GlobalCluster, resp *svcsdk.DescribeGlobalClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
-	switch aws.StringValue(resp.GlobalClusters[0].Status) {
+	e.observed = resp.GlobalClusters[0]
+	switch aws.StringValue(e.observed.Status) {
 	case "available":
 		cr.SetConditions(xpv1.Available())
 	case "deleting", "stopped", "stopping":
@@ -87,3 +105,176 @@ func filterList(cr *svcapitypes.GlobalCluster, obj *svcsdk.DescribeGlobalCluster
 	}
 	return resp
 }
+
+// isUpToDate checks the global cluster settings, its primary region and its
+// members. A global cluster that is not available cannot be modified, so it
+// is considered up to date until it becomes available. The primary region
+// without a member and the desired DB clusters that are not members are
+// reported as errors by Update.
+func isUpToDate(cr *svcapitypes.GlobalCluster, resp *svcsdk.DescribeGlobalClustersOutput) (bool, error) {
+	gc := resp.GlobalClusters[0]
+	if aws.StringValue(gc.Status) != "available" {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	target, err := getFailoverTarget(p.PrimaryRegion, gc.GlobalClusterMembers)
+	switch {
+	case generateModifyGlobalClusterInput(cr, gc) != nil,
+		err != nil, target != "",
+		len(getMembersToRemove(p.CustomGlobalClusterParameters, gc.GlobalClusterMembers)) != 0,
+		len(getMissingMembers(p.DBClusterARNs, gc.GlobalClusterMembers)) != 0:
+		return false, nil
+	}
+	return true, nil
+}
+
+// generateModifyGlobalClusterInput returns the input to modify the settings
+// of the global cluster that differ from the desired ones, or nil if they are
+// all up to date. The engine version is sent only when it needs to be
+// changed since the API rejects the upgrades to the current version.
+func generateModifyGlobalClusterInput(cr *svcapitypes.GlobalCluster, gc *svcsdk.GlobalCluster) *svcsdk.ModifyGlobalClusterInput {
+	p := cr.Spec.ForProvider
+	obj := &svcsdk.ModifyGlobalClusterInput{}
+	changed := false
+	if p.DeletionProtection != nil && aws.BoolValue(p.DeletionProtection) != aws.BoolValue(gc.DeletionProtection) {
+		obj.DeletionProtection = p.DeletionProtection
+		changed = true
+	}
+	if p.EngineVersion != nil && aws.StringValue(p.EngineVersion) != aws.StringValue(gc.EngineVersion) {
+		obj.EngineVersion = p.EngineVersion
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	obj.GlobalClusterIdentifier = aws.String(meta.GetExternalName(cr))
+	return obj
+}
+
+// getRegion returns the region of the DB cluster with the given ARN.
+func getRegion(clusterARN string) string {
+	a, err := arn.Parse(clusterARN)
+	if err != nil {
+		return ""
+	}
+	return a.Region
+}
+
+// getFailoverTarget returns the ARN of the member in the given primary region
+// if it is not the writer of the global cluster already. It returns an error
+// if none of the members is in the primary region.
+func getFailoverTarget(primaryRegion *string, members []*svcsdk.GlobalClusterMember) (string, error) {
+	if primaryRegion == nil {
+		return "", nil
+	}
+	target := ""
+	for _, m := range members {
+		if getRegion(aws.StringValue(m.DBClusterArn)) != aws.StringValue(primaryRegion) {
+			continue
+		}
+		if aws.BoolValue(m.IsWriter) {
+			return "", nil
+		}
+		target = aws.StringValue(m.DBClusterArn)
+	}
+	if target == "" {
+		return "", errors.Errorf(errNoPrimaryRegionMember, aws.StringValue(primaryRegion))
+	}
+	return target, nil
+}
+
+// getMembersToRemove returns the ARNs of the secondary members that are not
+// desired. The primary member cannot be removed while there are secondary
+// ones, so it has to be failed over first. No member is removed while some of
+// the referenced DB clusters are not resolved yet since they may be members
+// already.
+func getMembersToRemove(p svcapitypes.CustomGlobalClusterParameters, members []*svcsdk.GlobalClusterMember) []string {
+	if len(p.DBClusterARNs) == 0 || len(p.DBClusterARNs) < len(p.DBClusterARNRefs) {
+		return nil
+	}
+	keep := make(map[string]bool, len(p.DBClusterARNs))
+	for _, a := range p.DBClusterARNs {
+		keep[a] = true
+	}
+	var remove []string
+	for _, m := range members {
+		if !aws.BoolValue(m.IsWriter) && !keep[aws.StringValue(m.DBClusterArn)] {
+			remove = append(remove, aws.StringValue(m.DBClusterArn))
+		}
+	}
+	return remove
+}
+
+// getMissingMembers returns the desired ARNs that are not members of the
+// global cluster. Existing DB clusters cannot be added to a global cluster,
+// so they have to be created with its identifier instead.
+func getMissingMembers(desired []string, members []*svcsdk.GlobalClusterMember) []string {
+	existing := make(map[string]bool, len(members))
+	for _, m := range members {
+		existing[aws.StringValue(m.DBClusterArn)] = true
+	}
+	var missing []string
+	for _, a := range desired {
+		if !existing[a] {
+			missing = append(missing, a)
+		}
+	}
+	return missing
+}
+
+type hooks struct {
+	kube     client.Client
+	client   svcsdkapi.RDSAPI
+	observed *svcsdk.GlobalCluster
+}
+
+// update modifies the settings of the global cluster, fails it over or
+// removes an undesired member. Only one of them is done in a single pass
+// since the global cluster cannot be changed while it is being modified,
+// failed over or while a member is being removed.
+func (e *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.GlobalCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	gc := e.observed
+	if obj := generateModifyGlobalClusterInput(cr, gc); obj != nil {
+		_, err := e.client.ModifyGlobalClusterWithContext(ctx, obj)
+		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+	}
+	p := cr.Spec.ForProvider
+	target, err := getFailoverTarget(p.PrimaryRegion, gc.GlobalClusterMembers)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if target != "" {
+		return managed.ExternalUpdate{}, e.failover(ctx, cr, target)
+	}
+	if remove := getMembersToRemove(p.CustomGlobalClusterParameters, gc.GlobalClusterMembers); len(remove) != 0 {
+		_, err := e.client.RemoveFromGlobalClusterWithContext(ctx, &svcsdk.RemoveFromGlobalClusterInput{
+			GlobalClusterIdentifier: aws.String(meta.GetExternalName(cr)),
+			DbClusterIdentifier:     aws.String(remove[0]),
+		})
+		return managed.ExternalUpdate{}, aws.Wrap(err, errRemoveFromGlobalCluster)
+	}
+	if missing := getMissingMembers(p.DBClusterARNs, gc.GlobalClusterMembers); len(missing) != 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotMembers, strings.Join(missing, ", "))
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// failover promotes the given member to be the primary cluster. The request
+// is sent to the region of the target since the current primary region may
+// be the one that is unavailable.
+func (e *hooks) failover(ctx context.Context, cr *svcapitypes.GlobalCluster, target string) error {
+	// FailoverGlobalCluster is available only in AWS SDK v2.
+	cfg, err := aws.GetConfig(ctx, e.kube, cr, getRegion(target))
+	if err != nil {
+		return errors.Wrap(err, errGetFailoverConfig)
+	}
+	_, err = rdsv2.NewFromConfig(*cfg).FailoverGlobalCluster(ctx, &rdsv2.FailoverGlobalClusterInput{
+		GlobalClusterIdentifier:   aws.String(meta.GetExternalName(cr)),
+		TargetDbClusterIdentifier: aws.String(target),
+	})
+	return aws.Wrap(err, errFailoverGlobalCluster)
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalcluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	primaryARN   = "arn:aws:rds:us-east-1:123456789012:cluster:primary"
+	secondaryARN = "arn:aws:rds:eu-west-1:123456789012:cluster:secondary"
+	otherARN     = "arn:aws:rds:us-west-2:123456789012:cluster:other"
+	missingARN   = "arn:aws:rds:ap-south-1:123456789012:cluster:missing"
+)
+
+func members() []*svcsdk.GlobalClusterMember {
+	return []*svcsdk.GlobalClusterMember{
+		{DBClusterArn: aws.String(primaryARN), IsWriter: aws.Bool(true)},
+		{DBClusterArn: aws.String(secondaryARN), IsWriter: aws.Bool(false)},
+		{DBClusterArn: aws.String(otherARN), IsWriter: aws.Bool(false)},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p  svcapitypes.GlobalClusterParameters
+		gc svcsdk.GlobalCluster
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					DeletionProtection: aws.Bool(true),
+					CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+						PrimaryRegion: aws.String("us-east-1"),
+						DBClusterARNs: []string{primaryARN, secondaryARN, otherARN},
+					},
+				},
+				gc: svcsdk.GlobalCluster{
+					Status:               aws.String("available"),
+					DeletionProtection:   aws.Bool(true),
+					GlobalClusterMembers: members(),
+				},
+			},
+			want: true,
+		},
+		"DeletionProtectionChanged": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					DeletionProtection: aws.Bool(true),
+				},
+				gc: svcsdk.GlobalCluster{
+					Status: aws.String("available"),
+				},
+			},
+			want: false,
+		},
+		"PrimaryRegionChanged": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+						PrimaryRegion: aws.String("eu-west-1"),
+					},
+				},
+				gc: svcsdk.GlobalCluster{
+					Status:               aws.String("available"),
+					GlobalClusterMembers: members(),
+				},
+			},
+			want: false,
+		},
+		"MemberRemoved": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+						DBClusterARNs: []string{primaryARN, secondaryARN},
+					},
+				},
+				gc: svcsdk.GlobalCluster{
+					Status:               aws.String("available"),
+					GlobalClusterMembers: members(),
+				},
+			},
+			want: false,
+		},
+		"EngineVersionUnchanged": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					EngineVersion: aws.String("5.7.mysql_aurora.2.10.0"),
+				},
+				gc: svcsdk.GlobalCluster{
+					Status:        aws.String("available"),
+					EngineVersion: aws.String("5.7.mysql_aurora.2.10.0"),
+				},
+			},
+			want: true,
+		},
+		"PrimaryRegionWithoutMember": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+						PrimaryRegion: aws.String("ap-south-1"),
+					},
+				},
+				gc: svcsdk.GlobalCluster{
+					Status:               aws.String("available"),
+					GlobalClusterMembers: members(),
+				},
+			},
+			want: false,
+		},
+		"MemberMissing": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+						DBClusterARNs: []string{primaryARN, secondaryARN, otherARN, missingARN},
+					},
+				},
+				gc: svcsdk.GlobalCluster{
+					Status:               aws.String("available"),
+					GlobalClusterMembers: members(),
+				},
+			},
+			want: false,
+		},
+		"FailingOver": {
+			args: args{
+				p: svcapitypes.GlobalClusterParameters{
+					CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+						PrimaryRegion: aws.String("eu-west-1"),
+					},
+				},
+				gc: svcsdk.GlobalCluster{
+					Status:               aws.String("failing-over"),
+					GlobalClusterMembers: members(),
+				},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.GlobalCluster{Spec: svcapitypes.GlobalClusterSpec{ForProvider: tc.args.p}}
+			got, err := isUpToDate(cr, &svcsdk.DescribeGlobalClustersOutput{GlobalClusters: []*svcsdk.GlobalCluster{&tc.args.gc}})
+			if err != nil {
+				t.Errorf("r: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetFailoverTarget(t *testing.T) {
+	cases := map[string]struct {
+		primaryRegion *string
+		want          string
+		err           error
+	}{
+		"NotManaged": {},
+		"AlreadyPrimary": {
+			primaryRegion: aws.String("us-east-1"),
+		},
+		"Secondary": {
+			primaryRegion: aws.String("eu-west-1"),
+			want:          secondaryARN,
+		},
+		"NoMemberInRegion": {
+			primaryRegion: aws.String("ap-south-1"),
+			err:           errors.Errorf(errNoPrimaryRegionMember, "ap-south-1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := getFailoverTarget(tc.primaryRegion, members())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetMembersToRemove(t *testing.T) {
+	cases := map[string]struct {
+		p    svcapitypes.CustomGlobalClusterParameters
+		want []string
+	}{
+		"NotManaged": {},
+		"RemoveSecondary": {
+			p:    svcapitypes.CustomGlobalClusterParameters{DBClusterARNs: []string{primaryARN, secondaryARN}},
+			want: []string{otherARN},
+		},
+		"KeepPrimary": {
+			p:    svcapitypes.CustomGlobalClusterParameters{DBClusterARNs: []string{secondaryARN}},
+			want: []string{otherARN},
+		},
+		"UnresolvedReferences": {
+			p: svcapitypes.CustomGlobalClusterParameters{
+				DBClusterARNs:    []string{primaryARN},
+				DBClusterARNRefs: []xpv1.Reference{{Name: "primary"}, {Name: "secondary"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getMembersToRemove(tc.p, members())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetMissingMembers(t *testing.T) {
+	cases := map[string]struct {
+		desired []string
+		want    []string
+	}{
+		"NotManaged": {},
+		"AllMembers": {
+			desired: []string{primaryARN, secondaryARN, otherARN},
+		},
+		"Missing": {
+			desired: []string{primaryARN, missingARN},
+			want:    []string{missingARN},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getMissingMembers(tc.desired, members())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// mockUpdateClient records the update calls made to the RDS API. The calls
+// that are not implemented panic.
+type mockUpdateClient struct {
+	svcsdkapi.RDSAPI
+
+	calls []string
+}
+
+func (m *mockUpdateClient) ModifyGlobalClusterWithContext(_ context.Context, in *svcsdk.ModifyGlobalClusterInput, _ ...request.Option) (*svcsdk.ModifyGlobalClusterOutput, error) {
+	m.calls = append(m.calls, "ModifyGlobalCluster:"+aws.StringValue(in.EngineVersion))
+	return &svcsdk.ModifyGlobalClusterOutput{}, nil
+}
+
+func (m *mockUpdateClient) RemoveFromGlobalClusterWithContext(_ context.Context, in *svcsdk.RemoveFromGlobalClusterInput, _ ...request.Option) (*svcsdk.RemoveFromGlobalClusterOutput, error) {
+	m.calls = append(m.calls, "RemoveFromGlobalCluster:"+aws.StringValue(in.DbClusterIdentifier))
+	return &svcsdk.RemoveFromGlobalClusterOutput{}, nil
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		p    svcapitypes.GlobalClusterParameters
+		gc   svcsdk.GlobalCluster
+		want want
+	}{
+		"EngineVersionChanged": {
+			p: svcapitypes.GlobalClusterParameters{
+				EngineVersion: aws.String("5.7.mysql_aurora.2.10.1"),
+				CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+					DBClusterARNs: []string{primaryARN, secondaryARN},
+				},
+			},
+			gc: svcsdk.GlobalCluster{
+				EngineVersion:        aws.String("5.7.mysql_aurora.2.10.0"),
+				GlobalClusterMembers: members(),
+			},
+			want: want{
+				calls: []string{"ModifyGlobalCluster:5.7.mysql_aurora.2.10.1"},
+			},
+		},
+		"MemberRemoved": {
+			p: svcapitypes.GlobalClusterParameters{
+				EngineVersion: aws.String("5.7.mysql_aurora.2.10.0"),
+				CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+					DBClusterARNs: []string{primaryARN, secondaryARN},
+				},
+			},
+			gc: svcsdk.GlobalCluster{
+				EngineVersion:        aws.String("5.7.mysql_aurora.2.10.0"),
+				GlobalClusterMembers: members(),
+			},
+			want: want{
+				calls: []string{"RemoveFromGlobalCluster:" + otherARN},
+			},
+		},
+		"OneMemberRemovedPerPass": {
+			p: svcapitypes.GlobalClusterParameters{
+				CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+					DBClusterARNs: []string{primaryARN},
+				},
+			},
+			gc: svcsdk.GlobalCluster{
+				GlobalClusterMembers: members(),
+			},
+			want: want{
+				calls: []string{"RemoveFromGlobalCluster:" + secondaryARN},
+			},
+		},
+		"MemberMissing": {
+			p: svcapitypes.GlobalClusterParameters{
+				CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+					DBClusterARNs: []string{primaryARN, secondaryARN, otherARN, missingARN},
+				},
+			},
+			gc: svcsdk.GlobalCluster{
+				GlobalClusterMembers: members(),
+			},
+			want: want{
+				err: errors.Errorf(errNotMembers, missingARN),
+			},
+		},
+		"PrimaryRegionWithoutMember": {
+			p: svcapitypes.GlobalClusterParameters{
+				CustomGlobalClusterParameters: svcapitypes.CustomGlobalClusterParameters{
+					PrimaryRegion: aws.String("ap-south-1"),
+				},
+			},
+			gc: svcsdk.GlobalCluster{
+				GlobalClusterMembers: members(),
+			},
+			want: want{
+				err: errors.Errorf(errNoPrimaryRegionMember, "ap-south-1"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockUpdateClient{}
+			h := &hooks{client: client, observed: &tc.gc}
+			cr := &svcapitypes.GlobalCluster{Spec: svcapitypes.GlobalClusterSpec{ForProvider: tc.p}}
+			got, err := h.update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(managed.ExternalUpdate{}, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, client.calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -171,16 +171,8 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 }
 
 func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*svcapitypes.GlobalCluster)
-	if !ok {
-		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
-	}
-	input := GenerateModifyGlobalClusterInput(cr)
-	if err := e.preUpdate(ctx, cr, input); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, "pre-update failed")
-	}
-	resp, err := e.client.ModifyGlobalClusterWithContext(ctx, input)
-	return e.postUpdate(ctx, cr, resp, managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate))
+	return e.update(ctx, mg)
+
 }
 
 func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
@@ -216,8 +208,7 @@ func newExternal(kube client.Client, client svcsdkapi.RDSAPI, opts []option) *ex
 		postCreate:     nopPostCreate,
 		preDelete:      nopPreDelete,
 		postDelete:     nopPostDelete,
-		preUpdate:      nopPreUpdate,
-		postUpdate:     nopPostUpdate,
+		update:         nopUpdate,
 	}
 	for _, f := range opts {
 		f(e)
@@ -237,8 +228,7 @@ type external struct {
 	postCreate     func(context.Context, *svcapitypes.GlobalCluster, *svcsdk.CreateGlobalClusterOutput, managed.ExternalCreation, error) (managed.ExternalCreation, error)
 	preDelete      func(context.Context, *svcapitypes.GlobalCluster, *svcsdk.DeleteGlobalClusterInput) (bool, error)
 	postDelete     func(context.Context, *svcapitypes.GlobalCluster, *svcsdk.DeleteGlobalClusterOutput, error) error
-	preUpdate      func(context.Context, *svcapitypes.GlobalCluster, *svcsdk.ModifyGlobalClusterInput) error
-	postUpdate     func(context.Context, *svcapitypes.GlobalCluster, *svcsdk.ModifyGlobalClusterOutput, managed.ExternalUpdate, error) (managed.ExternalUpdate, error)
+	update         func(context.Context, cpresource.Managed) (managed.ExternalUpdate, error)
 }
 
 func nopPreObserve(context.Context, *svcapitypes.GlobalCluster, *svcsdk.DescribeGlobalClustersInput) error {
@@ -270,9 +260,6 @@ func nopPreDelete(context.Context, *svcapitypes.GlobalCluster, *svcsdk.DeleteGlo
 func nopPostDelete(_ context.Context, _ *svcapitypes.GlobalCluster, _ *svcsdk.DeleteGlobalClusterOutput, err error) error {
 	return err
 }
-func nopPreUpdate(context.Context, *svcapitypes.GlobalCluster, *svcsdk.ModifyGlobalClusterInput) error {
-	return nil
-}
-func nopPostUpdate(_ context.Context, _ *svcapitypes.GlobalCluster, _ *svcsdk.ModifyGlobalClusterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
-	return upd, err
+func nopUpdate(context.Context, cpresource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
 }
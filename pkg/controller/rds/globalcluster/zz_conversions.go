@@ -151,20 +151,6 @@ func GenerateCreateGlobalClusterInput(cr *svcapitypes.GlobalCluster) *svcsdk.Cre
 	return res
 }
 
-// GenerateModifyGlobalClusterInput returns an update input.
-func GenerateModifyGlobalClusterInput(cr *svcapitypes.GlobalCluster) *svcsdk.ModifyGlobalClusterInput {
-	res := &svcsdk.ModifyGlobalClusterInput{}
-
-	if cr.Spec.ForProvider.DeletionProtection != nil {
-		res.SetDeletionProtection(*cr.Spec.ForProvider.DeletionProtection)
-	}
-	if cr.Spec.ForProvider.EngineVersion != nil {
-		res.SetEngineVersion(*cr.Spec.ForProvider.EngineVersion)
-	}
-
-	return res
-}
-
 // GenerateDeleteGlobalClusterInput returns a deletion input.
 func GenerateDeleteGlobalClusterInput(cr *svcapitypes.GlobalCluster) *svcsdk.DeleteGlobalClusterInput {
 	res := &svcsdk.DeleteGlobalClusterInput{}
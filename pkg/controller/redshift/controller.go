@@ -40,6 +40,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
 	"github.com/crossplane/provider-aws/pkg/clients/redshift"
 )
 
@@ -66,6 +67,7 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 		Complete(managed.NewReconciler(
 			mgr, resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: redshift.NewClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -138,11 +140,26 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	pw, pwChanged, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	conn := redshift.GetConnectionDetails(*cr)
+	// The password in the connection secret is updated only after the
+	// cluster is modified to use it.
+	if pw != "" && !pwChanged {
+		if conn == nil {
+			conn = managed.ConnectionDetails{}
+		}
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(cr.Spec.ForProvider.MasterUsername)
+	}
 
 	return managed.ExternalObservation{
-		ResourceUpToDate:  updated,
+		ResourceUpToDate:  updated && !pwChanged,
 		ResourceExists:    true,
-		ConnectionDetails: redshift.GetConnectionDetails(*cr),
+		ConnectionDetails: conn,
 	}, nil
 }
 
@@ -155,10 +172,16 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.ClusterStatus == v1alpha1.StateCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	pw, err := password.Generate()
+	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
+	if pw == "" {
+		pw, err = password.Generate()
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
 	input := redshift.GenerateCreateClusterInput(&cr.Spec.ForProvider, aws.String(meta.GetExternalName(cr)), aws.String(pw))
 	_, err = e.client.CreateCluster(ctx, input)
 	if err != nil {
@@ -190,7 +213,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(redshift.IsNotFound, err), errDescribeFailed)
 	}
 
-	_, err = e.client.ModifyCluster(ctx, redshift.GenerateModifyClusterInput(&cr.Spec.ForProvider, rsp.Clusters[0]))
+	modify := redshift.GenerateModifyClusterInput(&cr.Spec.ForProvider, rsp.Clusters[0])
+	pw, pwChanged, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// If the password cannot be changed in this request, it will be changed
+	// in one of the next reconciliations since the cluster is still not up
+	// to date.
+	var conn managed.ConnectionDetails
+	if pwChanged && redshift.SetMasterUserPassword(modify, pw) {
+		conn = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}
+	}
+
+	_, err = e.client.ModifyCluster(ctx, modify)
 
 	if err == nil && aws.ToString(cr.Spec.ForProvider.NewClusterIdentifier) != meta.GetExternalName(cr) {
 		meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.NewClusterIdentifier))
@@ -200,7 +238,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyFailed)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
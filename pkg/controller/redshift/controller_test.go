@@ -24,6 +24,8 @@ import (
 	awsredshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -45,6 +47,10 @@ var (
 	singleNode        = "single-node"
 	name              = "redshift-test"
 	vpcSecurityGroups = []awsredshifttypes.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("id-sg")}}
+	connectionSecret  = "redshift-conn"
+	passwordSecret    = "redshift-password"
+	oldPassword       = "old-password"
+	newPassword       = "new-password"
 )
 
 type args struct {
@@ -75,6 +81,31 @@ func withNewExternalName(s string) redshiftModifier {
 	return func(r *v1alpha1.Cluster) { meta.SetExternalName(r, s) }
 }
 
+func withMasterPasswordSecretRef() redshiftModifier {
+	return func(r *v1alpha1.Cluster) {
+		r.Spec.ForProvider.MasterPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: passwordSecret},
+			Key:             "password",
+		}
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: connectionSecret}
+	}
+}
+
+// passwordSecrets returns a Get function that serves the referenced
+// password secret with the given password and the connection secret with
+// the given published password.
+func passwordSecrets(pw, published string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		data := map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(published)}
+		if key == (types.NamespacedName{Name: passwordSecret}) {
+			data = map[string][]byte{"password": []byte(pw)}
+		}
+		s := corev1.Secret{Data: data}
+		s.DeepCopyInto(obj.(*corev1.Secret))
+		return nil
+	}
+}
+
 func cluster(m ...redshiftModifier) *v1alpha1.Cluster {
 	cr := &v1alpha1.Cluster{
 		Spec: v1alpha1.ClusterSpec{
@@ -145,6 +176,78 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PasswordChanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: passwordSecrets(newPassword, oldPassword),
+				},
+				redshift: &fake.MockRedshiftClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{
+								{
+									ClusterStatus:     aws.String(string(v1alpha1.StateAvailable)),
+									NumberOfNodes:     1,
+									ClusterIdentifier: &name,
+									MasterUsername:    &masterUsername,
+									NodeType:          &nodeType,
+									VpcSecurityGroups: vpcSecurityGroups,
+								},
+							},
+						}, nil
+					},
+				},
+				cr: cluster(withMasterPasswordSecretRef()),
+			},
+			want: want{
+				cr: cluster(
+					withMasterPasswordSecretRef(),
+					withConditions(xpv1.Available()),
+					withClusterStatus(string(v1alpha1.StateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PasswordPublished": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: passwordSecrets(newPassword, newPassword),
+				},
+				redshift: &fake.MockRedshiftClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{
+								{
+									ClusterStatus:     aws.String(string(v1alpha1.StateAvailable)),
+									NumberOfNodes:     1,
+									ClusterIdentifier: &name,
+									MasterUsername:    &masterUsername,
+									NodeType:          &nodeType,
+									VpcSecurityGroups: vpcSecurityGroups,
+								},
+							},
+						}, nil
+					},
+				},
+				cr: cluster(withMasterPasswordSecretRef()),
+			},
+			want: want{
+				cr: cluster(
+					withMasterPasswordSecretRef(),
+					withConditions(xpv1.Available()),
+					withClusterStatus(string(v1alpha1.StateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(newPassword),
+					},
+				},
+			},
+		},
 		"DeletingState": {
 			args: args{
 				redshift: &fake.MockRedshiftClient{
@@ -321,6 +424,33 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"SuccessfulWithPasswordSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: passwordSecrets(newPassword, ""),
+				},
+				redshift: &fake.MockRedshiftClient{
+					MockCreate: func(ctx context.Context, input *awsredshift.CreateClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.CreateClusterOutput, error) {
+						if aws.ToString(input.MasterUserPassword) != newPassword {
+							return nil, errBoom
+						}
+						return &awsredshift.CreateClusterOutput{}, nil
+					},
+				},
+				cr: cluster(withMasterPasswordSecretRef()),
+			},
+			want: want{
+				cr: cluster(
+					withMasterPasswordSecretRef(),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(newPassword),
+					},
+				},
+			},
+		},
 		"SuccessfulNoNeedForCreate": {
 			args: args{
 				cr: cluster(withClusterStatus(v1alpha1.StateCreating)),
@@ -401,6 +531,40 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withNewClusterIdentifier("update"), withNewExternalName("update")),
 			},
 		},
+		"RotatePassword": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: passwordSecrets(newPassword, oldPassword),
+				},
+				redshift: &fake.MockRedshiftClient{
+					MockModify: func(ctx context.Context, input *awsredshift.ModifyClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.ModifyClusterOutput, error) {
+						if aws.ToString(input.MasterUserPassword) != newPassword {
+							return nil, errBoom
+						}
+						return &awsredshift.ModifyClusterOutput{}, nil
+					},
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{{
+								NumberOfNodes:     1,
+								MasterUsername:    &masterUsername,
+								NodeType:          &nodeType,
+								VpcSecurityGroups: vpcSecurityGroups,
+							}},
+						}, nil
+					},
+				},
+				cr: cluster(withMasterPasswordSecretRef()),
+			},
+			want: want{
+				cr: cluster(withMasterPasswordSecretRef()),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(newPassword),
+					},
+				},
+			},
+		},
 		"AlreadyModifying": {
 			args: args{
 				cr: cluster(withClusterStatus(v1alpha1.StateModifying)),
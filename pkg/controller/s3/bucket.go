@@ -66,6 +66,7 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.BucketGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, logger: logger}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewDefaultTagger(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(logger),
@@ -139,9 +140,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	// TODO: smarter updating for the bucket, we dont need to update the ACL every time
-	err := s3.UpdateBucketACL(ctx, e.s3client, cr)
-	if err != nil {
-		return managed.ExternalObservation{}, err
+	if !s3.IsACLDisabled(cr.Spec.ForProvider) {
+		if err := s3.UpdateBucketACL(ctx, e.s3client, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 	}
 
 	cr.Status.SetConditions(xpv1.Available())
@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	analyticsListFailed   = "cannot list Bucket analytics configurations"
+	analyticsPutFailed    = "cannot put Bucket analytics configuration"
+	analyticsDeleteFailed = "cannot delete Bucket analytics configuration"
+)
+
+// AnalyticsConfigurationClient is the client for API methods and reconciling the AnalyticsConfigurations
+type AnalyticsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewAnalyticsConfigurationClient creates the client for Analytics Configurations
+func NewAnalyticsConfigurationClient(client s3.BucketClient) *AnalyticsConfigurationClient {
+	return &AnalyticsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *AnalyticsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := bucket.Spec.ForProvider.AnalyticsConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0:
+		return NeedsDeletion, nil
+	}
+	if put, remove := DiffAnalyticsConfigurations(local, external); len(put) != 0 || len(remove) != 0 {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// CreateOrUpdate puts the configurations that are missing or different and
+// deletes the ones that are not in the spec.
+func (in *AnalyticsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := DiffAnalyticsConfigurations(bucket.Spec.ForProvider.AnalyticsConfigurations, external)
+	for i := range put {
+		_, err := in.client.PutBucketAnalyticsConfiguration(ctx, &awss3.PutBucketAnalyticsConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     put[i].Id,
+			AnalyticsConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, analyticsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all Analytics configurations of the bucket.
+func (in *AnalyticsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *AnalyticsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.AnalyticsConfigurations = GenerateLocalAnalyticsConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *AnalyticsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0
+}
+
+func (in *AnalyticsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.AnalyticsConfiguration, error) {
+	var result []types.AnalyticsConfiguration
+	input := &awss3.ListBucketAnalyticsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketAnalyticsConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, analyticsListFailed)
+		}
+		result = append(result, out.AnalyticsConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *AnalyticsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketAnalyticsConfiguration(ctx, &awss3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, analyticsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// DiffAnalyticsConfigurations returns the configurations that need
+// to be put and the IDs of the configurations that need to be deleted for the
+// external configurations to match the local ones.
+func DiffAnalyticsConfigurations(local []v1beta1.AnalyticsConfiguration, external []types.AnalyticsConfiguration) ([]types.AnalyticsConfiguration, []string) {
+	current := make(map[string]types.AnalyticsConfiguration, len(external))
+	for _, c := range external {
+		current[awsclient.StringValue(c.Id)] = c
+	}
+	var put []types.AnalyticsConfiguration
+	for _, l := range local {
+		desired := GenerateAnalyticsConfiguration(l)
+		c, ok := current[l.ID]
+		if !ok || !cmp.Equal(desired, c, equateConfigurations...) {
+			put = append(put, desired)
+		}
+		delete(current, l.ID)
+	}
+	remove := make([]string, 0, len(current))
+	for id := range current {
+		remove = append(remove, id)
+	}
+	sort.Strings(remove)
+	return put, remove
+}
+
+// GenerateAnalyticsConfiguration creates the AnalyticsConfiguration for the AWS SDK
+func GenerateAnalyticsConfiguration(local v1beta1.AnalyticsConfiguration) types.AnalyticsConfiguration {
+	out := types.AnalyticsConfiguration{
+		Id:                   awsclient.String(local.ID),
+		StorageClassAnalysis: &types.StorageClassAnalysis{},
+	}
+	if de := local.StorageClassAnalysis.DataExport; de != nil {
+		dst := de.Destination.S3BucketDestination
+		out.StorageClassAnalysis.DataExport = &types.StorageClassAnalysisDataExport{
+			OutputSchemaVersion: types.StorageClassAnalysisSchemaVersion(de.OutputSchemaVersion),
+			Destination: &types.AnalyticsExportDestination{
+				S3BucketDestination: &types.AnalyticsS3BucketDestination{
+					Bucket:          awsclient.String(dst.Bucket),
+					BucketAccountId: dst.BucketAccountID,
+					Format:          types.AnalyticsS3ExportFileFormat(dst.Format),
+					Prefix:          dst.Prefix,
+				},
+			},
+		}
+	}
+	if local.Filter != nil {
+		switch {
+		case local.Filter.And != nil:
+			out.Filter = &types.AnalyticsFilterMemberAnd{Value: types.AnalyticsAndOperator{
+				Prefix: local.Filter.And.Prefix,
+				Tags:   s3.CopyTags(local.Filter.And.Tags),
+			}}
+		case local.Filter.Tag != nil:
+			out.Filter = &types.AnalyticsFilterMemberTag{Value: types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}}
+		case local.Filter.Prefix != nil:
+			out.Filter = &types.AnalyticsFilterMemberPrefix{Value: awsclient.StringValue(local.Filter.Prefix)}
+		}
+	}
+	return out
+}
+
+// GenerateLocalAnalyticsConfigurations creates the local
+// AnalyticsConfigurations from the ones returned by AWS.
+func GenerateLocalAnalyticsConfigurations(external []types.AnalyticsConfiguration) []v1beta1.AnalyticsConfiguration {
+	if len(external) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.AnalyticsConfiguration, len(external))
+	for i, e := range external {
+		result[i] = v1beta1.AnalyticsConfiguration{ID: awsclient.StringValue(e.Id)}
+		if e.StorageClassAnalysis != nil && e.StorageClassAnalysis.DataExport != nil {
+			de := e.StorageClassAnalysis.DataExport
+			result[i].StorageClassAnalysis.DataExport = &v1beta1.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: string(de.OutputSchemaVersion),
+			}
+			if de.Destination != nil && de.Destination.S3BucketDestination != nil {
+				dst := de.Destination.S3BucketDestination
+				result[i].StorageClassAnalysis.DataExport.Destination.S3BucketDestination = v1beta1.AnalyticsS3BucketDestination{
+					Bucket:          awsclient.StringValue(dst.Bucket),
+					BucketAccountID: dst.BucketAccountId,
+					Format:          string(dst.Format),
+					Prefix:          dst.Prefix,
+				}
+			}
+		}
+		switch f := e.Filter.(type) {
+		case *types.AnalyticsFilterMemberAnd:
+			result[i].Filter = &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{Prefix: f.Value.Prefix, Tags: s3.CopyAWSTags(f.Value.Tags)}}
+		case *types.AnalyticsFilterMemberTag:
+			result[i].Filter = &v1beta1.AnalyticsFilter{Tag: &v1beta1.Tag{Key: awsclient.StringValue(f.Value.Key), Value: awsclient.StringValue(f.Value.Value)}}
+		case *types.AnalyticsFilterMemberPrefix:
+			result[i].Filter = &v1beta1.AnalyticsFilter{Prefix: awsclient.String(f.Value)}
+		}
+	}
+	return result
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	intelligentTieringListFailed   = "cannot list Bucket intelligent tiering configurations"
+	intelligentTieringPutFailed    = "cannot put Bucket intelligent tiering configuration"
+	intelligentTieringDeleteFailed = "cannot delete Bucket intelligent tiering configuration"
+)
+
+// IntelligentTieringConfigurationClient is the client for API methods and reconciling the IntelligentTieringConfigurations
+type IntelligentTieringConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewIntelligentTieringConfigurationClient creates the client for Intelligent-Tiering Configurations
+func NewIntelligentTieringConfigurationClient(client s3.BucketClient) *IntelligentTieringConfigurationClient {
+	return &IntelligentTieringConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *IntelligentTieringConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := bucket.Spec.ForProvider.IntelligentTieringConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0:
+		return NeedsDeletion, nil
+	}
+	if put, remove := DiffIntelligentTieringConfigurations(local, external); len(put) != 0 || len(remove) != 0 {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// CreateOrUpdate puts the configurations that are missing or different and
+// deletes the ones that are not in the spec.
+func (in *IntelligentTieringConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := DiffIntelligentTieringConfigurations(bucket.Spec.ForProvider.IntelligentTieringConfigurations, external)
+	for i := range put {
+		_, err := in.client.PutBucketIntelligentTieringConfiguration(ctx, &awss3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          awsclient.String(meta.GetExternalName(bucket)),
+			Id:                              put[i].Id,
+			IntelligentTieringConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, intelligentTieringPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all Intelligent-Tiering configurations of the bucket.
+func (in *IntelligentTieringConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *IntelligentTieringConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.IntelligentTieringConfigurations = GenerateLocalIntelligentTieringConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *IntelligentTieringConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0
+}
+
+func (in *IntelligentTieringConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.IntelligentTieringConfiguration, error) {
+	var result []types.IntelligentTieringConfiguration
+	input := &awss3.ListBucketIntelligentTieringConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketIntelligentTieringConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, intelligentTieringListFailed)
+		}
+		result = append(result, out.IntelligentTieringConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *IntelligentTieringConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketIntelligentTieringConfiguration(ctx, &awss3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, intelligentTieringDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// DiffIntelligentTieringConfigurations returns the configurations that need
+// to be put and the IDs of the configurations that need to be deleted for the
+// external configurations to match the local ones.
+func DiffIntelligentTieringConfigurations(local []v1beta1.IntelligentTieringConfiguration, external []types.IntelligentTieringConfiguration) ([]types.IntelligentTieringConfiguration, []string) {
+	current := make(map[string]types.IntelligentTieringConfiguration, len(external))
+	for _, c := range external {
+		current[awsclient.StringValue(c.Id)] = c
+	}
+	opts := append([]cmp.Option{cmpopts.SortSlices(func(a, b types.Tiering) bool { return a.AccessTier < b.AccessTier })}, equateConfigurations...)
+	var put []types.IntelligentTieringConfiguration
+	for _, l := range local {
+		desired := GenerateIntelligentTieringConfiguration(l)
+		c, ok := current[l.ID]
+		if !ok || !cmp.Equal(desired, c, opts...) {
+			put = append(put, desired)
+		}
+		delete(current, l.ID)
+	}
+	remove := make([]string, 0, len(current))
+	for id := range current {
+		remove = append(remove, id)
+	}
+	sort.Strings(remove)
+	return put, remove
+}
+
+// GenerateIntelligentTieringConfiguration creates the IntelligentTieringConfiguration for the AWS SDK
+func GenerateIntelligentTieringConfiguration(local v1beta1.IntelligentTieringConfiguration) types.IntelligentTieringConfiguration {
+	out := types.IntelligentTieringConfiguration{
+		Id:       awsclient.String(local.ID),
+		Status:   types.IntelligentTieringStatus(local.Status),
+		Tierings: make([]types.Tiering, len(local.Tierings)),
+	}
+	for i, t := range local.Tierings {
+		out.Tierings[i] = types.Tiering{AccessTier: types.IntelligentTieringAccessTier(t.AccessTier), Days: t.Days}
+	}
+	if local.Filter != nil {
+		out.Filter = &types.IntelligentTieringFilter{Prefix: local.Filter.Prefix}
+		if local.Filter.Tag != nil {
+			out.Filter.Tag = &types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}
+		}
+		if local.Filter.And != nil {
+			out.Filter.And = &types.IntelligentTieringAndOperator{Prefix: local.Filter.And.Prefix, Tags: s3.CopyTags(local.Filter.And.Tags)}
+		}
+	}
+	return out
+}
+
+// GenerateLocalIntelligentTieringConfigurations creates the local
+// IntelligentTieringConfigurations from the ones returned by AWS.
+func GenerateLocalIntelligentTieringConfigurations(external []types.IntelligentTieringConfiguration) []v1beta1.IntelligentTieringConfiguration {
+	if len(external) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.IntelligentTieringConfiguration, len(external))
+	for i, e := range external {
+		result[i] = v1beta1.IntelligentTieringConfiguration{
+			ID:       awsclient.StringValue(e.Id),
+			Status:   string(e.Status),
+			Tierings: make([]v1beta1.Tiering, len(e.Tierings)),
+		}
+		for j, t := range e.Tierings {
+			result[i].Tierings[j] = v1beta1.Tiering{AccessTier: string(t.AccessTier), Days: t.Days}
+		}
+		if e.Filter != nil {
+			result[i].Filter = &v1beta1.IntelligentTieringFilter{Prefix: e.Filter.Prefix}
+			if e.Filter.Tag != nil {
+				result[i].Filter.Tag = &v1beta1.Tag{Key: awsclient.StringValue(e.Filter.Tag.Key), Value: awsclient.StringValue(e.Filter.Tag.Value)}
+			}
+			if e.Filter.And != nil {
+				result[i].Filter.And = &v1beta1.IntelligentTieringAndOperator{Prefix: e.Filter.And.Prefix, Tags: s3.CopyAWSTags(e.Filter.And.Tags)}
+			}
+		}
+	}
+	return result
+}
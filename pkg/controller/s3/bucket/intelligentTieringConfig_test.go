@@ -0,0 +1,439 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &IntelligentTieringConfigurationClient{}
+
+func generateIntelligentTieringConfig(id string) v1beta1.IntelligentTieringConfiguration {
+	return v1beta1.IntelligentTieringConfiguration{
+		ID: id,
+		Filter: &v1beta1.IntelligentTieringFilter{
+			And: &v1beta1.IntelligentTieringAndOperator{
+				Prefix: awsclient.String("logs/"),
+				Tags:   []v1beta1.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}},
+			},
+		},
+		Status: enabled,
+		Tierings: []v1beta1.Tiering{
+			{AccessTier: "ARCHIVE_ACCESS", Days: 90},
+			{AccessTier: "DEEP_ARCHIVE_ACCESS", Days: 180},
+		},
+	}
+}
+
+func generateAWSIntelligentTieringConfig(id string) s3types.IntelligentTieringConfiguration {
+	return s3types.IntelligentTieringConfiguration{
+		Id: awsclient.String(id),
+		Filter: &s3types.IntelligentTieringFilter{
+			And: &s3types.IntelligentTieringAndOperator{
+				Prefix: awsclient.String("logs/"),
+				Tags: []s3types.Tag{
+					{Key: awsclient.String("b"), Value: awsclient.String("2")},
+					{Key: awsclient.String("a"), Value: awsclient.String("1")},
+				},
+			},
+		},
+		Status: s3types.IntelligentTieringStatusEnabled,
+		Tierings: []s3types.Tiering{
+			{AccessTier: s3types.IntelligentTieringAccessTierDeepArchiveAccess, Days: 180},
+			{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: 90},
+		},
+	}
+}
+
+func TestIntelligentTieringObserve(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, intelligentTieringListFailed),
+			},
+		},
+		"NoConfig": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("a")},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						c := generateAWSIntelligentTieringConfig("a")
+						c.Status = s3types.IntelligentTieringStatusDisabled
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{c},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdateExtraConfig": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{
+								generateAWSIntelligentTieringConfig("a"),
+								generateAWSIntelligentTieringConfig("b"),
+							},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeededPaginated": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"), generateIntelligentTieringConfig("b"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						if input.ContinuationToken == nil {
+							return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+								IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("a")},
+								IsTruncated:                         true,
+								NextContinuationToken:               awsclient.String("next"),
+							}, nil
+						}
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("b")},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		put     []string
+		deleted []string
+	}
+
+	var put, deleted []string
+	client := func(external ...s3types.IntelligentTieringConfiguration) *IntelligentTieringConfigurationClient {
+		return NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+			MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+				return &s3.ListBucketIntelligentTieringConfigurationsOutput{IntelligentTieringConfigurationList: external}, nil
+			},
+			MockPutBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+				put = append(put, awsclient.StringValue(input.Id))
+				return &s3.PutBucketIntelligentTieringConfigurationOutput{}, nil
+			},
+			MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+				deleted = append(deleted, awsclient.StringValue(input.Id))
+				return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+			},
+		})
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutOnlyChanged": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"), generateIntelligentTieringConfig("b"))),
+				cl: client(generateAWSIntelligentTieringConfig("a")),
+			},
+			want: want{
+				put: []string{"b"},
+			},
+		},
+		"DeleteExtra": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: client(generateAWSIntelligentTieringConfig("a"), generateAWSIntelligentTieringConfig("c"), generateAWSIntelligentTieringConfig("b")),
+			},
+			want: want{
+				deleted: []string{"b", "c"},
+			},
+		},
+		"PutError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+					},
+					MockPutBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringPutFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, deleted = nil, nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringDelete(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("a")},
+						}, nil
+					},
+					MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringDeleteFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("a")},
+						}, nil
+					},
+					MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+						return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringLateInit(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NoLateInitEmpty": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("a")},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(v1beta1.IntelligentTieringConfiguration{
+					ID: "a",
+					Filter: &v1beta1.IntelligentTieringFilter{
+						And: &v1beta1.IntelligentTieringAndOperator{
+							Prefix: awsclient.String("logs/"),
+							Tags:   []v1beta1.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}},
+						},
+					},
+					Status: enabled,
+					Tierings: []v1beta1.Tiering{
+						{AccessTier: "DEEP_ARCHIVE_ACCESS", Days: 180},
+						{AccessTier: "ARCHIVE_ACCESS", Days: 90},
+					},
+				})),
+			},
+		},
+		"NoOpLateInit": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
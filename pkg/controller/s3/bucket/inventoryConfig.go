@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	inventoryListFailed   = "cannot list Bucket inventory configurations"
+	inventoryPutFailed    = "cannot put Bucket inventory configuration"
+	inventoryDeleteFailed = "cannot delete Bucket inventory configuration"
+)
+
+// InventoryConfigurationClient is the client for API methods and reconciling the InventoryConfigurations
+type InventoryConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewInventoryConfigurationClient creates the client for Inventory Configurations
+func NewInventoryConfigurationClient(client s3.BucketClient) *InventoryConfigurationClient {
+	return &InventoryConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *InventoryConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := bucket.Spec.ForProvider.InventoryConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0:
+		return NeedsDeletion, nil
+	}
+	if put, remove := DiffInventoryConfigurations(local, external); len(put) != 0 || len(remove) != 0 {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// CreateOrUpdate puts the configurations that are missing or different and
+// deletes the ones that are not in the spec.
+func (in *InventoryConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := DiffInventoryConfigurations(bucket.Spec.ForProvider.InventoryConfigurations, external)
+	for i := range put {
+		_, err := in.client.PutBucketInventoryConfiguration(ctx, &awss3.PutBucketInventoryConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     put[i].Id,
+			InventoryConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, inventoryPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all Inventory configurations of the bucket.
+func (in *InventoryConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *InventoryConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.InventoryConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.InventoryConfigurations = GenerateLocalInventoryConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *InventoryConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.InventoryConfigurations) != 0
+}
+
+func (in *InventoryConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.InventoryConfiguration, error) {
+	var result []types.InventoryConfiguration
+	input := &awss3.ListBucketInventoryConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketInventoryConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, inventoryListFailed)
+		}
+		result = append(result, out.InventoryConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *InventoryConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketInventoryConfiguration(ctx, &awss3.DeleteBucketInventoryConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, inventoryDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// DiffInventoryConfigurations returns the configurations that need
+// to be put and the IDs of the configurations that need to be deleted for the
+// external configurations to match the local ones.
+func DiffInventoryConfigurations(local []v1beta1.InventoryConfiguration, external []types.InventoryConfiguration) ([]types.InventoryConfiguration, []string) {
+	current := make(map[string]types.InventoryConfiguration, len(external))
+	for _, c := range external {
+		current[awsclient.StringValue(c.Id)] = c
+	}
+	opts := append([]cmp.Option{cmpopts.SortSlices(func(a, b types.InventoryOptionalField) bool { return a < b })}, equateConfigurations...)
+	var put []types.InventoryConfiguration
+	for _, l := range local {
+		desired := GenerateInventoryConfiguration(l)
+		c, ok := current[l.ID]
+		if !ok || !cmp.Equal(desired, c, opts...) {
+			put = append(put, desired)
+		}
+		delete(current, l.ID)
+	}
+	remove := make([]string, 0, len(current))
+	for id := range current {
+		remove = append(remove, id)
+	}
+	sort.Strings(remove)
+	return put, remove
+}
+
+// GenerateInventoryConfiguration creates the InventoryConfiguration for the AWS SDK
+func GenerateInventoryConfiguration(local v1beta1.InventoryConfiguration) types.InventoryConfiguration {
+	dst := local.Destination.S3BucketDestination
+	out := types.InventoryConfiguration{
+		Id:                     awsclient.String(local.ID),
+		IncludedObjectVersions: types.InventoryIncludedObjectVersions(local.IncludedObjectVersions),
+		IsEnabled:              local.IsEnabled,
+		Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequency(local.Schedule.Frequency)},
+		Destination: &types.InventoryDestination{
+			S3BucketDestination: &types.InventoryS3BucketDestination{
+				AccountId: dst.AccountID,
+				Bucket:    awsclient.String(dst.Bucket),
+				Format:    types.InventoryFormat(dst.Format),
+				Prefix:    dst.Prefix,
+			},
+		},
+	}
+	if dst.Encryption != nil {
+		enc := &types.InventoryEncryption{}
+		if dst.Encryption.SSEKMS != nil {
+			enc.SSEKMS = &types.SSEKMS{KeyId: awsclient.String(dst.Encryption.SSEKMS.KeyID)}
+		}
+		if awsclient.BoolValue(dst.Encryption.SSES3) {
+			enc.SSES3 = &types.SSES3{}
+		}
+		out.Destination.S3BucketDestination.Encryption = enc
+	}
+	if local.Filter != nil {
+		out.Filter = &types.InventoryFilter{Prefix: awsclient.String(local.Filter.Prefix)}
+	}
+	for _, f := range local.OptionalFields {
+		out.OptionalFields = append(out.OptionalFields, types.InventoryOptionalField(f))
+	}
+	return out
+}
+
+// GenerateLocalInventoryConfigurations creates the local
+// InventoryConfigurations from the ones returned by AWS.
+func GenerateLocalInventoryConfigurations(external []types.InventoryConfiguration) []v1beta1.InventoryConfiguration {
+	if len(external) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.InventoryConfiguration, len(external))
+	for i, e := range external {
+		result[i] = v1beta1.InventoryConfiguration{
+			ID:                     awsclient.StringValue(e.Id),
+			IncludedObjectVersions: string(e.IncludedObjectVersions),
+			IsEnabled:              e.IsEnabled,
+		}
+		if e.Schedule != nil {
+			result[i].Schedule.Frequency = string(e.Schedule.Frequency)
+		}
+		if e.Destination != nil && e.Destination.S3BucketDestination != nil {
+			dst := e.Destination.S3BucketDestination
+			result[i].Destination.S3BucketDestination = v1beta1.InventoryS3BucketDestination{
+				AccountID: dst.AccountId,
+				Bucket:    awsclient.StringValue(dst.Bucket),
+				Format:    string(dst.Format),
+				Prefix:    dst.Prefix,
+			}
+			if dst.Encryption != nil {
+				enc := &v1beta1.InventoryEncryption{}
+				if dst.Encryption.SSEKMS != nil {
+					enc.SSEKMS = &v1beta1.SSEKMS{KeyID: awsclient.StringValue(dst.Encryption.SSEKMS.KeyId)}
+				}
+				if dst.Encryption.SSES3 != nil {
+					enc.SSES3 = awsclient.Bool(true)
+				}
+				result[i].Destination.S3BucketDestination.Encryption = enc
+			}
+		}
+		if e.Filter != nil {
+			result[i].Filter = &v1beta1.InventoryFilter{Prefix: awsclient.StringValue(e.Filter.Prefix)}
+		}
+		for _, f := range e.OptionalFields {
+			result[i].OptionalFields = append(result[i].OptionalFields, string(f))
+		}
+	}
+	return result
+}
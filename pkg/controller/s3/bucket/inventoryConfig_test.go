@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &InventoryConfigurationClient{}
+
+func generateInventoryConfig() v1beta1.InventoryConfiguration {
+	return v1beta1.InventoryConfiguration{
+		ID: "inventory",
+		Destination: v1beta1.InventoryDestination{
+			S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+				AccountID:  awsclient.String("123456789012"),
+				Bucket:     "arn:aws:s3:::inventory",
+				Encryption: &v1beta1.InventoryEncryption{SSES3: awsclient.Bool(true)},
+				Format:     "CSV",
+				Prefix:     awsclient.String("reports/"),
+			},
+		},
+		Filter:                 &v1beta1.InventoryFilter{Prefix: "data/"},
+		IncludedObjectVersions: "Current",
+		IsEnabled:              true,
+		OptionalFields:         []string{"Size", "ETag"},
+		Schedule:               v1beta1.InventorySchedule{Frequency: "Daily"},
+	}
+}
+
+func generateAWSInventoryConfig() s3types.InventoryConfiguration {
+	return s3types.InventoryConfiguration{
+		Id: awsclient.String("inventory"),
+		Destination: &s3types.InventoryDestination{
+			S3BucketDestination: &s3types.InventoryS3BucketDestination{
+				AccountId:  awsclient.String("123456789012"),
+				Bucket:     awsclient.String("arn:aws:s3:::inventory"),
+				Encryption: &s3types.InventoryEncryption{SSES3: &s3types.SSES3{}},
+				Format:     s3types.InventoryFormatCsv,
+				Prefix:     awsclient.String("reports/"),
+			},
+		},
+		Filter:                 &s3types.InventoryFilter{Prefix: awsclient.String("data/")},
+		IncludedObjectVersions: s3types.InventoryIncludedObjectVersionsCurrent,
+		IsEnabled:              true,
+		OptionalFields:         []s3types.InventoryOptionalField{s3types.InventoryOptionalFieldSize, s3types.InventoryOptionalFieldETag},
+		Schedule:               &s3types.InventorySchedule{Frequency: s3types.InventoryFrequencyDaily},
+	}
+}
+
+func TestInventoryObserve(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	list := func(external ...s3types.InventoryConfiguration) *InventoryConfigurationClient {
+		return NewInventoryConfigurationClient(fake.MockBucketClient{
+			MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+				return &s3.ListBucketInventoryConfigurationsOutput{InventoryConfigurationList: external}, nil
+			},
+		})
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, inventoryListFailed),
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: list(generateAWSInventoryConfig()),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig())),
+				cl: list(func() s3types.InventoryConfiguration {
+					c := generateAWSInventoryConfig()
+					c.Schedule.Frequency = s3types.InventoryFrequencyWeekly
+					return c
+				}()),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeeded": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig())),
+				cl: list(generateAWSInventoryConfig()),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryLateInit(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return &s3.ListBucketInventoryConfigurationsOutput{
+							InventoryConfigurationList: []s3types.InventoryConfiguration{generateAWSInventoryConfig()},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
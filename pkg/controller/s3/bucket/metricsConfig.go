@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	metricsListFailed   = "cannot list Bucket metrics configurations"
+	metricsPutFailed    = "cannot put Bucket metrics configuration"
+	metricsDeleteFailed = "cannot delete Bucket metrics configuration"
+)
+
+// MetricsConfigurationClient is the client for API methods and reconciling the MetricsConfigurations
+type MetricsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewMetricsConfigurationClient creates the client for Metrics Configurations
+func NewMetricsConfigurationClient(client s3.BucketClient) *MetricsConfigurationClient {
+	return &MetricsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *MetricsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := bucket.Spec.ForProvider.MetricsConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0:
+		return NeedsDeletion, nil
+	}
+	if put, remove := DiffMetricsConfigurations(local, external); len(put) != 0 || len(remove) != 0 {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// CreateOrUpdate puts the configurations that are missing or different and
+// deletes the ones that are not in the spec.
+func (in *MetricsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := DiffMetricsConfigurations(bucket.Spec.ForProvider.MetricsConfigurations, external)
+	for i := range put {
+		_, err := in.client.PutBucketMetricsConfiguration(ctx, &awss3.PutBucketMetricsConfigurationInput{
+			Bucket:               awsclient.String(meta.GetExternalName(bucket)),
+			Id:                   put[i].Id,
+			MetricsConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, metricsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all Metrics configurations of the bucket.
+func (in *MetricsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *MetricsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.MetricsConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.MetricsConfigurations = GenerateLocalMetricsConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *MetricsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.MetricsConfigurations) != 0
+}
+
+func (in *MetricsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.MetricsConfiguration, error) {
+	var result []types.MetricsConfiguration
+	input := &awss3.ListBucketMetricsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketMetricsConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, metricsListFailed)
+		}
+		result = append(result, out.MetricsConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *MetricsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketMetricsConfiguration(ctx, &awss3.DeleteBucketMetricsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, metricsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// DiffMetricsConfigurations returns the configurations that need
+// to be put and the IDs of the configurations that need to be deleted for the
+// external configurations to match the local ones.
+func DiffMetricsConfigurations(local []v1beta1.MetricsConfiguration, external []types.MetricsConfiguration) ([]types.MetricsConfiguration, []string) {
+	current := make(map[string]types.MetricsConfiguration, len(external))
+	for _, c := range external {
+		current[awsclient.StringValue(c.Id)] = c
+	}
+	var put []types.MetricsConfiguration
+	for _, l := range local {
+		desired := GenerateMetricsConfiguration(l)
+		c, ok := current[l.ID]
+		if !ok || !cmp.Equal(desired, c, equateConfigurations...) {
+			put = append(put, desired)
+		}
+		delete(current, l.ID)
+	}
+	remove := make([]string, 0, len(current))
+	for id := range current {
+		remove = append(remove, id)
+	}
+	sort.Strings(remove)
+	return put, remove
+}
+
+// GenerateMetricsConfiguration creates the MetricsConfiguration for the AWS SDK
+func GenerateMetricsConfiguration(local v1beta1.MetricsConfiguration) types.MetricsConfiguration {
+	out := types.MetricsConfiguration{Id: awsclient.String(local.ID)}
+	if local.Filter != nil {
+		switch {
+		case local.Filter.And != nil:
+			out.Filter = &types.MetricsFilterMemberAnd{Value: types.MetricsAndOperator{
+				AccessPointArn: local.Filter.And.AccessPointARN,
+				Prefix:         local.Filter.And.Prefix,
+				Tags:           s3.CopyTags(local.Filter.And.Tags),
+			}}
+		case local.Filter.Tag != nil:
+			out.Filter = &types.MetricsFilterMemberTag{Value: types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}}
+		case local.Filter.AccessPointARN != nil:
+			out.Filter = &types.MetricsFilterMemberAccessPointArn{Value: awsclient.StringValue(local.Filter.AccessPointARN)}
+		case local.Filter.Prefix != nil:
+			out.Filter = &types.MetricsFilterMemberPrefix{Value: awsclient.StringValue(local.Filter.Prefix)}
+		}
+	}
+	return out
+}
+
+// GenerateLocalMetricsConfigurations creates the local MetricsConfigurations
+// from the ones returned by AWS.
+func GenerateLocalMetricsConfigurations(external []types.MetricsConfiguration) []v1beta1.MetricsConfiguration {
+	if len(external) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.MetricsConfiguration, len(external))
+	for i, e := range external {
+		result[i] = v1beta1.MetricsConfiguration{ID: awsclient.StringValue(e.Id)}
+		switch f := e.Filter.(type) {
+		case *types.MetricsFilterMemberAnd:
+			result[i].Filter = &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{
+				AccessPointARN: f.Value.AccessPointArn,
+				Prefix:         f.Value.Prefix,
+				Tags:           s3.CopyAWSTags(f.Value.Tags),
+			}}
+		case *types.MetricsFilterMemberTag:
+			result[i].Filter = &v1beta1.MetricsFilter{Tag: &v1beta1.Tag{Key: awsclient.StringValue(f.Value.Key), Value: awsclient.StringValue(f.Value.Value)}}
+		case *types.MetricsFilterMemberAccessPointArn:
+			result[i].Filter = &v1beta1.MetricsFilter{AccessPointARN: awsclient.String(f.Value)}
+		case *types.MetricsFilterMemberPrefix:
+			result[i].Filter = &v1beta1.MetricsFilter{Prefix: awsclient.String(f.Value)}
+		}
+	}
+	return result
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &MetricsConfigurationClient{}
+
+func generateMetricsConfig() v1beta1.MetricsConfiguration {
+	return v1beta1.MetricsConfiguration{
+		ID: "metrics",
+		Filter: &v1beta1.MetricsFilter{
+			And: &v1beta1.MetricsAndOperator{
+				Prefix: awsclient.String("data/"),
+				Tags:   []v1beta1.Tag{{Key: "team", Value: "storage"}},
+			},
+		},
+	}
+}
+
+func generateAWSMetricsConfig() s3types.MetricsConfiguration {
+	return s3types.MetricsConfiguration{
+		Id: awsclient.String("metrics"),
+		Filter: &s3types.MetricsFilterMemberAnd{Value: s3types.MetricsAndOperator{
+			Prefix: awsclient.String("data/"),
+			Tags:   []s3types.Tag{{Key: awsclient.String("team"), Value: awsclient.String("storage")}},
+		}},
+	}
+}
+
+func TestMetricsObserve(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	list := func(external ...s3types.MetricsConfiguration) *MetricsConfigurationClient {
+		return NewMetricsConfigurationClient(fake.MockBucketClient{
+			MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+				return &s3.ListBucketMetricsConfigurationsOutput{MetricsConfigurationList: external}, nil
+			},
+		})
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, metricsListFailed),
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: list(generateAWSMetricsConfig()),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithMetricsConfigs(generateMetricsConfig())),
+				cl: list(s3types.MetricsConfiguration{Id: awsclient.String("metrics")}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeeded": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithMetricsConfigs(generateMetricsConfig())),
+				cl: list(generateAWSMetricsConfig()),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsLateInit(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return &s3.ListBucketMetricsConfigurationsOutput{
+							MetricsConfigurationList: []s3types.MetricsConfiguration{generateAWSMetricsConfig()},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithMetricsConfigs(generateMetricsConfig())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
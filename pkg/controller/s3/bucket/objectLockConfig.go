@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	objectLockGetFailed = "cannot get Bucket object lock configuration"
+	objectLockPutFailed = "cannot put Bucket object lock configuration"
+)
+
+// ObjectLockConfigurationClient is the client for API methods and reconciling the ObjectLockConfiguration
+type ObjectLockConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewObjectLockConfigurationClient creates the client for Object Lock Configuration
+func NewObjectLockConfigurationClient(client s3.BucketClient) *ObjectLockConfigurationClient {
+	return &ObjectLockConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *ObjectLockConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	config := bucket.Spec.ForProvider.ObjectLockConfiguration
+	if config == nil {
+		return Updated, nil
+	}
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(resource.Ignore(s3.ObjectLockConfigurationNotFound, err), objectLockGetFailed)
+	}
+	if cmp.Equal(GenerateObjectLockConfiguration(config), external.ObjectLockConfiguration, cmpopts.IgnoreTypes(document.NoSerde{})) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *ObjectLockConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return nil
+	}
+	input := &awss3.PutObjectLockConfigurationInput{
+		Bucket:                  awsclient.String(meta.GetExternalName(bucket)),
+		ObjectLockConfiguration: GenerateObjectLockConfiguration(bucket.Spec.ForProvider.ObjectLockConfiguration),
+	}
+	_, err := in.client.PutObjectLockConfiguration(ctx, input)
+	return awsclient.Wrap(err, objectLockPutFailed)
+}
+
+// Delete does not do anything since Object Lock cannot be disabled once it is
+// enabled for a bucket.
+func (*ObjectLockConfigurationClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value.
+// The default retention rule is initialized only together with the whole
+// configuration so that the user can remove it later.
+func (in *ObjectLockConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.ObjectLockConfigurationNotFound, err), objectLockGetFailed)
+	}
+	if external.ObjectLockConfiguration == nil || external.ObjectLockConfiguration.ObjectLockEnabled == "" {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.ObjectLockConfiguration == nil {
+		fp.ObjectLockConfiguration = &v1beta1.ObjectLockConfiguration{}
+		if r := external.ObjectLockConfiguration.Rule; r != nil && r.DefaultRetention != nil {
+			fp.ObjectLockConfiguration.Rule = &v1beta1.ObjectLockRule{
+				DefaultRetention: v1beta1.DefaultRetention{
+					Days:  nonZeroInt32(r.DefaultRetention.Days),
+					Mode:  string(r.DefaultRetention.Mode),
+					Years: nonZeroInt32(r.DefaultRetention.Years),
+				},
+			}
+		}
+	}
+	fp.ObjectLockConfiguration.ObjectLockEnabled = awsclient.LateInitializeStringPtr(fp.ObjectLockConfiguration.ObjectLockEnabled,
+		awsclient.String(string(external.ObjectLockConfiguration.ObjectLockEnabled)))
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *ObjectLockConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectLockConfiguration != nil
+}
+
+// GenerateObjectLockConfiguration creates the ObjectLockConfiguration for the AWS SDK.
+// Object Lock is always enabled because AWS does not accept a configuration
+// that disables it.
+func GenerateObjectLockConfiguration(config *v1beta1.ObjectLockConfiguration) *types.ObjectLockConfiguration {
+	if config == nil {
+		return nil
+	}
+	out := &types.ObjectLockConfiguration{ObjectLockEnabled: types.ObjectLockEnabledEnabled}
+	if config.Rule != nil {
+		out.Rule = &types.ObjectLockRule{
+			DefaultRetention: &types.DefaultRetention{
+				Days:  aws.ToInt32(config.Rule.DefaultRetention.Days),
+				Mode:  types.ObjectLockRetentionMode(config.Rule.DefaultRetention.Mode),
+				Years: aws.ToInt32(config.Rule.DefaultRetention.Years),
+			},
+		}
+	}
+	return out
+}
+
+func nonZeroInt32(v int32) *int32 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
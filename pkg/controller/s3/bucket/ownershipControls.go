@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	ownershipControlsGetFailed    = "cannot get Bucket ownership controls"
+	ownershipControlsPutFailed    = "cannot put Bucket ownership controls"
+	ownershipControlsDeleteFailed = "cannot delete Bucket ownership controls"
+)
+
+// OwnershipControlsClient is the client for API methods and reconciling the OwnershipControls
+type OwnershipControlsClient struct {
+	client s3.BucketClient
+}
+
+// NewOwnershipControlsClient creates the client for Ownership Controls
+func NewOwnershipControlsClient(client s3.BucketClient) *OwnershipControlsClient {
+	return &OwnershipControlsClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *OwnershipControlsClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.client.GetBucketOwnershipControls(ctx, &awss3.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if s3.OwnershipControlsNotFound(err) && bucket.Spec.ForProvider.OwnershipControls == nil {
+		return Updated, nil
+	}
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsGetFailed)
+	}
+	if bucket.Spec.ForProvider.OwnershipControls == nil ||
+		cmp.Equal(GenerateOwnershipControls(bucket.Spec.ForProvider.OwnershipControls), external.OwnershipControls,
+			cmpopts.IgnoreTypes(document.NoSerde{}), cmpopts.EquateEmpty()) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *OwnershipControlsClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.OwnershipControls == nil {
+		return nil
+	}
+	input := &awss3.PutBucketOwnershipControlsInput{
+		Bucket:            awsclient.String(meta.GetExternalName(bucket)),
+		OwnershipControls: GenerateOwnershipControls(bucket.Spec.ForProvider.OwnershipControls),
+	}
+	_, err := in.client.PutBucketOwnershipControls(ctx, input)
+	return awsclient.Wrap(err, ownershipControlsPutFailed)
+}
+
+// Delete removes the ownership controls.
+func (in *OwnershipControlsClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	input := &awss3.DeleteBucketOwnershipControlsInput{
+		Bucket: awsclient.String(meta.GetExternalName(bucket)),
+	}
+	_, err := in.client.DeleteBucketOwnershipControls(ctx, input)
+	return errors.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsDeleteFailed)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *OwnershipControlsClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetBucketOwnershipControls(ctx, &awss3.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsGetFailed)
+	}
+	if external.OwnershipControls == nil || len(external.OwnershipControls.Rules) == 0 {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.OwnershipControls == nil {
+		fp.OwnershipControls = &v1beta1.OwnershipControls{}
+	}
+	if len(fp.OwnershipControls.Rules) == 0 {
+		fp.OwnershipControls.Rules = make([]v1beta1.OwnershipControlsRule, len(external.OwnershipControls.Rules))
+		for i, r := range external.OwnershipControls.Rules {
+			fp.OwnershipControls.Rules[i] = v1beta1.OwnershipControlsRule{ObjectOwnership: string(r.ObjectOwnership)}
+		}
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *OwnershipControlsClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.OwnershipControls != nil
+}
+
+// GenerateOwnershipControls creates the OwnershipControls for the AWS SDK
+func GenerateOwnershipControls(config *v1beta1.OwnershipControls) *types.OwnershipControls {
+	if config == nil {
+		return nil
+	}
+	out := &types.OwnershipControls{Rules: make([]types.OwnershipControlsRule, len(config.Rules))}
+	for i, r := range config.Rules {
+		out.Rules[i] = types.OwnershipControlsRule{ObjectOwnership: types.ObjectOwnership(r.ObjectOwnership)}
+	}
+	return out
+}
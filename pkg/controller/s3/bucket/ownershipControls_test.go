@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clientss3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &OwnershipControlsClient{}
+
+const bucketOwnerEnforced = "BucketOwnerEnforced"
+
+func generateOwnershipControls(ownership string) *v1beta1.OwnershipControls {
+	return &v1beta1.OwnershipControls{Rules: []v1beta1.OwnershipControlsRule{{ObjectOwnership: ownership}}}
+}
+
+func generateAWSOwnershipControls(ownership string) *s3types.OwnershipControls {
+	return &s3types.OwnershipControls{Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnership(ownership)}}}
+}
+
+func TestOwnershipControlsObserve(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, ownershipControlsGetFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clientss3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NotFoundNeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(bucketOwnerEnforced))),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clientss3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(bucketOwnerEnforced))),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: generateAWSOwnershipControls(string(s3types.ObjectOwnershipObjectWriter))}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeeded": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(bucketOwnerEnforced))),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: generateAWSOwnershipControls(bucketOwnerEnforced)}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Skip": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{}),
+			},
+		},
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(bucketOwnerEnforced))),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockPutBucketOwnershipControls: func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipControlsPutFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(bucketOwnerEnforced))),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockPutBucketOwnershipControls: func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+						return &s3.PutBucketOwnershipControlsOutput{}, nil
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsDelete(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockDeleteBucketOwnershipControls: func(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipControlsDeleteFailed),
+			},
+		},
+		"GoneAlready": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockDeleteBucketOwnershipControls: func(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clientss3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsLateInit(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipControlsGetFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: generateAWSOwnershipControls(bucketOwnerEnforced)}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(bucketOwnerEnforced))),
+			},
+		},
+		"NoOpLateInit": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(string(s3types.ObjectOwnershipObjectWriter)))),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: generateAWSOwnershipControls(bucketOwnerEnforced)}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithOwnershipControls(generateOwnershipControls(string(s3types.ObjectOwnershipObjectWriter)))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,12 @@ package bucket
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
 )
@@ -49,9 +55,24 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		NewTaggingConfigurationClient(client),
 		NewWebsiteConfigurationClient(client),
 		NewPublicAccessBlockClient(client),
+		NewObjectLockConfigurationClient(client),
+		NewOwnershipControlsClient(client),
+		NewIntelligentTieringConfigurationClient(client),
+		NewInventoryConfigurationClient(client),
+		NewAnalyticsConfigurationClient(client),
+		NewMetricsConfigurationClient(client),
 	}
 }
 
+// equateConfigurations are the options to compare the configurations that are
+// generated from the spec with the ones returned by AWS, which doesn't keep
+// the order of tags.
+var equateConfigurations = []cmp.Option{
+	cmpopts.IgnoreTypes(document.NoSerde{}),
+	cmpopts.EquateEmpty(),
+	cmpopts.SortSlices(func(a, b types.Tag) bool { return aws.ToString(a.Key) < aws.ToString(b.Key) }),
+}
+
 // ResourceStatus represents the current status  if the resource resource is updated.
 type ResourceStatus int
 
@@ -139,6 +139,40 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{},
 			},
 		},
+		"ACLDisabledSkipsUpdateACL": {
+			args: args{
+				s3: s3Testing.Client(
+					s3Testing.WithPutACL(func(ctx context.Context, input *awss3.PutBucketAclInput, opts []func(*awss3.Options)) (*awss3.PutBucketAclOutput, error) {
+						return nil, errBoom
+					}),
+					s3Testing.WithGetOwnershipControls(func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error) {
+						return &awss3.GetBucketOwnershipControlsOutput{OwnershipControls: &awss3types.OwnershipControls{
+							Rules: []awss3types.OwnershipControlsRule{{ObjectOwnership: clients3.ObjectOwnershipBucketOwnerEnforced}},
+						}}, nil
+					}),
+				),
+				cr: s3Testing.Bucket(s3Testing.WithOwnershipControls(&v1beta1.OwnershipControls{
+					Rules: []v1beta1.OwnershipControlsRule{{ObjectOwnership: "BucketOwnerEnforced"}},
+				})),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					s3Testing.WithConditions(xpv1.Available()),
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+					s3Testing.WithOwnershipControls(&v1beta1.OwnershipControls{
+						Rules: []v1beta1.OwnershipControlsRule{{ObjectOwnership: "BucketOwnerEnforced"}},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: map[string][]byte{
+						xpv1.ResourceCredentialsSecretEndpointKey:  []byte(s3Testing.BucketName),
+						v1beta1.ResourceCredentialsSecretRegionKey: []byte(s3Testing.Region),
+					},
+				},
+			},
+		},
 		"LateInitialize": {
 			args: args{
 				s3: s3Testing.Client(
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a BucketObject resource"
+	errHead             = "failed to get the object"
+	errGetTagging       = "failed to get the tags of the object"
+	errPut              = "failed to upload the object"
+	errDelete           = "failed to delete the object"
+	errNoContent        = "exactly one of content, contentSecretRef and contentConfigMapRef must be specified"
+	errGetSecret        = "cannot get the content secret"
+	errGetConfigMap     = "cannot get the content config map"
+	errFmtNoKey         = "%s key does not exist in %s"
+)
+
+// SetupBucketObject adds a controller that reconciles BucketObjects.
+func SetupBucketObject(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha3.BucketObjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha3.BucketObject{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.BucketObjectGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewBucketObjectClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewDefaultTagger(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.BucketObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.BucketObject)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.BucketObjectClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.BucketObject)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	o, err := e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3.IsNotFound, err), errHead)
+	}
+	content, err := e.getContent(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	t, err := e.client.GetObjectTagging(ctx, &awss3.GetObjectTaggingInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetTagging)
+	}
+
+	cr.Status.AtProvider = s3.GenerateBucketObjectObservation(o)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3.IsBucketObjectUpToDate(cr.Spec.ForProvider, content, o, t.TagSet),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.BucketObject)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+// Update uploads the object again since neither its content nor its
+// encryption can be changed in place.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.BucketObject)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.BucketObject)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsErrorBucketNotFound, err), errDelete)
+}
+
+func (e *external) put(ctx context.Context, cr *v1alpha3.BucketObject) error {
+	content, err := e.getContent(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	o, err := e.client.PutObject(ctx, s3.GeneratePutObjectInput(cr.Spec.ForProvider, content))
+	if err != nil {
+		return awsclient.Wrap(err, errPut)
+	}
+	cr.Status.AtProvider.ETag = strings.Trim(aws.ToString(o.ETag), `"`)
+	cr.Status.AtProvider.VersionID = aws.ToString(o.VersionId)
+	return nil
+}
+
+// getContent returns the content of the object from the source given in the
+// parameters.
+func (e *external) getContent(ctx context.Context, p v1alpha3.BucketObjectParameters) ([]byte, error) {
+	switch {
+	case p.Content != nil && p.ContentSecretRef == nil && p.ContentConfigMapRef == nil:
+		return []byte(*p.Content), nil
+	case p.ContentSecretRef != nil && p.Content == nil && p.ContentConfigMapRef == nil:
+		ref := p.ContentSecretRef
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.Errorf(errFmtNoKey, ref.Key, "secret")
+		}
+		return v, nil
+	case p.ContentConfigMapRef != nil && p.Content == nil && p.ContentSecretRef == nil:
+		ref := p.ContentConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return []byte(v), nil
+		}
+		if v, ok := cm.BinaryData[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf(errFmtNoKey, ref.Key, "config map")
+	}
+	return nil, errors.New(errNoContent)
+}
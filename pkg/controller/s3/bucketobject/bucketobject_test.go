@@ -0,0 +1,464 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	bucketName     = "test.s3.crossplane.com"
+	key            = "config/app.json"
+	content        = `{"a": "b"}`
+	eTag           = `"` + s3.ContentMD5([]byte(content)) + `"`
+	versionID      = "v1"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.BucketObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type bucketObjectModifier func(*v1alpha3.BucketObject)
+
+func withConditions(c ...xpv1.Condition) bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withContent(s string) bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) { r.Spec.ForProvider.Content = &s }
+}
+
+func withContentSecretRef(name, namespace, key string) bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) {
+		r.Spec.ForProvider.ContentSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: name, Namespace: namespace},
+			Key:             key,
+		}
+	}
+}
+
+func withContentConfigMapRef(name, namespace, key string) bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) {
+		r.Spec.ForProvider.ContentConfigMapRef = &v1alpha3.ConfigMapKeySelector{Name: name, Namespace: namespace, Key: key}
+	}
+}
+
+func withObservation(o v1alpha3.BucketObjectObservation) bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) { r.Status.AtProvider = o }
+}
+
+func bucketObject(m ...bucketObjectModifier) *v1alpha3.BucketObject {
+	cr := &v1alpha3.BucketObject{
+		Spec: v1alpha3.BucketObjectSpec{
+			ForProvider: v1alpha3.BucketObjectParameters{
+				BucketName: &bucketName,
+				Key:        key,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func headOutput(sha string) *awss3.HeadObjectOutput {
+	return &awss3.HeadObjectOutput{
+		ETag:      aws.String(eTag),
+		VersionId: aws.String(versionID),
+		Metadata:  map[string]string{s3.ContentSHA256MetadataKey: sha},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(s3.ContentSHA256([]byte(content))), nil
+					},
+					MockGetObjectTagging: func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+						return &awss3.GetObjectTaggingOutput{}, nil
+					},
+				},
+				cr: bucketObject(withContent(content)),
+			},
+			want: want{
+				cr: bucketObject(withContent(content),
+					withObservation(v1alpha3.BucketObjectObservation{ETag: s3.ContentMD5([]byte(content)), VersionID: versionID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecretContentChanged": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(s3.ContentSHA256([]byte(content))), nil
+					},
+					MockGetObjectTagging: func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+						return &awss3.GetObjectTaggingOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						s := obj.(*corev1.Secret)
+						s.Data = map[string][]byte{"content": []byte("new")}
+						return nil
+					},
+				},
+				cr: bucketObject(withContentSecretRef("s", "ns", "content")),
+			},
+			want: want{
+				cr: bucketObject(withContentSecretRef("s", "ns", "content"),
+					withObservation(v1alpha3.BucketObjectObservation{ETag: s3.ContentMD5([]byte(content)), VersionID: versionID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &s3types.NotFound{}
+					},
+				},
+				cr: bucketObject(withContent(content)),
+			},
+			want: want{
+				cr: bucketObject(withContent(content)),
+			},
+		},
+		"HeadError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bucketObject(withContent(content)),
+			},
+			want: want{
+				cr:  bucketObject(withContent(content)),
+				err: awsclient.Wrap(errBoom, errHead),
+			},
+		},
+		"NoContent": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(""), nil
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr:  bucketObject(),
+				err: errors.New(errNoContent),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ConfigMapBinaryData": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						if diff := cmp.Diff(s3.ContentSHA256([]byte(content)), input.Metadata[s3.ContentSHA256MetadataKey]); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awss3.PutObjectOutput{ETag: aws.String(eTag), VersionId: aws.String(versionID)}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						cm := obj.(*corev1.ConfigMap)
+						cm.BinaryData = map[string][]byte{"content": []byte(content)}
+						return nil
+					},
+				},
+				cr: bucketObject(withContentConfigMapRef("cm", "ns", "content")),
+			},
+			want: want{
+				cr: bucketObject(withContentConfigMapRef("cm", "ns", "content"),
+					withObservation(v1alpha3.BucketObjectObservation{ETag: s3.ContentMD5([]byte(content)), VersionID: versionID}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"MissingConfigMapKey": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: bucketObject(withContentConfigMapRef("cm", "ns", "content")),
+			},
+			want: want{
+				cr: bucketObject(withContentConfigMapRef("cm", "ns", "content"),
+					withConditions(xpv1.Creating())),
+				err: errors.Errorf(errFmtNoKey, "content", "config map"),
+			},
+		},
+		"SecretGetError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: bucketObject(withContentSecretRef("s", "ns", "content")),
+			},
+			want: want{
+				cr: bucketObject(withContentSecretRef("s", "ns", "content"),
+					withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"PutError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bucketObject(withContent(content)),
+			},
+			want: want{
+				cr:  bucketObject(withContent(content), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Success": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return &awss3.PutObjectOutput{ETag: aws.String(eTag)}, nil
+					},
+				},
+				cr: bucketObject(withContent(content)),
+			},
+			want: want{
+				cr: bucketObject(withContent(content),
+					withObservation(v1alpha3.BucketObjectObservation{ETag: s3.ContentMD5([]byte(content))})),
+			},
+		},
+		"PutError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bucketObject(withContent(content)),
+			},
+			want: want{
+				cr:  bucketObject(withContent(content)),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Success": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return &awss3.DeleteObjectOutput{}, nil
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(withConditions(xpv1.Deleting())),
+			},
+		},
+		"BucketNotFound": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, &s3types.NoSuchBucket{}
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr:  bucketObject(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,7 +23,6 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -37,8 +36,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	awspolicy "github.com/crossplane/provider-aws/pkg/clients/policy"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
 )
 
@@ -48,7 +49,8 @@ const (
 	errDelete           = "failed to delete the policy for bucket"
 	errGet              = "failed to get BucketPolicy for bucket with name"
 	errUpdate           = "failed to update the policy for bucket"
-	errNotSpecified     = "failed to format bucketPolicy, no rawPolicy or policy specified"
+	errNotSpecified     = "failed to format bucketPolicy, no rawPolicy, policy or policyDocument specified"
+	errBothSpecified    = "failed to format bucketPolicy, policy and policyDocument cannot be specified together"
 )
 
 // SetupBucketPolicy adds a controller that reconciles
@@ -66,12 +68,20 @@ func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 			resource.ManagedKind(v1alpha3.BucketPolicyGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(),
 				newClientFn: s3.NewBucketPolicyClient}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithReferenceResolver(awspolicy.NewReferenceResolver(mgr.GetClient(), policyDocuments)),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+func policyDocuments(mg resource.Managed) []*commonv1alpha1.PolicyDocument {
+	cr, ok := mg.(*v1alpha3.BucketPolicy)
+	if !ok {
+		return nil
+	}
+	return []*commonv1alpha1.PolicyDocument{cr.Spec.Parameters.PolicyDocument}
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) s3.BucketPolicyClient
@@ -121,7 +131,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// If our version and the external version are the same, we return ResourceUpToDate: true
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(*policyData, *resp.Policy),
+		ResourceUpToDate: awspolicy.AreEquivalent(*policyData, *resp.Policy),
 	}, nil
 }
 
@@ -131,6 +141,11 @@ func (e *external) formatBucketPolicy(original *v1alpha3.BucketPolicy) (*string,
 		return nil, errors.New(errNotSpecified)
 	}
 	switch {
+	case original.Spec.Parameters.PolicyDocument != nil && original.Spec.Parameters.Policy != nil:
+		return nil, errors.New(errBothSpecified)
+	case original.Spec.Parameters.PolicyDocument != nil:
+		str := awspolicy.Marshal(original.Spec.Parameters.PolicyDocument)
+		return &str, nil
 	case original.Spec.Parameters.RawPolicy != nil:
 		return original.Spec.Parameters.RawPolicy, nil
 	case original.Spec.Parameters.Policy != nil:
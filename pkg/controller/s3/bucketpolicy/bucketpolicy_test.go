@@ -30,6 +30,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	commonv1alpha1 "github.com/crossplane/provider-aws/apis/common/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
@@ -415,6 +416,36 @@ func TestFormat(t *testing.T) {
 				str: policy,
 			},
 		},
+		"PolicyDocument": {
+			args: formatarg{
+				cr: bucketPolicy(withPolicy(&v1alpha3.BucketPolicyParameters{
+					RawPolicy: awsclient.String(`{"Version":"2012-10-17"}`),
+					PolicyDocument: &commonv1alpha1.PolicyDocument{
+						Version: "2012-10-17",
+						Statements: []commonv1alpha1.PolicyStatement{{
+							Effect:    "Allow",
+							Principal: &commonv1alpha1.PolicyPrincipal{AllowAnon: true},
+							Action:    []string{"s3:ListBucket"},
+							Resource:  []string{"arn:aws:s3:::test.s3.crossplane.com"},
+						}},
+					},
+				})),
+			},
+			want: want{
+				str: policy,
+			},
+		},
+		"PolicyAndPolicyDocument": {
+			args: formatarg{
+				cr: bucketPolicy(withPolicy(&v1alpha3.BucketPolicyParameters{
+					Policy:         params.Policy,
+					PolicyDocument: &commonv1alpha1.PolicyDocument{Version: "2012-10-17"},
+				})),
+			},
+			want: want{
+				err: errors.New(errBothSpecified),
+			},
+		},
 		"NoPolicy": {
 			args: formatarg{
 				cr: bucketPolicy(withPolicy(&v1alpha3.BucketPolicyParameters{})),
@@ -66,6 +66,24 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 		MockDeletePublicAccessBlock: func(ctx context.Context, input *awss3.DeletePublicAccessBlockInput, opts []func(*awss3.Options)) (*awss3.DeletePublicAccessBlockOutput, error) {
 			return &awss3.DeletePublicAccessBlockOutput{}, nil
 		},
+		MockGetObjectLockConfiguration: func(ctx context.Context, input *awss3.GetObjectLockConfigurationInput, opts []func(*awss3.Options)) (*awss3.GetObjectLockConfigurationOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+		},
+		MockGetBucketOwnershipControls: func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+		},
+		MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *awss3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+			return &awss3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+		},
+		MockListBucketInventoryConfigurations: func(ctx context.Context, input *awss3.ListBucketInventoryConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketInventoryConfigurationsOutput, error) {
+			return &awss3.ListBucketInventoryConfigurationsOutput{}, nil
+		},
+		MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *awss3.ListBucketAnalyticsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketAnalyticsConfigurationsOutput, error) {
+			return &awss3.ListBucketAnalyticsConfigurationsOutput{}, nil
+		},
+		MockListBucketMetricsConfigurations: func(ctx context.Context, input *awss3.ListBucketMetricsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketMetricsConfigurationsOutput, error) {
+			return &awss3.ListBucketMetricsConfigurationsOutput{}, nil
+		},
 	}
 	for _, v := range m {
 		v(client)
@@ -117,3 +135,10 @@ func WithPutACL(input func(ctx context.Context, input *awss3.PutBucketAclInput,
 		client.MockPutBucketAcl = input
 	}
 }
+
+// WithGetOwnershipControls sets the MockGetBucketOwnershipControls of the mock S3 Client
+func WithGetOwnershipControls(input func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error)) ClientModifier {
+	return func(client *fake.MockBucketClient) {
+		client.MockGetBucketOwnershipControls = input
+	}
+}
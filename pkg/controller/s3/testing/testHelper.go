@@ -108,6 +108,36 @@ func WithNotificationConfig(s *v1beta1.NotificationConfiguration) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.NotificationConfiguration = s }
 }
 
+// WithOwnershipControls sets the OwnershipControls for an S3 Bucket
+func WithOwnershipControls(s *v1beta1.OwnershipControls) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.OwnershipControls = s }
+}
+
+// WithObjectLockConfig sets the ObjectLockConfiguration for an S3 Bucket
+func WithObjectLockConfig(s *v1beta1.ObjectLockConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ObjectLockConfiguration = s }
+}
+
+// WithIntelligentTieringConfigs sets the IntelligentTieringConfigurations for an S3 Bucket
+func WithIntelligentTieringConfigs(s ...v1beta1.IntelligentTieringConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.IntelligentTieringConfigurations = s }
+}
+
+// WithInventoryConfigs sets the InventoryConfigurations for an S3 Bucket
+func WithInventoryConfigs(s ...v1beta1.InventoryConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.InventoryConfigurations = s }
+}
+
+// WithAnalyticsConfigs sets the AnalyticsConfigurations for an S3 Bucket
+func WithAnalyticsConfigs(s ...v1beta1.AnalyticsConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.AnalyticsConfigurations = s }
+}
+
+// WithMetricsConfigs sets the MetricsConfigurations for an S3 Bucket
+func WithMetricsConfigs(s ...v1beta1.MetricsConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.MetricsConfigurations = s }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{
@@ -18,9 +18,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"sort"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/pkg/errors"
@@ -56,6 +59,12 @@ const (
 	errDeleteResourcePolicy = "cannot delete resource policy"
 	errInvalidSpecPolicy    = "spec policy is invalid"
 	errInvalidCurrentPolicy = "current policy is invalid"
+	errRotateSecret         = "cannot rotate the secret"
+	errCancelRotateSecret   = "cannot cancel the rotation of the secret"
+	errRotateImmediately    = "cannot disable the immediate rotation of the secret"
+	errExplodeJSON          = "cannot explode the value of the secret since it is not a JSON object"
+
+	defaultReverseSyncKey = "value"
 )
 
 // SetupSecret adds a controller that reconciles a Secret.
@@ -64,8 +73,8 @@ func SetupSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
-			e.postObserve = postObserve
 			h := &hooks{client: e.client, kube: e.kube}
+			e.postObserve = h.postObserve
 			e.isUpToDate = h.isUpToDate
 			e.preUpdate = h.preUpdate
 			e.preCreate = h.preCreate
@@ -83,7 +92,7 @@ func SetupSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 			resource.ManagedKind(svcapitypes.SecretGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}, awsclients.NewDefaultTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -94,7 +103,12 @@ func preObserve(_ context.Context, cr *svcapitypes.Secret, obj *svcsdk.DescribeS
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+type hooks struct {
+	client secretsmanageriface.SecretsManagerAPI
+	kube   client.Client
+}
+
+func (e *hooks) postObserve(ctx context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -105,12 +119,135 @@ func postObserve(_ context.Context, cr *svcapitypes.Secret, resp *svcsdk.Describ
 		return obs, nil
 	}
 	cr.SetConditions(xpv1.Available())
-	return obs, nil
+	if cr.Spec.ForProvider.ReverseSync == nil {
+		return obs, nil
+	}
+	// The secret may not have a value yet if it is created without one and
+	// waits for the first rotation.
+	s, err := e.client.GetSecretValueWithContext(ctx, &svcsdk.GetSecretValueInput{
+		SecretId: awsclients.String(meta.GetExternalName(cr)),
+	})
+	if IsNotFound(err) {
+		return obs, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errGetSecretValue)
+	}
+	obs.ConnectionDetails, err = getConnectionDetails(*cr.Spec.ForProvider.ReverseSync, s)
+	return obs, err
 }
 
-type hooks struct {
-	client secretsmanageriface.SecretsManagerAPI
-	kube   client.Client
+// getConnectionDetails returns the connection details that contain the value
+// of the secret as configured in the given ReverseSync.
+func getConnectionDetails(rs svcapitypes.ReverseSync, s *svcsdk.GetSecretValueOutput) (managed.ConnectionDetails, error) {
+	value := s.SecretBinary
+	if s.SecretString != nil {
+		value = []byte(awsclients.StringValue(s.SecretString))
+	}
+	if !rs.ExplodeJSON {
+		key := rs.Key
+		if key == "" {
+			key = defaultReverseSyncKey
+		}
+		return managed.ConnectionDetails{key: value}, nil
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(value, &fields); err != nil {
+		return nil, errors.Wrap(err, errExplodeJSON)
+	}
+	conn := make(managed.ConnectionDetails, len(fields))
+	for k, v := range fields {
+		if str, ok := v.(string); ok {
+			conn[k] = []byte(str)
+			continue
+		}
+		// Non-string fields are kept in their JSON representation.
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrap(err, errExplodeJSON)
+		}
+		conn[k] = b
+	}
+	return conn, nil
+}
+
+// isRotationUpToDate returns true if the rotation of the secret is cancelled
+// when RotationEnabled is false, or enabled with the desired Lambda function
+// and rules otherwise. The rotation is not managed if neither RotationEnabled
+// nor RotationLambdaARN is given.
+func isRotationUpToDate(p svcapitypes.SecretParameters, resp *svcsdk.DescribeSecretOutput) bool {
+	enabled := awsclients.BoolValue(resp.RotationEnabled)
+	switch {
+	case p.RotationEnabled != nil && !*p.RotationEnabled:
+		return !enabled
+	case p.RotationEnabled == nil && p.RotationLambdaARN == nil:
+		return true
+	case !enabled:
+		return false
+	case p.RotationLambdaARN != nil && awsclients.StringValue(p.RotationLambdaARN) != awsclients.StringValue(resp.RotationLambdaARN):
+		return false
+	case p.RotationRules == nil:
+		return true
+	}
+	return resp.RotationRules != nil && awsclients.Int64Value(resp.RotationRules.AutomaticallyAfterDays) == p.RotationRules.AutomaticallyAfterDays
+}
+
+func (e *hooks) updateRotation(ctx context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput) error {
+	if isRotationUpToDate(cr.Spec.ForProvider, resp) {
+		return nil
+	}
+	if cr.Spec.ForProvider.RotationEnabled != nil && !*cr.Spec.ForProvider.RotationEnabled {
+		_, err := e.client.CancelRotateSecretWithContext(ctx, &svcsdk.CancelRotateSecretInput{
+			SecretId: awsclients.String(meta.GetExternalName(cr)),
+		})
+		return awsclients.Wrap(err, errCancelRotateSecret)
+	}
+	in := &svcsdk.RotateSecretInput{
+		SecretId:          awsclients.String(meta.GetExternalName(cr)),
+		RotationLambdaARN: cr.Spec.ForProvider.RotationLambdaARN,
+	}
+	if cr.Spec.ForProvider.RotationRules != nil {
+		in.RotationRules = &svcsdk.RotationRulesType{
+			AutomaticallyAfterDays: awsclients.Int64(int(cr.Spec.ForProvider.RotationRules.AutomaticallyAfterDays)),
+		}
+	}
+	var opts []request.Option
+	// The secret is rotated right away only when the rotation is enabled or
+	// its Lambda function changes, not when only the schedule changes.
+	if awsclients.BoolValue(resp.RotationEnabled) &&
+		(in.RotationLambdaARN == nil || awsclients.StringValue(in.RotationLambdaARN) == awsclients.StringValue(resp.RotationLambdaARN)) {
+		opts = append(opts, withoutImmediateRotation)
+	}
+	_, err := e.client.RotateSecretWithContext(ctx, in, opts...)
+	return awsclients.Wrap(err, errRotateSecret)
+}
+
+// withoutImmediateRotation sets RotateImmediately to false in the RotateSecret
+// request so that only the rotation configuration is updated. The field is
+// not available in the AWS SDK version in use, so it is added to the
+// serialized request body.
+func withoutImmediateRotation(r *request.Request) {
+	r.Handlers.Build.PushBack(setRotateImmediatelyFalse)
+}
+
+func setRotateImmediatelyFalse(r *request.Request) {
+	if r.Error != nil {
+		return
+	}
+	body := map[string]interface{}{}
+	b, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(b, &body)
+	}
+	if err == nil {
+		body["RotateImmediately"] = false
+		b, err = json.Marshal(body)
+	}
+	if err != nil {
+		r.Error = awserr.New(request.ErrCodeSerialization, errRotateImmediately, err)
+		return
+	}
+	r.SetBufferBody(b)
 }
 
 func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput) (bool, error) { // nolint:gocyclo
@@ -128,6 +265,9 @@ func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOu
 	if len(add) != 0 && len(remove) != 0 {
 		return false, nil
 	}
+	if !isRotationUpToDate(cr.Spec.ForProvider, resp) {
+		return false, nil
+	}
 
 	// TODO(muvaf): We need isUpToDate to have context.
 	ctx := context.TODO()
@@ -155,6 +295,11 @@ func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOu
 		return false, nil
 	}
 
+	// The value is owned by AWS in reverse sync mode.
+	if cr.Spec.ForProvider.ReverseSync != nil {
+		return true, nil
+	}
+
 	// Compare secret values
 	s, err := e.client.GetSecretValueWithContext(ctx, &svcsdk.GetSecretValueInput{
 		SecretId: awsclients.String(meta.GetExternalName(cr)),
@@ -255,6 +400,16 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 		}
 	}
 
+	if err := e.updateRotation(ctx, cr, resp); err != nil {
+		return err
+	}
+
+	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
+	obj.Description = cr.Spec.ForProvider.Description
+	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
+	if cr.Spec.ForProvider.ReverseSync != nil {
+		return nil
+	}
 	payload, err := e.getPayload(ctx, cr)
 	if err != nil {
 		return err
@@ -265,13 +420,16 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	case cr.Spec.ForProvider.BinarySecretRef != nil:
 		obj.SecretBinary = payload
 	}
-	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
-	obj.Description = cr.Spec.ForProvider.Description
-	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
 	return nil
 }
 
 func (e *hooks) preCreate(ctx context.Context, cr *svcapitypes.Secret, obj *svcsdk.CreateSecretInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	// In reverse sync mode, the secret can be created without a value to be
+	// filled in by its first rotation.
+	if cr.Spec.ForProvider.ReverseSync != nil && cr.Spec.ForProvider.StringSecretRef == nil && cr.Spec.ForProvider.BinarySecretRef == nil {
+		return nil
+	}
 	payload, err := e.getPayload(ctx, cr)
 	if err != nil {
 		return err
@@ -282,7 +440,6 @@ func (e *hooks) preCreate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	case cr.Spec.ForProvider.BinarySecretRef != nil:
 		obj.SecretBinary = payload
 	}
-	obj.Name = awsclients.String(meta.GetExternalName(cr))
 	return nil
 }
 
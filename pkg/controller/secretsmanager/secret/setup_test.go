@@ -0,0 +1,320 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	lambdaARN      = "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+	otherLambdaARN = "arn:aws:lambda:us-east-1:123456789012:function:other"
+)
+
+func TestGetConnectionDetails(t *testing.T) {
+	type args struct {
+		rs svcapitypes.ReverseSync
+		s  *svcsdk.GetSecretValueOutput
+	}
+	type want struct {
+		conn managed.ConnectionDetails
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DefaultKey": {
+			args: args{
+				s: &svcsdk.GetSecretValueOutput{SecretString: awsclients.String("foo")},
+			},
+			want: want{
+				conn: managed.ConnectionDetails{"value": []byte("foo")},
+			},
+		},
+		"CustomKeyBinary": {
+			args: args{
+				rs: svcapitypes.ReverseSync{Key: "password"},
+				s:  &svcsdk.GetSecretValueOutput{SecretBinary: []byte("foo")},
+			},
+			want: want{
+				conn: managed.ConnectionDetails{"password": []byte("foo")},
+			},
+		},
+		"ExplodeJSON": {
+			args: args{
+				rs: svcapitypes.ReverseSync{ExplodeJSON: true},
+				s:  &svcsdk.GetSecretValueOutput{SecretString: awsclients.String(`{"username":"admin","port":5432,"tags":["a"]}`)},
+			},
+			want: want{
+				conn: managed.ConnectionDetails{
+					"username": []byte("admin"),
+					"port":     []byte("5432"),
+					"tags":     []byte(`["a"]`),
+				},
+			},
+		},
+		"NotJSON": {
+			args: args{
+				rs: svcapitypes.ReverseSync{ExplodeJSON: true},
+				s:  &svcsdk.GetSecretValueOutput{SecretString: awsclients.String("foo")},
+			},
+			want: want{
+				err: errors.Wrap(json.Unmarshal([]byte("foo"), &map[string]interface{}{}), errExplodeJSON),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn, err := getConnectionDetails(tc.args.rs, tc.args.s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conn, conn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRotationUpToDate(t *testing.T) {
+	type args struct {
+		p    svcapitypes.SecretParameters
+		resp *svcsdk.DescribeSecretOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"DisabledAsDesired": {
+			args: args{
+				p:    svcapitypes.SecretParameters{CustomSecretParameters: svcapitypes.CustomSecretParameters{RotationEnabled: awsclients.Bool(false, awsclients.FieldRequired)}},
+				resp: &svcsdk.DescribeSecretOutput{},
+			},
+			want: true,
+		},
+		"ShouldBeDisabled": {
+			args: args{
+				p:    svcapitypes.SecretParameters{CustomSecretParameters: svcapitypes.CustomSecretParameters{RotationEnabled: awsclients.Bool(false, awsclients.FieldRequired)}},
+				resp: &svcsdk.DescribeSecretOutput{RotationEnabled: awsclients.Bool(true), RotationLambdaARN: &lambdaARN},
+			},
+			want: false,
+		},
+		"EnabledButNotManaged": {
+			args: args{
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled:   awsclients.Bool(true),
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &svcsdk.RotationRulesType{AutomaticallyAfterDays: awsclients.Int64(30)},
+				},
+			},
+			want: true,
+		},
+		"ShouldBeReenabled": {
+			args: args{
+				p:    svcapitypes.SecretParameters{CustomSecretParameters: svcapitypes.CustomSecretParameters{RotationEnabled: awsclients.Bool(true)}},
+				resp: &svcsdk.DescribeSecretOutput{RotationLambdaARN: &lambdaARN},
+			},
+			want: false,
+		},
+		"ShouldBeEnabled": {
+			args: args{
+				p:    svcapitypes.SecretParameters{CustomSecretParameters: svcapitypes.CustomSecretParameters{RotationLambdaARN: &lambdaARN}},
+				resp: &svcsdk.DescribeSecretOutput{},
+			},
+			want: false,
+		},
+		"DifferentLambda": {
+			args: args{
+				p:    svcapitypes.SecretParameters{CustomSecretParameters: svcapitypes.CustomSecretParameters{RotationLambdaARN: &lambdaARN}},
+				resp: &svcsdk.DescribeSecretOutput{RotationEnabled: awsclients.Bool(true), RotationLambdaARN: &otherLambdaARN},
+			},
+			want: false,
+		},
+		"DifferentRules": {
+			args: args{
+				p: svcapitypes.SecretParameters{CustomSecretParameters: svcapitypes.CustomSecretParameters{
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &svcapitypes.RotationRules{AutomaticallyAfterDays: 30},
+				}},
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled:   awsclients.Bool(true),
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &svcsdk.RotationRulesType{AutomaticallyAfterDays: awsclients.Int64(7)},
+				},
+			},
+			want: false,
+		},
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.SecretParameters{CustomSecretParameters: svcapitypes.CustomSecretParameters{
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &svcapitypes.RotationRules{AutomaticallyAfterDays: 30},
+				}},
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled:   awsclients.Bool(true),
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &svcsdk.RotationRulesType{AutomaticallyAfterDays: awsclients.Int64(30)},
+				},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isRotationUpToDate(tc.args.p, tc.args.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type mockRotationClient struct {
+	secretsmanageriface.SecretsManagerAPI
+
+	calls []string
+}
+
+func (m *mockRotationClient) RotateSecretWithContext(_ context.Context, in *svcsdk.RotateSecretInput, opts ...request.Option) (*svcsdk.RotateSecretOutput, error) {
+	call := "RotateSecret"
+	if len(opts) != 0 {
+		call += ":NotImmediately"
+	}
+	m.calls = append(m.calls, call)
+	return &svcsdk.RotateSecretOutput{}, nil
+}
+
+func (m *mockRotationClient) CancelRotateSecretWithContext(_ context.Context, in *svcsdk.CancelRotateSecretInput, _ ...request.Option) (*svcsdk.CancelRotateSecretOutput, error) {
+	m.calls = append(m.calls, "CancelRotateSecret")
+	return &svcsdk.CancelRotateSecretOutput{}, nil
+}
+
+func TestUpdateRotation(t *testing.T) {
+	type args struct {
+		p    svcapitypes.CustomSecretParameters
+		resp *svcsdk.DescribeSecretOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want []string
+	}{
+		"NotManaged": {
+			args: args{
+				resp: &svcsdk.DescribeSecretOutput{RotationEnabled: awsclients.Bool(true), RotationLambdaARN: &lambdaARN},
+			},
+		},
+		"Cancel": {
+			args: args{
+				p:    svcapitypes.CustomSecretParameters{RotationEnabled: awsclients.Bool(false, awsclients.FieldRequired)},
+				resp: &svcsdk.DescribeSecretOutput{RotationEnabled: awsclients.Bool(true), RotationLambdaARN: &lambdaARN},
+			},
+			want: []string{"CancelRotateSecret"},
+		},
+		"Enable": {
+			args: args{
+				p:    svcapitypes.CustomSecretParameters{RotationLambdaARN: &lambdaARN},
+				resp: &svcsdk.DescribeSecretOutput{},
+			},
+			want: []string{"RotateSecret"},
+		},
+		"LambdaChanged": {
+			args: args{
+				p:    svcapitypes.CustomSecretParameters{RotationLambdaARN: &lambdaARN},
+				resp: &svcsdk.DescribeSecretOutput{RotationEnabled: awsclients.Bool(true), RotationLambdaARN: &otherLambdaARN},
+			},
+			want: []string{"RotateSecret"},
+		},
+		"OnlyScheduleChanged": {
+			args: args{
+				p: svcapitypes.CustomSecretParameters{
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &svcapitypes.RotationRules{AutomaticallyAfterDays: 30},
+				},
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled:   awsclients.Bool(true),
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &svcsdk.RotationRulesType{AutomaticallyAfterDays: awsclients.Int64(7)},
+				},
+			},
+			want: []string{"RotateSecret:NotImmediately"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockRotationClient{}
+			cr := &svcapitypes.Secret{}
+			meta.SetExternalName(cr, "secret")
+			cr.Spec.ForProvider.CustomSecretParameters = tc.args.p
+			e := &hooks{client: client}
+			if err := e.updateRotation(context.Background(), cr, tc.args.resp); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, client.calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithoutImmediateRotation(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      awsclients.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	r, _ := svcsdk.New(sess).RotateSecretRequest(&svcsdk.RotateSecretInput{
+		ClientRequestToken: awsclients.String("0123456789abcdef0123456789abcdef"),
+		SecretId:           awsclients.String("secret"),
+	})
+	r.ApplyOptions(withoutImmediateRotation)
+	if err := r.Build(); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"ClientRequestToken":"0123456789abcdef0123456789abcdef","RotateImmediately":false,"SecretId":"secret"}`
+	if diff := cmp.Diff(want, string(b)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}